@@ -35,4 +35,9 @@ var (
 	ErrNilBlk = errors.New("nil beacon block")
 	// ErrDataNotAvailable.
 	ErrDataNotAvailable = errors.New("data not available")
+	// ErrReplayWindowExceeded is an error for when the execution client is
+	// missing payloads older than the blocks retained by the block store.
+	ErrReplayWindowExceeded = errors.New(
+		"execution client is missing payloads older than the stored blocks",
+	)
 )
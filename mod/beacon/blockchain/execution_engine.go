@@ -42,10 +42,10 @@ func (s *Service[
 	st BeaconStateT,
 	blk BeaconBlockT,
 ) {
-	lph, err := st.GetLatestExecutionPayloadHeader()
+	fcs, err := s.ForkchoiceState(st)
 	if err != nil {
 		s.logger.Error(
-			"failed to get latest execution payload in postBlockProcess",
+			"failed to get forkchoice state in postBlockProcess",
 			"error", err,
 		)
 		return
@@ -85,8 +85,8 @@ func (s *Service[
 				uint64(blk.GetBody().GetExecutionPayload().GetTimestamp()+1),
 			)),
 			prevBlockRoot,
-			lph.GetBlockHash(),
-			lph.GetParentHash(),
+			fcs.HeadBlockHash,
+			fcs.FinalizedBlockHash,
 		); err == nil {
 			return
 		}
@@ -105,11 +105,7 @@ func (s *Service[
 		_, _, err = s.ee.NotifyForkchoiceUpdate(
 			ctx,
 			engineprimitives.BuildForkchoiceUpdateRequest(
-				&engineprimitives.ForkchoiceStateV1{
-					HeadBlockHash:      lph.GetBlockHash(),
-					SafeBlockHash:      lph.GetParentHash(),
-					FinalizedBlockHash: lph.GetParentHash(),
-				},
+				fcs,
 				nil,
 				s.cs.ActiveForkVersionForSlot(blk.GetSlot()),
 			),
@@ -122,3 +118,40 @@ func (s *Service[
 		}
 	}
 }
+
+// notifyNewPayload verifies the execution payload of the given block with
+// the execution client, which imports it.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	DepositStoreT,
+]) notifyNewPayload(
+	ctx context.Context,
+	blk BeaconBlockT,
+	optimistic bool,
+) error {
+	var (
+		body                  = blk.GetBody()
+		parentBeaconBlockRoot = blk.GetParentBlockRoot()
+	)
+	// Execution requests are only sent to the execution client from
+	// Electra onwards, prior to that they are ignored.
+	executionRequests, err := body.GetExecutionRequests().Encode()
+	if err != nil {
+		return err
+	}
+
+	return s.ee.VerifyAndNotifyNewPayload(
+		ctx, engineprimitives.BuildNewPayloadRequest(
+			body.GetExecutionPayload(),
+			body.GetBlobKzgCommitments().ToVersionedHashes(),
+			&parentBeaconBlockRoot,
+			executionRequests,
+			optimistic,
+		),
+	)
+}
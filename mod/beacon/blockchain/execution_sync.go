@@ -0,0 +1,322 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// executionSyncInterval is the interval at which the execution client is
+// checked while it is syncing.
+const executionSyncInterval = 5 * time.Second
+
+// replayBatchSize is the number of stored blocks loaded at once when their
+// execution payloads are replayed to the execution client.
+const replayBatchSize = 64
+
+// IsExecutionSynced returns true if the execution client is consistent with
+// the beacon chain, i.e. it has the execution block of the latest committed
+// beacon block. Blocks must not be built until it is.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) IsExecutionSynced() bool {
+	return s.executionSynced.Load()
+}
+
+// SyncExecutionClient reconciles the execution client with the latest block
+// committed to the given state, which it may be missing after a restart or
+// a snapshot restore. It replays the stored execution payloads the client
+// is missing or, if they are not stored, waits for the client to sync them
+// from its peers. The execution client is out of sync until it completes in
+// the background.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) SyncExecutionClient(
+	ctx context.Context,
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return err
+	}
+
+	fcs, err := s.ForkchoiceState(st)
+	if err != nil {
+		return err
+	}
+
+	s.executionSynced.Store(false)
+	go s.syncExecutionClient(ctx, slot, lph.GetNumber(), fcs)
+	return nil
+}
+
+// syncExecutionClient drives the execution client to the head of the given
+// forkchoice state, the execution block of the given number and slot.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) syncExecutionClient(
+	ctx context.Context,
+	slot math.Slot,
+	number math.U64,
+	fcs *engineprimitives.ForkchoiceStateV1,
+) {
+	ticker := time.NewTicker(executionSyncInterval)
+	defer ticker.Stop()
+
+	replayed := false
+	for {
+		if _, err := s.ee.HeaderByHash(ctx, fcs.HeadBlockHash); err == nil {
+			if err = s.notifyForkchoice(ctx, slot, fcs); err == nil {
+				s.executionSynced.Store(true)
+				s.logger.Info(
+					"execution client is synced with the beacon chain ✅ ",
+					"head_eth1_hash", fcs.HeadBlockHash,
+					"head_eth1_number", number,
+				)
+				return
+			}
+			s.logger.Error(
+				"failed to send forkchoice update to synced execution client",
+				"error", err,
+			)
+		} else if head, hErr := s.ee.HeaderByNumber(ctx, nil); hErr != nil {
+			s.logger.Warn(
+				"waiting for execution client to become available",
+				"error", hErr,
+			)
+		} else if !replayed {
+			// Replay the payloads the execution client is missing, and check
+			// again right away whether it caught up.
+			replayed = true
+			if err = s.replayExecutionPayloads(ctx, slot); err == nil {
+				continue
+			}
+			s.logger.Warn(
+				"failed to replay stored execution payloads, "+
+					"waiting for execution client to sync",
+				"error", err,
+			)
+
+			// Point the execution client to the head, so that it syncs
+			// the missing blocks from its peers.
+			if err = s.notifyForkchoice(ctx, slot, fcs); err != nil {
+				s.logger.Error(
+					"failed to send forkchoice update to syncing "+
+						"execution client",
+					"error", err,
+				)
+			}
+		} else {
+			s.logger.Info(
+				"waiting for execution client to sync 🐌",
+				"head_eth1_number", head.Number.Uint64(),
+				"target_eth1_number", number,
+				"remaining", number.Unwrap()-min(
+					head.Number.Uint64(), number.Unwrap(),
+				),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// replayExecutionPayloads sends the execution client the stored payloads of
+// the blocks up to the given slot that it is missing, oldest first. Only the
+// blocks within the replay window that are still stored are replayed, a batch
+// at a time.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) replayExecutionPayloads(
+	ctx context.Context,
+	slot math.Slot,
+) error {
+	// Blocks are stored from slot 1, as the genesis state has no block, and
+	// only as far back as the oldest block retained.
+	oldest, err := s.bs.OldestSlot()
+	if err != nil {
+		return err
+	}
+	lo := max(oldest, slot-min(slot, math.Slot(s.replayWindow)), 1)
+	if lo > slot {
+		return errors.Wrapf(ErrReplayWindowExceeded, "slot %d", slot)
+	}
+
+	start, err := s.oldestMissingPayload(ctx, lo, slot)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info(
+		"replaying stored execution payloads 🔁",
+		"from_slot", start,
+		"to_slot", slot,
+	)
+	for from := start; from <= slot; from += replayBatchSize {
+		to := min(from+replayBatchSize-1, slot)
+		blks := make([]BeaconBlockT, 0, to-from+1)
+		for i := from; i <= to; i++ {
+			var blk BeaconBlockT
+			if blk, err = s.bs.Get(i); err != nil {
+				return err
+			}
+			blks = append(blks, blk)
+		}
+
+		for _, blk := range blks {
+			if err = s.notifyNewPayload(ctx, blk, false); err != nil {
+				return err
+			}
+		}
+		s.logger.Debug(
+			"replayed stored execution payloads",
+			"from_slot", from,
+			"to_slot", to,
+		)
+	}
+	return nil
+}
+
+// oldestMissingPayload returns the slot of the oldest block in the range
+// [lo, hi] whose execution payload the execution client is missing. As the
+// client has the parent of a payload if it has the parent of any later one,
+// the slot is binary searched for.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) oldestMissingPayload(
+	ctx context.Context,
+	lo, hi math.Slot,
+) (math.Slot, error) {
+	// The parent of the payload of the oldest stored block must be known,
+	// otherwise the missing payloads cannot be replayed.
+	if known, err := s.hasParentPayload(ctx, lo); err != nil {
+		return 0, err
+	} else if !known {
+		return 0, errors.Wrapf(ErrReplayWindowExceeded, "slot %d", lo)
+	}
+
+	// The parent of the payload of the block at lo is known, find the last
+	// block for which it holds.
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		known, err := s.hasParentPayload(ctx, mid)
+		if err != nil {
+			return 0, err
+		}
+		if known {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return lo, nil
+}
+
+// hasParentPayload returns true if the execution client has the parent of
+// the execution payload of the block stored for the given slot.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) hasParentPayload(
+	ctx context.Context,
+	slot math.Slot,
+) (bool, error) {
+	blk, err := s.bs.Get(slot)
+	if err != nil {
+		return false, err
+	}
+	parentHash := blk.GetBody().GetExecutionPayload().GetParentHash()
+	_, err = s.ee.HeaderByHash(ctx, parentHash)
+	return err == nil, nil
+}
+
+// notifyForkchoice sends the given forkchoice state to the execution client,
+// without payload attributes.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) notifyForkchoice(
+	ctx context.Context,
+	slot math.Slot,
+	fcs *engineprimitives.ForkchoiceStateV1,
+) error {
+	_, _, err := s.ee.NotifyForkchoiceUpdate(
+		ctx,
+		engineprimitives.BuildForkchoiceUpdateRequest(
+			fcs, nil, s.cs.ActiveForkVersionForSlot(slot),
+		),
+	)
+	return err
+}
@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+var errUnknownHeader = errors.New("unknown header")
+
+// replayExecutionEngine is an execution engine importing the payloads it is
+// notified of.
+type replayExecutionEngine struct {
+	ExecutionEngine
+	// known are the hashes of the execution blocks of the client.
+	known map[common.ExecutionHash]bool
+	// headerRequests is the number of headers requested.
+	headerRequests int
+	// replayed are the hashes of the payloads notified, in order.
+	replayed []common.ExecutionHash
+}
+
+func (e *replayExecutionEngine) HeaderByHash(
+	_ context.Context,
+	hash common.ExecutionHash,
+) (*engineprimitives.Header, error) {
+	e.headerRequests++
+	if !e.known[hash] {
+		return nil, errUnknownHeader
+	}
+	return &engineprimitives.Header{}, nil
+}
+
+func (e *replayExecutionEngine) VerifyAndNotifyNewPayload(
+	_ context.Context,
+	req *engineprimitives.NewPayloadRequest[
+		*types.ExecutionPayload, *engineprimitives.Withdrawal,
+	],
+) error {
+	hash := req.ExecutionPayload.GetBlockHash()
+	e.replayed = append(e.replayed, hash)
+	e.known[hash] = true
+	return nil
+}
+
+// testBlockStore is a block store in memory.
+type testBlockStore map[math.Slot]*types.BeaconBlock
+
+func (bs testBlockStore) Get(slot math.Slot) (*types.BeaconBlock, error) {
+	blk, ok := bs[slot]
+	if !ok {
+		return nil, errors.Newf("no block at slot %d", slot)
+	}
+	return blk, nil
+}
+
+func (bs testBlockStore) Set(blk *types.BeaconBlock) error {
+	bs[blk.GetSlot()] = blk
+	return nil
+}
+
+func (bs testBlockStore) OldestSlot() (math.Slot, error) {
+	if len(bs) == 0 {
+		return 0, errors.New("no blocks")
+	}
+	oldest := math.Slot(0)
+	for slot := range bs {
+		if oldest == 0 || slot < oldest {
+			oldest = slot
+		}
+	}
+	return oldest, nil
+}
+
+// executionHash returns the hash of the execution block of the given slot.
+func executionHash(slot math.Slot) common.ExecutionHash {
+	return common.ExecutionHash{1, byte(slot), byte(slot >> 8)}
+}
+
+// newReplayService returns a service with the given replay window, whose
+// block store holds a chain of blocks from slot 1 up to the given head slot
+// and whose execution client has the execution blocks up to the given synced
+// slot. As in production, no block is stored for the genesis slot.
+func newReplayService(
+	t *testing.T,
+	replayWindow uint64,
+	head, synced math.Slot,
+) (
+	*Service[
+		testAvailabilityStore, *types.BeaconBlock, *types.BeaconBlockBody,
+		*testState, testSidecars, *types.Deposit, testDepositStore,
+	],
+	*replayExecutionEngine,
+) {
+	t.Helper()
+	// The execution client always has the genesis execution block.
+	ee := &replayExecutionEngine{
+		known: map[common.ExecutionHash]bool{executionHash(0): true},
+	}
+	for slot := math.Slot(1); slot <= synced; slot++ {
+		ee.known[executionHash(slot)] = true
+	}
+
+	bs := testBlockStore{}
+	parent := &types.BeaconBlockHeader{}
+	for slot := math.Slot(1); slot <= head; slot++ {
+		blk := newTestBlock(t, parent, slot)
+		body, ok := blk.GetBody().RawBeaconBlockBody.(*types.BeaconBlockBodyDeneb)
+		require.True(t, ok)
+		body.ExecutionPayload.ParentHash = executionHash(slot - 1)
+		body.ExecutionPayload.BlockHash = executionHash(slot)
+		require.NoError(t, bs.Set(blk))
+		parent = blk.GetHeader()
+	}
+
+	return NewService[
+		testAvailabilityStore, *types.BeaconBlock, *types.BeaconBlockBody,
+		*testState, testSidecars, testDepositStore, *types.Deposit,
+	](
+		testStorageBackend{st: &testState{header: parent}},
+		noop.NewLogger(),
+		nil,
+		ee,
+		&testForkchoiceStore{},
+		bs,
+		replayWindow,
+		nil,
+		nil,
+		&testStateProcessor{},
+		testTelemetrySink{},
+		nil,
+		false,
+	), ee
+}
+
+func TestReplayExecutionPayloads(t *testing.T) {
+	s, ee := newReplayService(t, 1000, 150, 40)
+
+	require.NoError(t, s.replayExecutionPayloads(context.Background(), 150))
+
+	// The missing payloads are replayed in order, over several batches.
+	require.Len(t, ee.replayed, 110)
+	for i, hash := range ee.replayed {
+		require.Equal(t, executionHash(math.Slot(i+41)), hash)
+	}
+	// The oldest missing payload is binary searched for.
+	require.LessOrEqual(t, ee.headerRequests, 9)
+}
+
+func TestReplayExecutionPayloads_YoungChain(t *testing.T) {
+	// The chain is younger than the replay window, and the execution client
+	// only has the genesis execution block.
+	s, ee := newReplayService(t, 8192, 20, 0)
+
+	require.NoError(t, s.replayExecutionPayloads(context.Background(), 20))
+	require.Len(t, ee.replayed, 20)
+	for i, hash := range ee.replayed {
+		require.Equal(t, executionHash(math.Slot(i+1)), hash)
+	}
+}
+
+func TestReplayExecutionPayloads_PrunedBlocks(t *testing.T) {
+	// The blocks older than the window were pruned, only the last stored
+	// blocks are searched.
+	s, ee := newReplayService(t, 1000, 150, 120)
+	for slot := math.Slot(1); slot < 100; slot++ {
+		delete(s.bs.(testBlockStore), slot)
+	}
+
+	require.NoError(t, s.replayExecutionPayloads(context.Background(), 150))
+	require.Len(t, ee.replayed, 30)
+	require.Equal(t, executionHash(121), ee.replayed[0])
+}
+
+func TestReplayExecutionPayloads_Synced(t *testing.T) {
+	s, ee := newReplayService(t, 1000, 150, 150)
+
+	require.NoError(t, s.replayExecutionPayloads(context.Background(), 150))
+	require.Equal(t, []common.ExecutionHash{executionHash(150)}, ee.replayed)
+}
+
+func TestReplayExecutionPayloads_WindowExceeded(t *testing.T) {
+	s, ee := newReplayService(t, 100, 150, 40)
+
+	err := s.replayExecutionPayloads(context.Background(), 150)
+	require.ErrorIs(t, err, ErrReplayWindowExceeded)
+	require.Empty(t, ee.replayed)
+	require.Equal(t, 1, ee.headerRequests)
+}
+
+func TestReplayExecutionPayloads_MissingBlock(t *testing.T) {
+	s, ee := newReplayService(t, 1000, 150, 40)
+	delete(s.bs.(testBlockStore), 100)
+
+	require.Error(t, s.replayExecutionPayloads(context.Background(), 150))
+	require.NotContains(t, ee.replayed, executionHash(150))
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ForkchoiceState returns the forkchoice state of the execution chain as of
+// the latest block committed to the given state.
+//
+// CometBFT gives single slot finality, thus the execution payload of the
+// latest committed block is the head, safe and finalized execution block.
+// The forkchoice state persisted on FinalizeBlock is returned, unless it was
+// recorded for a block older than the latest block of the given state, or
+// was never recorded. It is then derived from the latest execution payload
+// of the given state, e.g. on the first start after an upgrade or after the
+// node was restored from a state snapshot, until the next block is
+// finalized.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) ForkchoiceState(
+	st BeaconStateT,
+) (*engineprimitives.ForkchoiceStateV1, error) {
+	// The state may have been processed to a later slot than that of its
+	// latest block, e.g. to build the next block.
+	latestHeader, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	slot, fcs, err := s.fcs.Get()
+	if err != nil {
+		return nil, err
+	} else if fcs != nil && slot >= latestHeader.GetSlot() {
+		return fcs, nil
+	}
+
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	return newFinalizedForkchoiceState(lph.GetBlockHash()), nil
+}
+
+// finalizeForkchoice records the given execution block as the head, safe
+// and finalized block of the execution chain, as of the beacon block at the
+// given slot. It is called with the payload of each block passed to
+// FinalizeBlock, which CometBFT has committed.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) finalizeForkchoice(
+	slot math.Slot,
+	blockHash common.ExecutionHash,
+) error {
+	return s.fcs.Set(slot, newFinalizedForkchoiceState(blockHash))
+}
+
+// newFinalizedForkchoiceState returns a forkchoice state in which the given
+// execution block is the head, safe and finalized block.
+func newFinalizedForkchoiceState(
+	blockHash common.ExecutionHash,
+) *engineprimitives.ForkchoiceStateV1 {
+	return &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash:      blockHash,
+		SafeBlockHash:      blockHash,
+		FinalizedBlockHash: blockHash,
+	}
+}
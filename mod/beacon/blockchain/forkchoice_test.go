@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// newForkchoiceTestState returns a state whose latest block is at the given
+// slot, with an execution payload of the given hash.
+func newForkchoiceTestState(
+	slot math.Slot, blockHash common.ExecutionHash,
+) *testState {
+	return &testState{
+		header: types.NewBeaconBlockHeader(
+			slot, 0, common.Root{}, common.Root{}, common.Root{},
+		),
+		payloadHeader: &types.ExecutionPayloadHeader{
+			InnerExecutionPayloadHeader: &types.ExecutionPayloadHeaderDeneb{
+				BlockHash: blockHash,
+			},
+		},
+	}
+}
+
+func TestForkchoiceStatePersisted(t *testing.T) {
+	s, _, _ := newTestService(&types.BeaconBlockHeader{})
+	require.NoError(t, s.finalizeForkchoice(11, common.ExecutionHash{0x0b}))
+
+	// The node stopped after finalizing the block at slot 11, but before
+	// committing it, thus the persisted state is ahead of the given state
+	// and drives the forkchoice.
+	fcs, err := s.ForkchoiceState(
+		newForkchoiceTestState(10, common.ExecutionHash{0x0a}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, newFinalizedForkchoiceState(
+		common.ExecutionHash{0x0b},
+	), fcs)
+}
+
+func TestForkchoiceStateNotPersisted(t *testing.T) {
+	s, _, _ := newTestService(&types.BeaconBlockHeader{})
+
+	fcs, err := s.ForkchoiceState(
+		newForkchoiceTestState(10, common.ExecutionHash{0x0a}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, newFinalizedForkchoiceState(
+		common.ExecutionHash{0x0a},
+	), fcs)
+}
+
+func TestForkchoiceStateStale(t *testing.T) {
+	s, _, _ := newTestService(&types.BeaconBlockHeader{})
+	require.NoError(t, s.finalizeForkchoice(5, common.ExecutionHash{0x05}))
+
+	// The state is ahead of the persisted forkchoice state, e.g. after it
+	// was restored from a snapshot, thus the state is the source of truth.
+	fcs, err := s.ForkchoiceState(
+		newForkchoiceTestState(10, common.ExecutionHash{0x0a}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, newFinalizedForkchoiceState(
+		common.ExecutionHash{0x0a},
+	), fcs)
+}
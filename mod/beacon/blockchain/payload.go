@@ -29,39 +29,6 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
-// forceStartupHead sends a force head FCU to the execution client.
-func (s *Service[
-	AvailabilityStoreT,
-	BeaconBlockT,
-	BeaconBlockBodyT,
-	BeaconStateT,
-	BlobSidecarsT,
-	DepositStoreT,
-	DepositT,
-]) forceStartupHead(
-	ctx context.Context,
-	st BeaconStateT,
-) {
-	slot, err := st.GetSlot()
-	if err != nil {
-		s.logger.Error(
-			"failed to get slot for force startup head",
-			"error", err,
-		)
-		return
-	}
-
-	// TODO: Verify if the slot number is correct here, I believe in current
-	// form
-	// it should be +1'd. Not a big deal until hardforks are in play though.
-	if err = s.lb.SendForceHeadFCU(ctx, st, slot+1); err != nil {
-		s.logger.Error(
-			"failed to send force head FCU",
-			"error", err,
-		)
-	}
-}
-
 // handleRebuildPayloadForRejectedBlock handles the case where the incoming
 // block was rejected and we need to rebuild the payload for the current slot.
 func (s *Service[
@@ -146,6 +113,11 @@ func (s *Service[
 		return err
 	}
 
+	fcs, err := s.ForkchoiceState(st)
+	if err != nil {
+		return err
+	}
+
 	// Submit a request for a new payload.
 	if _, err = s.lb.RequestPayloadAsync(
 		ctx,
@@ -161,11 +133,8 @@ func (s *Service[
 		// We set the parent root to the previous block root.
 		prevBlockRoot,
 		// We set the head of our chain to previous finalized block.
-		lph.GetBlockHash(),
-		// We can say that the payload from the previous block is *finalized*,
-		// TODO: This is making an assumption about the consensus rules
-		// and possibly should be made more explicit later on.
-		lph.GetBlockHash(),
+		fcs.HeadBlockHash,
+		fcs.FinalizedBlockHash,
 	); err != nil {
 		s.metrics.markRebuildPayloadForRejectedBlockFailure(slot, err)
 		return err
@@ -186,10 +155,13 @@ func (s *Service[
 	DepositT,
 ]) handleOptimisticPayloadBuild(
 	ctx context.Context,
-	st BeaconStateT,
+	preState BeaconStateT,
+	postState BeaconStateT,
 	blk BeaconBlockT,
 ) {
-	if err := s.optimisticPayloadBuild(ctx, st, blk); err != nil {
+	if err := s.optimisticPayloadBuild(
+		ctx, preState, postState, blk,
+	); err != nil {
 		s.logger.Error(
 			"failed to build optimistic payload",
 			"for_slot", blk.GetSlot()+1,
@@ -198,7 +170,9 @@ func (s *Service[
 	}
 }
 
-// optimisticPayloadBuild builds a payload for the next slot.
+// optimisticPayloadBuild builds a payload for the next slot on top of the
+// given block, which has been accepted but not committed yet. The preState
+// is the state the block was applied to, and the postState the result.
 func (s *Service[
 	AvailabilityStoreT,
 	BeaconBlockT,
@@ -209,7 +183,8 @@ func (s *Service[
 	DepositT,
 ]) optimisticPayloadBuild(
 	ctx context.Context,
-	st BeaconStateT,
+	preState BeaconStateT,
+	postState BeaconStateT,
 	blk BeaconBlockT,
 ) error {
 	// We are building for the next slot, so we increment the slot relative
@@ -228,9 +203,16 @@ func (s *Service[
 		return err
 	}
 
+	// The block is not committed yet, thus the execution chain is only
+	// finalized up to the latest block committed to the preState.
+	fcs, err := s.ForkchoiceState(preState)
+	if err != nil {
+		return err
+	}
+
 	// We process the slot to update any RANDAO values.
 	if _, err = s.sp.ProcessSlots(
-		st, slot,
+		postState, slot,
 	); err != nil {
 		return err
 	}
@@ -238,7 +220,7 @@ func (s *Service[
 	// We then trigger a request for the next payload.
 	payload := blk.GetBody().GetExecutionPayload()
 	if _, err = s.lb.RequestPayloadAsync(
-		ctx, st,
+		ctx, postState,
 		slot,
 		// TODO: this is hood as fuck.
 		max(
@@ -251,11 +233,7 @@ func (s *Service[
 		blkRoot,
 		// We set the head of our chain to the block we just processed.
 		payload.GetBlockHash(),
-		// We can say that the payload from the previous block is *finalized*,
-		// This is safe to do since this block was accepted and the thus the
-		// parent hash was deemed valid by the state transition function we
-		// just processed.
-		payload.GetParentHash(),
+		fcs.FinalizedBlockHash,
 	); err != nil {
 		s.metrics.markOptimisticPayloadBuildFailure(slot, err)
 		return err
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// maxPostStates is the number of post-states that are cached. Only the
+// blocks of the current height are expected to be looked up.
+const maxPostStates = 4
+
+// postState is the result of the transition of a block.
+type postState[BeaconStateT any] struct {
+	// st is the post-state, which is not changed once cached.
+	st BeaconStateT
+	// valUpdates are the validator updates of the transition.
+	valUpdates []*transition.ValidatorUpdate
+	// parentHeader is the root of the latest block header of the pre-state.
+	parentHeader common.Root
+}
+
+// postStateCache caches the post-states of recently transitioned blocks by
+// block root, so that the transition of a block is not run again by the
+// paths that process the same block.
+type postStateCache[BeaconStateT interface{ Copy() BeaconStateT }] struct {
+	mu sync.Mutex
+	// roots are the roots of the cached blocks, oldest first.
+	roots  []common.Root
+	states map[common.Root]postState[BeaconStateT]
+}
+
+// newPostStateCache returns an empty post-state cache.
+func newPostStateCache[
+	BeaconStateT interface{ Copy() BeaconStateT },
+]() *postStateCache[BeaconStateT] {
+	return &postStateCache[BeaconStateT]{
+		states: make(map[common.Root]postState[BeaconStateT], maxPostStates),
+	}
+}
+
+// set caches the post-state of the block of the given root, evicting the
+// oldest post-state if the cache is full.
+func (c *postStateCache[BeaconStateT]) set(
+	root common.Root, ps postState[BeaconStateT],
+) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.states[root]; !ok {
+		if len(c.roots) == maxPostStates {
+			delete(c.states, c.roots[0])
+			c.roots = c.roots[1:]
+		}
+		c.roots = append(c.roots, root)
+	}
+	c.states[root] = ps
+}
+
+// get returns the post-state of the block of the given root, with a copy of
+// the state which may be changed freely, if it is cached.
+func (c *postStateCache[BeaconStateT]) get(
+	root common.Root,
+) (postState[BeaconStateT], bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ps, ok := c.states[root]
+	if ok {
+		ps.st = ps.st.Copy()
+	}
+	return ps, ok
+}
+
+// reset empties the cache.
+func (c *postStateCache[BeaconStateT]) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.roots = nil
+	clear(c.states)
+}
+
+// latestBlockHeaderRoot returns the root of the latest block header of the
+// given state, which identifies it among the states of its height.
+func latestBlockHeaderRoot[BeaconStateT ReadOnlyBeaconState[BeaconStateT]](
+	st BeaconStateT,
+) (common.Root, error) {
+	header, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return common.Root{}, err
+	}
+	return header.HashTreeRoot()
+}
+
+// CachePostState caches the post-state of the block of the given root and
+// the validator updates of its transition, as computed when the block was
+// built, so that the block is not transitioned again when it is verified.
+// The state must not be changed afterwards.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) CachePostState(
+	blockRoot common.Root,
+	st BeaconStateT,
+	valUpdates []*transition.ValidatorUpdate,
+) {
+	s.builtStates.set(
+		blockRoot,
+		postState[BeaconStateT]{st: st, valUpdates: valUpdates},
+	)
+}
@@ -0,0 +1,388 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// testState is a beacon state holding only the latest block header.
+type testState struct {
+	header *types.BeaconBlockHeader
+	// payloadHeader is the latest execution payload header.
+	payloadHeader *types.ExecutionPayloadHeader
+	// applies is the number of states applied to the state.
+	applies int
+}
+
+func (s *testState) GetSlot() (math.Slot, error) {
+	return s.header.GetSlot(), nil
+}
+
+func (s *testState) GetLatestExecutionPayloadHeader() (
+	*types.ExecutionPayloadHeader, error,
+) {
+	return s.payloadHeader, nil
+}
+
+func (s *testState) GetEth1DepositIndex() (uint64, error) {
+	return 0, nil
+}
+
+func (s *testState) GetLatestBlockHeader() (*types.BeaconBlockHeader, error) {
+	return s.header, nil
+}
+
+func (s *testState) HashTreeRoot() ([32]byte, error) {
+	return s.header.HashTreeRoot()
+}
+
+func (s *testState) Copy() *testState {
+	return &testState{header: s.header, payloadHeader: s.payloadHeader}
+}
+
+func (s *testState) Fork() *testState {
+	return s.Copy()
+}
+
+func (s *testState) Apply(st *testState) error {
+	s.header = st.header
+	s.payloadHeader = st.payloadHeader
+	s.applies++
+	return nil
+}
+
+func (s *testState) ValidatorIndexByPubkey(
+	crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	return 0, nil
+}
+
+// testSidecars are empty blob sidecars.
+type testSidecars struct{}
+
+func (testSidecars) MarshalSSZTo(buf []byte) ([]byte, error) { return buf, nil }
+func (testSidecars) MarshalSSZ() ([]byte, error)             { return nil, nil }
+func (testSidecars) UnmarshalSSZ([]byte) error               { return nil }
+func (testSidecars) SizeSSZ() int                            { return 0 }
+func (testSidecars) HashTreeRoot() ([32]byte, error)         { return [32]byte{}, nil }
+func (testSidecars) IsNil() bool                             { return false }
+func (testSidecars) Len() int                                { return 0 }
+
+// testAvailabilityStore is an availability store holding no sidecars.
+type testAvailabilityStore struct{}
+
+func (testAvailabilityStore) IsDataAvailable(
+	context.Context, math.Slot, *types.BeaconBlockBody,
+) bool {
+	return true
+}
+
+func (testAvailabilityStore) Persist(math.Slot, testSidecars) error {
+	return nil
+}
+
+func (testAvailabilityStore) GetBlobSidecars(math.Slot) (testSidecars, error) {
+	return testSidecars{}, nil
+}
+
+// testDepositStore is a deposit store holding no deposits.
+type testDepositStore struct{}
+
+func (testDepositStore) Prune(uint64, uint64) error             { return nil }
+func (testDepositStore) EnqueueDeposits([]*types.Deposit) error { return nil }
+func (testDepositStore) SetBlockDepositIndex(math.Slot, uint64) error {
+	return nil
+}
+
+// testStorageBackend is a storage backend of a single beacon state.
+type testStorageBackend struct {
+	st *testState
+}
+
+func (b testStorageBackend) AvailabilityStore(
+	context.Context,
+) testAvailabilityStore {
+	return testAvailabilityStore{}
+}
+
+func (b testStorageBackend) StateFromContext(context.Context) *testState {
+	return b.st
+}
+
+func (b testStorageBackend) DepositStore(context.Context) testDepositStore {
+	return testDepositStore{}
+}
+
+// testStateProcessor is a state processor whose transition sets the latest
+// block header of the state to the header of the block.
+type testStateProcessor struct {
+	// transitions is the number of blocks transitioned.
+	transitions int
+	// err is the error the transition returns.
+	err error
+}
+
+func (p *testStateProcessor) InitializePreminedBeaconStateFromEth1(
+	*testState,
+	[]*types.Deposit,
+	*types.ExecutionPayloadHeader,
+	primitives.Version,
+) ([]*transition.ValidatorUpdate, error) {
+	return nil, nil
+}
+
+func (p *testStateProcessor) ProcessSlots(
+	*testState, math.Slot,
+) ([]*transition.ValidatorUpdate, error) {
+	return nil, nil
+}
+
+func (p *testStateProcessor) Transition(
+	_ *transition.Context,
+	st *testState,
+	blk *types.BeaconBlock,
+) ([]*transition.ValidatorUpdate, error) {
+	p.transitions++
+	if p.err != nil {
+		return nil, p.err
+	}
+	st.header = blk.GetHeader()
+	return []*transition.ValidatorUpdate{{EffectiveBalance: 1}}, nil
+}
+
+// testExecutionEngine is an execution engine counting the new payloads it
+// is notified of.
+type testExecutionEngine struct {
+	ExecutionEngine
+	newPayloads int
+}
+
+func (e *testExecutionEngine) VerifyAndNotifyNewPayload(
+	context.Context,
+	*engineprimitives.NewPayloadRequest[
+		*types.ExecutionPayload, *engineprimitives.Withdrawal,
+	],
+) error {
+	e.newPayloads++
+	return nil
+}
+
+func (e *testExecutionEngine) HeaderByNumber(
+	context.Context, *big.Int,
+) (*engineprimitives.Header, error) {
+	return nil, nil
+}
+
+// testForkchoiceStore is an in-memory forkchoice store.
+type testForkchoiceStore struct {
+	slot math.Slot
+	fcs  *engineprimitives.ForkchoiceStateV1
+}
+
+func (s *testForkchoiceStore) Get() (
+	math.Slot, *engineprimitives.ForkchoiceStateV1, error,
+) {
+	return s.slot, s.fcs, nil
+}
+
+func (s *testForkchoiceStore) Set(
+	slot math.Slot, fcs *engineprimitives.ForkchoiceStateV1,
+) error {
+	s.slot, s.fcs = slot, fcs
+	return nil
+}
+
+// testTelemetrySink is a telemetry sink discarding the metrics.
+type testTelemetrySink struct{}
+
+func (testTelemetrySink) IncrementCounter(string, ...string)        {}
+func (testTelemetrySink) MeasureSince(string, time.Time, ...string) {}
+
+// newTestService returns a service whose storage backend holds a state at
+// the given parent header.
+func newTestService(
+	parent *types.BeaconBlockHeader,
+) (
+	*Service[
+		testAvailabilityStore, *types.BeaconBlock, *types.BeaconBlockBody,
+		*testState, testSidecars, *types.Deposit, testDepositStore,
+	],
+	*testStateProcessor,
+	*testExecutionEngine,
+) {
+	sp := &testStateProcessor{}
+	ee := &testExecutionEngine{}
+	return NewService[
+		testAvailabilityStore, *types.BeaconBlock, *types.BeaconBlockBody,
+		*testState, testSidecars, testDepositStore, *types.Deposit,
+	](
+		testStorageBackend{st: &testState{header: parent}},
+		noop.NewLogger(),
+		nil,
+		ee,
+		&testForkchoiceStore{},
+		nil,
+		0,
+		nil,
+		nil,
+		sp,
+		testTelemetrySink{},
+		nil,
+		false,
+	), sp, ee
+}
+
+// newTestBlock returns a block of the given slot on top of the given parent
+// header.
+func newTestBlock(
+	t *testing.T,
+	parent *types.BeaconBlockHeader,
+	slot math.Slot,
+) *types.BeaconBlock {
+	t.Helper()
+	parentRoot, err := parent.HashTreeRoot()
+	require.NoError(t, err)
+	body, ok := (&types.BeaconBlockBody{}).Empty(version.Deneb).
+		RawBeaconBlockBody.(*types.BeaconBlockBodyDeneb)
+	require.True(t, ok)
+	return &types.BeaconBlock{RawBeaconBlock: &types.BeaconBlockDeneb{
+		BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+			Slot:            slot.Unwrap(),
+			ParentBlockRoot: parentRoot,
+		},
+		Body: body,
+	}}
+}
+
+func TestProcessBeaconBlock_AppliesVerifiedPostState(t *testing.T) {
+	parent := &types.BeaconBlockHeader{}
+	s, sp, _ := newTestService(parent)
+	blk := newTestBlock(t, parent, 1)
+
+	require.NoError(t, s.VerifyIncomingBlock(context.Background(), blk))
+	require.Equal(t, 1, sp.transitions)
+
+	st := &testState{header: parent}
+	valUpdates, err := s.processBeaconBlock(context.Background(), st, blk)
+	require.NoError(t, err)
+	require.Equal(t, 1, sp.transitions)
+	require.Equal(t, 1, st.applies)
+	require.Equal(t, blk.GetHeader(), st.header)
+	require.Len(t, valUpdates, 1)
+}
+
+func TestProcessBeaconBlock_ParentHeaderMismatch(t *testing.T) {
+	parent := &types.BeaconBlockHeader{}
+	s, sp, _ := newTestService(parent)
+	blk := newTestBlock(t, parent, 1)
+
+	require.NoError(t, s.VerifyIncomingBlock(context.Background(), blk))
+
+	// The block is finalized on a state other than the one it was verified
+	// on, thus it is transitioned again.
+	st := &testState{header: &types.BeaconBlockHeader{
+		BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{Slot: 7},
+	}}
+	_, err := s.processBeaconBlock(context.Background(), st, blk)
+	require.NoError(t, err)
+	require.Equal(t, 2, sp.transitions)
+	require.Zero(t, st.applies)
+}
+
+func TestProcessBeaconBlock_AcceptedPayload(t *testing.T) {
+	parent := &types.BeaconBlockHeader{}
+	s, sp, _ := newTestService(parent)
+	blk := newTestBlock(t, parent, 1)
+
+	// The execution client accepted the payload without validating it, the
+	// transition stopped short of the post-state.
+	sp.err = engineerrors.ErrAcceptedPayloadStatus
+	require.NoError(t, s.VerifyIncomingBlock(context.Background(), blk))
+
+	sp.err = nil
+	st := &testState{header: parent}
+	_, err := s.processBeaconBlock(context.Background(), st, blk)
+	require.NoError(t, err)
+	require.Equal(t, 2, sp.transitions)
+	require.Zero(t, st.applies)
+}
+
+func TestProcessBeaconBlock_RoundChange(t *testing.T) {
+	parent := &types.BeaconBlockHeader{}
+	s, sp, _ := newTestService(parent)
+	blk := newTestBlock(t, parent, 1)
+	next := newTestBlock(t, parent, 2)
+
+	// The block of the first round is not the one finalized.
+	require.NoError(t, s.VerifyIncomingBlock(context.Background(), blk))
+	require.NoError(t, s.VerifyIncomingBlock(context.Background(), next))
+
+	st := &testState{header: parent}
+	_, err := s.processBeaconBlock(context.Background(), st, blk)
+	require.NoError(t, err)
+	require.Equal(t, 3, sp.transitions)
+	require.Zero(t, st.applies)
+}
+
+func TestVerifyIncomingBlock_BuiltBlock(t *testing.T) {
+	parent := &types.BeaconBlockHeader{}
+	s, sp, ee := newTestService(parent)
+	blk := newTestBlock(t, parent, 1)
+
+	blockRoot, err := blk.HashTreeRoot()
+	require.NoError(t, err)
+	s.CachePostState(
+		blockRoot,
+		&testState{header: blk.GetHeader()},
+		[]*transition.ValidatorUpdate{{EffectiveBalance: 2}},
+	)
+
+	// The built block is not transitioned again, its payload is only sent
+	// to the execution client.
+	require.NoError(t, s.VerifyIncomingBlock(context.Background(), blk))
+	require.Zero(t, sp.transitions)
+	require.Equal(t, 1, ee.newPayloads)
+
+	st := &testState{header: parent}
+	valUpdates, err := s.processBeaconBlock(context.Background(), st, blk)
+	require.NoError(t, err)
+	require.Zero(t, sp.transitions)
+	require.Equal(t, 1, st.applies)
+	require.Equal(t, blk.GetHeader(), st.header)
+	require.Equal(t,
+		[]*transition.ValidatorUpdate{{EffectiveBalance: 2}}, valUpdates,
+	)
+}
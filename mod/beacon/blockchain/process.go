@@ -49,7 +49,7 @@ func (s *Service[
 		DepositT, *types.ExecutionPayloadHeaderDeneb,
 	],
 ) ([]*transition.ValidatorUpdate, error) {
-	return s.sp.InitializePreminedBeaconStateFromEth1(
+	valUpdates, err := s.sp.InitializePreminedBeaconStateFromEth1(
 		s.sb.StateFromContext(ctx),
 		genesisData.Deposits,
 		&types.ExecutionPayloadHeader{
@@ -57,6 +57,17 @@ func (s *Service[
 		},
 		genesisData.ForkVersion,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The genesis execution block is the first finalized execution block.
+	if err = s.finalizeForkchoice(
+		0, genesisData.ExecutionPayloadHeader.GetBlockHash(),
+	); err != nil {
+		return nil, err
+	}
+	return valUpdates, nil
 }
 
 // ProcessBlockAndBlobs receives an incoming beacon block, it first validates
@@ -116,6 +127,32 @@ func (s *Service[
 		return nil, ErrDataNotAvailable
 	}
 
+	// The block has been committed by CometBFT, thus its execution payload
+	// is finalized.
+	if err := s.finalizeForkchoice(
+		blk.GetSlot(), blk.GetBody().GetExecutionPayload().GetBlockHash(),
+	); err != nil {
+		return nil, err
+	}
+
+	// Store the block, so that its execution payload can be replayed to the
+	// execution client should it fall behind.
+	if err := s.bs.Set(blk); err != nil {
+		return nil, err
+	}
+
+	// Record the deposits processed as of the block, so that they are
+	// pruned by the slot of the block rather than by their index.
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return nil, err
+	}
+	if err = s.sb.DepositStore(ctx).SetBlockDepositIndex(
+		blk.GetSlot(), depositIndex,
+	); err != nil {
+		return nil, err
+	}
+
 	// emit new block event
 	s.blockFeed.Send(
 		// TODO: decouple from feed package.
@@ -130,6 +167,11 @@ func (s *Service[
 
 	go s.sendPostBlockFCU(ctx, st, blk)
 
+	// The post-states cached are of the blocks of the height just
+	// finalized.
+	s.builtStates.reset()
+	s.verifiedStates.reset()
+
 	return valUpdates, nil
 }
 
@@ -149,7 +191,16 @@ func (s *Service[
 ) ([]*transition.ValidatorUpdate, error) {
 	startTime := time.Now()
 	defer s.metrics.measureStateTransitionDuration(startTime)
-	valUpdates, err := s.sp.Transition(
+
+	// The block was verified in this round, its post-state is applied
+	// rather than transitioning it again. The execution client got its
+	// payload when it was verified.
+	valUpdates, applied, err := s.applyVerifiedPostState(st, blk)
+	if err != nil || applied {
+		return valUpdates, err
+	}
+
+	valUpdates, err = s.sp.Transition(
 		&transition.Context{
 			Context:          ctx,
 			OptimisticEngine: true,
@@ -196,3 +247,51 @@ func (s *Service[
 		sidecars,
 	)
 }
+
+// applyVerifiedPostState applies the post-state of the given block to the
+// given state, if the block was verified on top of the same state. It
+// returns the validator updates of the transition of the block, and whether
+// its post-state was applied.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	DepositStoreT,
+]) applyVerifiedPostState(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) ([]*transition.ValidatorUpdate, bool, error) {
+	blockRoot, err := blk.HashTreeRoot()
+	if err != nil {
+		return nil, false, err
+	}
+	ps, ok := s.verifiedStates.get(blockRoot)
+	if !ok {
+		return nil, false, nil
+	}
+
+	parentHeader, err := latestBlockHeaderRoot(st)
+	if err != nil {
+		return nil, false, err
+	} else if parentHeader != ps.parentHeader {
+		s.logger.Warn(
+			"verified post-state is not of the state to finalize, "+
+				"transitioning block again",
+			"slot", blk.GetSlot(),
+		)
+		return nil, false, nil
+	}
+
+	if err = st.Apply(ps.st); err != nil {
+		return nil, false, err
+	}
+	s.logger.Info(
+		"applied verified post-state of block ♻️ ",
+		"slot", blk.GetSlot(),
+		"state_root", blk.GetStateRoot(),
+	)
+	return ps.valUpdates, true, nil
+}
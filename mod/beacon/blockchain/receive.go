@@ -83,12 +83,6 @@ func (s *Service[
 	// Grab a copy of the state to verify the incoming block.
 	preState := s.sb.StateFromContext(ctx)
 
-	// Force a sync of the startup head if we haven't done so already.
-	//
-	// TODO: This is a super hacky. It should be handled better elsewhere,
-	// ideally via some broader sync service.
-	s.forceStartupSyncOnce.Do(func() { s.forceStartupHead(ctx, preState) })
-
 	// If the block is nil or a nil pointer, exit early.
 	if blk.IsNil() {
 		s.logger.Warn(
@@ -102,16 +96,39 @@ func (s *Service[
 		"state_root", blk.GetStateRoot(),
 	)
 
-	// We purposefully make a copy of the BeaconState in orer
-	// to avoid modifying the underlying state, for the event in which
-	// we have to rebuild a payload for this slot again, if we do not agree
-	// with the incoming block.
-	postState := preState.Copy()
+	// A new block is proposed in every round, thus the post-states verified
+	// in the previous rounds are not going to be finalized.
+	s.verifiedStates.reset()
 
-	// Verify the state root of the incoming block.
-	if err := s.verifyStateRoot(
-		ctx, postState, blk,
-	); err != nil {
+	blockRoot, err := blk.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	parentHeader, err := latestBlockHeaderRoot(preState)
+	if err != nil {
+		return err
+	}
+
+	// The block may have been built by this node, whose post-state was then
+	// computed already.
+	ps, cached := s.builtStates.get(blockRoot)
+	transitioned := cached
+	if cached {
+		err = s.verifyCachedBlock(ctx, blk)
+	} else {
+		// We purposefully fork the BeaconState in orer
+		// to avoid modifying the underlying state, for the event in which
+		// we have to rebuild a payload for this slot again, if we do not
+		// agree with the incoming block. The changes of the block are
+		// applied to the state the block is finalized on.
+		ps.st = preState.Fork()
+
+		// Verify the state root of the incoming block.
+		ps.valUpdates, transitioned, err = s.verifyStateRoot(
+			ctx, ps.st, blk,
+		)
+	}
+	if err != nil {
 		s.logger.Error(
 			"rejecting incoming beacon block ❌ ",
 			"state_root",
@@ -127,20 +144,32 @@ func (s *Service[
 		return err
 	}
 
+	// The post-state is applied when the block is finalized, rather than
+	// transitioning the block again. The transition stops short of it if
+	// the execution client accepted the payload without validating it.
+	if transitioned {
+		ps.parentHeader = parentHeader
+		s.verifiedStates.set(blockRoot, ps)
+	}
+
 	s.logger.Info(
 		"state root verification succeeded - accepting incoming beacon block 🏎️ ",
 		"state_root",
 		blk.GetStateRoot(),
+		"cached",
+		cached,
 	)
 
 	if s.shouldBuildOptimisticPayloads() {
-		go s.handleOptimisticPayloadBuild(ctx, postState, blk)
+		go s.handleOptimisticPayloadBuild(ctx, preState, ps.st.Copy(), blk)
 	}
 
 	return nil
 }
 
-// verifyStateRoot verifies the state root of an incoming block.
+// verifyStateRoot verifies the state root of an incoming block. It returns
+// the validator updates of its transition, and whether the block was fully
+// transitioned.
 func (s *Service[
 	AvailabilityStoreT,
 	BeaconBlockT,
@@ -153,10 +182,10 @@ func (s *Service[
 	ctx context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
-) error {
+) ([]*transition.ValidatorUpdate, bool, error) {
 	startTime := time.Now()
 	defer s.metrics.measureStateRootVerificationTime(startTime)
-	if _, err := s.sp.Transition(
+	valUpdates, err := s.sp.Transition(
 		// We run with a non-optimistic engine here to ensure
 		// that the proposer does not try to push through a bad block.
 		&transition.Context{
@@ -167,18 +196,46 @@ func (s *Service[
 			SkipValidateRandao:      false,
 		},
 		st, blk,
-	); errors.Is(err, engineerrors.ErrAcceptedPayloadStatus) {
+	)
+	if errors.Is(err, engineerrors.ErrAcceptedPayloadStatus) {
 		// It is safe for the validator to ignore this error since
 		// the state transition will enforce that the block is part
 		// of the canonical chain.
 		//
 		// TODO: this is only true because we are assuming SSF.
-		return nil
+		return nil, false, nil
 	} else if err != nil {
-		return err
+		return nil, false, err
 	}
 
-	return nil
+	return valUpdates, true, nil
+}
+
+// verifyCachedBlock verifies an incoming block that was built by this node,
+// whose post-state was computed when it was built. The transition then
+// skipped the verification of the execution payload, which is only left to
+// the execution client, which also imports it.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) verifyCachedBlock(
+	ctx context.Context,
+	blk BeaconBlockT,
+) error {
+	startTime := time.Now()
+	defer s.metrics.measureStateRootVerificationTime(startTime)
+	err := s.notifyNewPayload(ctx, blk, false)
+	if errors.Is(err, engineerrors.ErrAcceptedPayloadStatus) {
+		// As for the transition of the block, the state transition
+		// enforces that the block is part of the canonical chain.
+		return nil
+	}
+	return err
 }
 
 // VerifyIncomingBlobs receives blobs from the network and processes them.
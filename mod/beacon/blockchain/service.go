@@ -22,7 +22,7 @@ package blockchain
 
 import (
 	"context"
-	"sync"
+	"sync/atomic"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -59,6 +59,8 @@ type Service[
 	cs primitives.ChainSpec
 	// ee is the execution engine responsible for processing execution payloads.
 	ee ExecutionEngine
+	// fcs persists the forkchoice state of the execution chain.
+	fcs ForkchoiceStore
 	// lb is a local builder for constructing new beacon states.
 	lb LocalBuilder[BeaconStateT]
 	// bp is the blob processor for processing incoming blobs.
@@ -78,8 +80,20 @@ type Service[
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
 	// builder is enabled.
 	optimisticPayloadBuilds bool
-	// forceStartupSyncOnce is used to force a sync of the startup head.
-	forceStartupSyncOnce *sync.Once
+	// bs stores the finalized blocks, whose execution payloads are replayed
+	// to an execution client that fell behind.
+	bs BlockStore[BeaconBlockT]
+	// replayWindow is the number of slots before the head whose blocks are
+	// retained by the block store, and thus can be replayed.
+	replayWindow uint64
+	// executionSynced is true if the execution client is consistent with
+	// the beacon chain.
+	executionSynced atomic.Bool
+	// builtStates caches the post-states of the blocks built by this node.
+	builtStates *postStateCache[BeaconStateT]
+	// verifiedStates caches the post-states of the blocks verified in the
+	// current round, which are applied when the same block is finalized.
+	verifiedStates *postStateCache[BeaconStateT]
 }
 
 // NewService creates a new validator service.
@@ -105,6 +119,9 @@ func NewService[
 	logger log.Logger[any],
 	cs primitives.ChainSpec,
 	ee ExecutionEngine,
+	fcs ForkchoiceStore,
+	bs BlockStore[BeaconBlockT],
+	replayWindow uint64,
 	lb LocalBuilder[BeaconStateT],
 	bp BlobProcessor[
 		AvailabilityStoreT,
@@ -122,7 +139,7 @@ func NewService[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
 	BlobSidecarsT, DepositT, DepositStoreT,
 ] {
-	return &Service[
+	s := &Service[
 		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
 		BlobSidecarsT, DepositT, DepositStoreT,
 	]{
@@ -130,14 +147,22 @@ func NewService[
 		logger:                  logger,
 		cs:                      cs,
 		ee:                      ee,
+		fcs:                     fcs,
+		bs:                      bs,
+		replayWindow:            replayWindow,
 		lb:                      lb,
 		bp:                      bp,
 		sp:                      sp,
 		metrics:                 newChainMetrics(ts),
 		blockFeed:               blockFeed,
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
-		forceStartupSyncOnce:    new(sync.Once),
+		builtStates:             newPostStateCache[BeaconStateT](),
+		verifiedStates:          newPostStateCache[BeaconStateT](),
 	}
+	// The execution client is assumed to be synced unless it is being
+	// reconciled with the beacon chain.
+	s.executionSynced.Store(true)
+	return s
 }
 
 // Name returns the name of the service.
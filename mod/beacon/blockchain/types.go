@@ -22,6 +22,7 @@ package blockchain
 
 import (
 	"context"
+	"math/big"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -46,6 +47,8 @@ type AvailabilityStore[BeaconBlockBodyT any, BlobSidecarsT any] interface {
 	// Persist makes sure that the sidecar remains accessible for data
 	// availability checks throughout the beacon node's operation.
 	Persist(math.Slot, BlobSidecarsT) error
+	// GetBlobSidecars returns the sidecars stored for the given slot.
+	GetBlobSidecars(math.Slot) (BlobSidecarsT, error)
 }
 
 // ReadOnlyBeaconState defines the interface for accessing various components of
@@ -71,11 +74,27 @@ type ReadOnlyBeaconState[T any] interface {
 	HashTreeRoot() ([32]byte, error)
 	// Copy creates a copy of the beacon state.
 	Copy() T
+	// Fork creates a copy of an in-memory fork of the beacon state, which
+	// the copy saves its changes to rather than to the beacon state.
+	Fork() T
+	// Apply writes the changes made through the given copy of the beacon
+	// state, or of an identical beacon state, to the beacon state.
+	Apply(T) error
 	// ValidatorIndexByPubkey finds the index of a validator based on their
 	// public key.
 	ValidatorIndexByPubkey(crypto.BLSPubkey) (math.ValidatorIndex, error)
 }
 
+// BlockStore stores the finalized beacon blocks.
+type BlockStore[BeaconBlockT any] interface {
+	// Get returns the block stored for the given slot.
+	Get(slot math.Slot) (BeaconBlockT, error)
+	// Set stores the given block under its slot.
+	Set(blk BeaconBlockT) error
+	// OldestSlot returns the slot of the oldest block stored.
+	OldestSlot() (math.Slot, error)
+}
+
 // BlobVerifier is the interface for the blobs processor.
 type BlobProcessor[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
@@ -109,6 +128,9 @@ type DepositStore[DepositT any] interface {
 	Prune(start, end uint64) error
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
+	// SetBlockDepositIndex records the index of the next deposit to be
+	// processed after the beacon block of the given slot.
+	SetBlockDepositIndex(slot math.Slot, index uint64) error
 }
 
 // ExecutionEngine is the interface for the execution engine.
@@ -124,6 +146,18 @@ type ExecutionEngine interface {
 		ctx context.Context,
 		req *engineprimitives.ForkchoiceUpdateRequest,
 	) (*engineprimitives.PayloadID, *common.ExecutionHash, error)
+	// HeaderByHash returns the header of the execution block with the given
+	// hash.
+	HeaderByHash(
+		ctx context.Context,
+		hash common.ExecutionHash,
+	) (*engineprimitives.Header, error)
+	// HeaderByNumber returns the header of the execution block with the
+	// given number, or of the latest execution block if the number is nil.
+	HeaderByNumber(
+		ctx context.Context,
+		number *big.Int,
+	) (*engineprimitives.Header, error)
 	// VerifyAndNotifyNewPayload verifies the new payload and notifies the
 	// execution client.
 	VerifyAndNotifyNewPayload(
@@ -140,6 +174,17 @@ type EventFeed[EventT any] interface {
 	Send(event EventT) int
 }
 
+// ForkchoiceStore persists the forkchoice state of the execution chain.
+type ForkchoiceStore interface {
+	// Get returns the latest forkchoice state along with the slot of the
+	// beacon block it was recorded for, or a nil state if none has been
+	// persisted yet.
+	Get() (math.Slot, *engineprimitives.ForkchoiceStateV1, error)
+	// Set persists the given forkchoice state, recorded for the beacon block
+	// at the given slot.
+	Set(math.Slot, *engineprimitives.ForkchoiceStateV1) error
+}
+
 // LocalBuilder is the interface for the builder service.
 type LocalBuilder[BeaconStateT any] interface {
 	// Enabled returns true if the local builder is enabled.
@@ -154,11 +199,6 @@ type LocalBuilder[BeaconStateT any] interface {
 		headEth1BlockHash common.ExecutionHash,
 		finalEth1BlockHash common.ExecutionHash,
 	) (*engineprimitives.PayloadID, error)
-	SendForceHeadFCU(
-		ctx context.Context,
-		st BeaconStateT,
-		slot math.Slot,
-	) error
 }
 
 // StateProcessor defines the interface for processing various state transitions
@@ -21,8 +21,12 @@
 package validator
 
 const (
-	// defaultGraffiti is the default graffiti string.
-	defaultGraffiti = ""
+	// defaultGraffiti is the default graffiti string, the client version
+	// graffiti of the execution client and beacon-kit.
+	defaultGraffiti = "{el_code}{el_commit}BK{commit}"
+
+	// defaultGraffitiFile is the default graffiti file, none.
+	defaultGraffitiFile = ""
 
 	// defaultEnableOptimisticPayloadBuilds is the default
 	// for enabling the optimistic payload builder.
@@ -33,10 +37,14 @@ const (
 //
 //nolint:lll // struct tags.
 type Config struct {
-	// Graffiti is the string that will be included in the
+	// Graffiti is the template of the string that will be included in the
 	// graffiti field of the beacon block.
 	Graffiti string `mapstructure:"graffiti"`
 
+	// GraffitiFile is the path of a file whose lines are used in turn as
+	// the graffiti template of each slot, if any.
+	GraffitiFile string `mapstructure:"graffiti-file"`
+
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
 	EnableOptimisticPayloadBuilds bool `mapstructure:"enable-optimistic-payload-builds"`
 }
@@ -45,6 +53,7 @@ type Config struct {
 func DefaultConfig() Config {
 	return Config{
 		Graffiti:                      defaultGraffiti,
+		GraffitiFile:                  defaultGraffitiFile,
 		EnableOptimisticPayloadBuilds: defaultEnableOptimisticPayloadBuilds,
 	}
 }
@@ -49,4 +49,8 @@ var (
 	// ErrNilDepositIndexStart is an error for when the deposit index start is
 	// nil.
 	ErrNilDepositIndexStart = errors.New("nil deposit index start")
+
+	// ErrExecutionClientSyncing is an error for when a block is requested
+	// while the execution client is not consistent with the beacon chain.
+	ErrExecutionClientSyncing = errors.New("execution client is syncing")
 )
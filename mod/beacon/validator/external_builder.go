@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// bidResult is the outcome of a request for the bids of external builders.
+type bidResult struct {
+	bid *types.SignedBuilderBidDeneb
+	err error
+}
+
+// retrieveExecutionPayload retrieves the execution payload for the block.
+// If external builders are enabled, their best bid is requested while the
+// local payload is retrieved, and the payload of the bid is used if it is
+// more valuable than the local one. Any failure to obtain the payload of
+// the bid falls back to the local payload.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	BlobSidecarsT, DepositStoreT, ForkDataT,
+]) retrieveExecutionPayload(
+	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
+) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
+	// Only Deneb blocks can be blinded.
+	if !s.externalBuilder.Enabled() || blk.Version() != version.Deneb {
+		return s.retrieveLocalPayload(ctx, st, blk)
+	}
+
+	// The latest execution payload header is the parent of the payload.
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	bidCh := make(chan bidResult, 1)
+	go func() {
+		bid, bidErr := s.externalBuilder.GetBid(
+			ctx, blk.GetSlot(), lph.GetBlockHash(),
+		)
+		bidCh <- bidResult{bid: bid, err: bidErr}
+	}()
+
+	local, localErr := s.retrieveLocalPayload(ctx, st, blk)
+	res := <-bidCh
+	if res.err != nil {
+		s.logger.Info(
+			"using local payload, no bid from external builders",
+			"slot", blk.GetSlot(), "reason", res.err,
+		)
+		s.metrics.markPayloadSource("local")
+		return local, localErr
+	}
+
+	bidValue := res.bid.Message.Value.UnwrapBig()
+	if localErr == nil &&
+		local.GetValue().UnwrapBig().Cmp(bidValue) >= 0 {
+		s.logger.Info(
+			"using local payload, more valuable than external bid",
+			"slot", blk.GetSlot(),
+			"local_value", local.GetValue().UnwrapBig(),
+			"bid_value", bidValue,
+		)
+		s.metrics.markPayloadSource("local")
+		return local, nil
+	}
+
+	envelope, err := s.unblindBid(ctx, st, blk, res.bid)
+	if err != nil {
+		s.logger.Warn(
+			"falling back to local payload, failed to unblind external bid",
+			"slot", blk.GetSlot(), "error", err,
+		)
+		s.metrics.markPayloadSource("local")
+		return local, localErr
+	}
+
+	s.logger.Info(
+		"using payload of external builder 🤝",
+		"slot", blk.GetSlot(),
+		"block_hash", res.bid.Message.Header.BlockHash,
+		"bid_value", bidValue,
+	)
+	s.metrics.markPayloadSource("external")
+	return envelope, nil
+}
+
+// unblindBid builds the blinded block committing to the payload of the bid
+// and exchanges it for the payload.
+//
+// NOTE: the state root of the blinded block is left empty, since it can only
+// be computed once the payload is revealed.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	BlobSidecarsT, DepositStoreT, ForkDataT,
+]) unblindBid(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	bid *types.SignedBuilderBidDeneb,
+) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.externalBuilder.Unblind(
+		ctx,
+		bid,
+		types.NewBlindedBeaconBlockDeneb(
+			blk.GetSlot(),
+			blk.GetProposerIndex(),
+			blk.GetParentBlockRoot(),
+			blk.GetBody(),
+			bid.Message.Header,
+			bid.Message.BlobKzgCommitments,
+		),
+		genesisValidatorsRoot,
+	)
+}
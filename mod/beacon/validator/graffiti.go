@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// clientVersionTTL is how long the version of the execution client is
+	// cached for.
+	clientVersionTTL = 10 * time.Minute
+	// clientVersionRetryInterval is how long a failure to retrieve the
+	// version of the execution client is cached for, so that it is not
+	// requested again on every proposal.
+	clientVersionRetryInterval = 30 * time.Second
+	// commitLength is the number of hex characters of the commits included
+	// in graffiti, as recommended for client version graffiti.
+	commitLength = 4
+	// graffitiLength is the length of the graffiti of a beacon block.
+	graffitiLength = 32
+)
+
+// GraffitiBuilder builds the graffiti of the blocks proposed by the node
+// from templates, which may include the version of beacon-kit and of the
+// execution client:
+//
+//   - {version}: the version of beacon-kit.
+//   - {commit}: the first 4 hex characters of the commit of beacon-kit.
+//   - {el_code}: the two letter code of the execution client, e.g. GE.
+//   - {el_name}: the name of the execution client.
+//   - {el_version}: the version of the execution client.
+//   - {el_commit}: the first 4 hex characters of the commit of the
+//     execution client.
+//
+// The template is the configured graffiti, unless a graffiti file is
+// configured, in which case the non-empty lines of the file are used in
+// turn, one per slot. The rendered graffiti is truncated to 32 bytes.
+type GraffitiBuilder struct {
+	// cfg is the validator config.
+	cfg *Config
+	// logger is a logger.
+	logger log.Logger[any]
+	// version is the version of beacon-kit.
+	version string
+	// commit is the commit of beacon-kit.
+	commit string
+	// clientVersions retrieves the version of the execution client.
+	clientVersions ClientVersionReader
+
+	// mu protects the cached version of the execution client.
+	mu sync.Mutex
+	// clientVersion is the cached version of the execution client.
+	clientVersion engineprimitives.ClientVersionV1
+	// clientVersionExpiry is the time the version of the execution client is
+	// retrieved again after.
+	clientVersionExpiry time.Time
+}
+
+// NewGraffitiBuilder creates a new graffiti builder.
+func NewGraffitiBuilder(
+	cfg *Config,
+	logger log.Logger[any],
+	version string,
+	commit string,
+	clientVersions ClientVersionReader,
+) *GraffitiBuilder {
+	return &GraffitiBuilder{
+		cfg:            cfg,
+		logger:         logger,
+		version:        version,
+		commit:         commit,
+		clientVersions: clientVersions,
+	}
+}
+
+// Graffiti returns the graffiti of the block proposed at the given slot.
+func (g *GraffitiBuilder) Graffiti(
+	ctx context.Context,
+	slot math.Slot,
+) bytes.B32 {
+	var graffiti bytes.B32
+	template := g.template(slot)
+	if template == "" {
+		return graffiti
+	}
+
+	copy(graffiti[:], truncate(g.render(ctx, template), graffitiLength))
+	return graffiti
+}
+
+// template returns the graffiti template of the given slot.
+func (g *GraffitiBuilder) template(slot math.Slot) string {
+	if g.cfg.GraffitiFile == "" {
+		return g.cfg.Graffiti
+	}
+
+	// The file is read for every proposal, so that it can be edited without
+	// restarting the node.
+	bz, err := os.ReadFile(g.cfg.GraffitiFile)
+	if err != nil {
+		g.logger.Error(
+			"failed to read graffiti file, using the configured graffiti",
+			"path", g.cfg.GraffitiFile, "error", err,
+		)
+		return g.cfg.Graffiti
+	}
+
+	var lines []string
+	for _, line := range strings.Split(string(bz), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) == 0 {
+		return g.cfg.Graffiti
+	}
+	return lines[slot.Unwrap()%uint64(len(lines))]
+}
+
+// render replaces the placeholders of a graffiti template.
+func (g *GraffitiBuilder) render(ctx context.Context, template string) string {
+	if !strings.Contains(template, "{") {
+		return template
+	}
+
+	clientVersion := g.getClientVersion(ctx)
+	return strings.NewReplacer(
+		"{version}", g.version,
+		"{commit}", shortCommit(g.commit),
+		"{el_code}", clientVersion.Code,
+		"{el_name}", clientVersion.Name,
+		"{el_version}", clientVersion.Version,
+		"{el_commit}", shortCommit(clientVersion.Commit),
+	).Replace(template)
+}
+
+// getClientVersion returns the version of the execution client, retrieving
+// it if the cached one is stale. The cached version is kept if it cannot be
+// retrieved, and it is not retrieved again before the retry interval.
+func (g *GraffitiBuilder) getClientVersion(
+	ctx context.Context,
+) engineprimitives.ClientVersionV1 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.clientVersions == nil || time.Now().Before(g.clientVersionExpiry) {
+		return g.clientVersion
+	}
+
+	versions, err := g.clientVersions.GetClientVersionV1(ctx)
+	if err != nil || len(versions) == 0 {
+		g.clientVersionExpiry = time.Now().Add(clientVersionRetryInterval)
+		g.logger.Warn(
+			"failed to get the version of the execution client for graffiti",
+			"error", err,
+		)
+		return g.clientVersion
+	}
+	g.clientVersion = versions[0]
+	g.clientVersionExpiry = time.Now().Add(clientVersionTTL)
+	return g.clientVersion
+}
+
+// shortCommit returns the first characters of a hex encoded commit.
+func shortCommit(commit string) string {
+	commit = strings.TrimPrefix(commit, "0x")
+	if len(commit) > commitLength {
+		return commit[:commitLength]
+	}
+	return commit
+}
+
+// truncate truncates a string to at most n bytes, without splitting a rune.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
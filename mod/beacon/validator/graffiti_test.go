@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// clientVersionReader is a ClientVersionReader counting its calls.
+type clientVersionReader struct {
+	calls int
+	err   error
+}
+
+func (r *clientVersionReader) GetClientVersionV1(
+	context.Context,
+) ([]engineprimitives.ClientVersionV1, error) {
+	r.calls++
+	return []engineprimitives.ClientVersionV1{{
+		Code:    "GE",
+		Name:    "Geth",
+		Version: "1.14.5",
+		Commit:  "0x0dd173a7",
+	}}, r.err
+}
+
+func graffiti(s string) bytes.B32 {
+	var b bytes.B32
+	copy(b[:], s)
+	return b
+}
+
+func TestGraffitiBuilder(t *testing.T) {
+	tests := []struct {
+		name     string
+		graffiti string
+		expected bytes.B32
+	}{
+		{
+			name:     "empty",
+			graffiti: "",
+			expected: bytes.B32{},
+		},
+		{
+			name:     "plain",
+			graffiti: "hello berachain",
+			expected: graffiti("hello berachain"),
+		},
+		{
+			name:     "client version",
+			graffiti: validator.DefaultConfig().Graffiti,
+			expected: graffiti("GE0dd1BKa1b2"),
+		},
+		{
+			name:     "all placeholders",
+			graffiti: "{el_name} {el_version} bk {version}",
+			expected: graffiti("Geth 1.14.5 bk v0.1.0"),
+		},
+		{
+			name:     "truncated",
+			graffiti: "{el_name} 0123456789012345678901234567",
+			expected: graffiti("Geth 012345678901234567890123456"),
+		},
+		{
+			name:     "truncated at rune",
+			graffiti: "0123456789012345678901234567890🐻",
+			expected: graffiti("0123456789012345678901234567890"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validator.DefaultConfig()
+			cfg.Graffiti = tt.graffiti
+			builder := validator.NewGraffitiBuilder(
+				&cfg, noop.NewLogger(), "v0.1.0", "a1b2c3d4",
+				&clientVersionReader{},
+			)
+			require.Equal(
+				t, tt.expected, builder.Graffiti(context.Background(), 1),
+			)
+		})
+	}
+}
+
+func TestGraffitiBuilderClientVersionCache(t *testing.T) {
+	cfg := validator.DefaultConfig()
+	reader := &clientVersionReader{err: errors.New("unavailable")}
+	builder := validator.NewGraffitiBuilder(
+		&cfg, noop.NewLogger(), "", "", reader,
+	)
+
+	// A failure to retrieve the version is cached, so that the version is
+	// not requested on every proposal.
+	require.Equal(t, graffiti("BK"), builder.Graffiti(context.Background(), 1))
+	reader.err = nil
+	require.Equal(t, graffiti("BK"), builder.Graffiti(context.Background(), 2))
+	require.Equal(t, 1, reader.calls)
+
+	// A retrieved version is cached as well.
+	reader = &clientVersionReader{}
+	builder = validator.NewGraffitiBuilder(
+		&cfg, noop.NewLogger(), "", "", reader,
+	)
+	require.Equal(
+		t, graffiti("GE0dd1BK"), builder.Graffiti(context.Background(), 1),
+	)
+	require.Equal(
+		t, graffiti("GE0dd1BK"), builder.Graffiti(context.Background(), 2),
+	)
+	require.Equal(t, 1, reader.calls)
+}
+
+func TestGraffitiBuilderFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "graffiti.txt")
+	require.NoError(t, os.WriteFile(
+		path, []byte("first\n\n  second  \n{el_code}\n"), 0o600,
+	))
+
+	cfg := validator.DefaultConfig()
+	cfg.Graffiti = "fallback"
+	cfg.GraffitiFile = path
+	builder := validator.NewGraffitiBuilder(
+		&cfg, noop.NewLogger(), "", "", &clientVersionReader{},
+	)
+
+	for slot, expected := range []string{"first", "second", "GE", "first"} {
+		require.Equal(t, graffiti(expected), builder.Graffiti(
+			context.Background(), math.Slot(slot),
+		))
+	}
+
+	// The configured graffiti is used if the file cannot be read.
+	require.NoError(t, os.Remove(path))
+	require.Equal(
+		t, graffiti("fallback"), builder.Graffiti(context.Background(), 0),
+	)
+}
@@ -74,3 +74,11 @@ func (cm *validatorMetrics) failedToRetrievePayload(
 		err.Error(),
 	)
 }
+
+// markPayloadSource increments the counter for the number of blocks built
+// with a payload from the given source, either local or external.
+func (cm *validatorMetrics) markPayloadSource(source string) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.payload_source", "source", source,
+	)
+}
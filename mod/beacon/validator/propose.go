@@ -31,6 +31,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"golang.org/x/sync/errgroup"
 )
@@ -54,12 +55,21 @@ func (s *Service[
 	defer s.metrics.measureRequestBlockForProposalTime(startTime)
 	s.logger.Info("requesting beacon block assembly 🙈", "slot", requestedSlot)
 
+	// Blocks are not built on top of an execution client that is missing
+	// the execution blocks of the beacon chain.
+	if !s.forkchoice.IsExecutionSynced() {
+		return blk, sidecars, ErrExecutionClientSyncing
+	}
+
 	// The goal here is to acquire a payload whose parent is the previously
 	// finalized block, such that, if this payload is accepted, it will be
 	// the next finalized block in the chain. A byproduct of this design
 	// is that we get the nice property of lazily propogating the finalized
 	// and safe block hashes to the execution client.
-	st := s.bsb.StateFromContext(ctx)
+	//
+	// The block is built on an in-memory fork of the state, whose
+	// post-state is shared with the verification of the block.
+	st := s.bsb.StateFromContext(ctx).Fork()
 
 	// Prepare the state such that it is ready to build a block for
 	// the request slot
@@ -91,22 +101,8 @@ func (s *Service[
 	// Set the reveal on the block body.
 	body.SetRandaoReveal(reveal)
 
-	// Get the payload for the block.
-	envelope, err := s.retrieveExecutionPayload(ctx, st, blk)
-	if err != nil {
-		return blk, sidecars, err
-	} else if envelope == nil {
-		return blk, sidecars, ErrNilPayload
-	}
-
-	// If we get returned a nil blobs bundle, we should return an error.
-	blobsBundle := envelope.GetBlobsBundle()
-	if blobsBundle == nil {
-		return blk, sidecars, ErrNilBlobsBundle
-	}
-
-	// Set the KZG commitments on the block body.
-	body.SetBlobKzgCommitments(blobsBundle.GetCommitments())
+	// Set the graffiti on the block body.
+	body.SetGraffiti(s.graffitiBuilder.Graffiti(ctx, requestedSlot))
 
 	depositIndex, err := st.GetEth1DepositIndex()
 	if err != nil {
@@ -125,9 +121,6 @@ func (s *Service[
 	// Set the deposits on the block body.
 	body.SetDeposits(deposits)
 
-	// Set the KZG commitments on the block body.
-	body.SetBlobKzgCommitments(blobsBundle.GetCommitments())
-
 	// TODO: assemble real eth1data.
 	body.SetEth1Data(&types.Eth1Data{
 		DepositRoot:  primitives.Bytes32{},
@@ -135,6 +128,25 @@ func (s *Service[
 		BlockHash:    common.ZeroHash,
 	})
 
+	// Get the payload for the block. The rest of the body must be assembled
+	// by now, since the blinded block sent to external builders commits to
+	// it.
+	envelope, err := s.retrieveExecutionPayload(ctx, st, blk)
+	if err != nil {
+		return blk, sidecars, err
+	} else if envelope == nil {
+		return blk, sidecars, ErrNilPayload
+	}
+
+	// If we get returned a nil blobs bundle, we should return an error.
+	blobsBundle := envelope.GetBlobsBundle()
+	if blobsBundle == nil {
+		return blk, sidecars, ErrNilBlobsBundle
+	}
+
+	// Set the KZG commitments on the block body.
+	body.SetBlobKzgCommitments(blobsBundle.GetCommitments())
+
 	// Set the execution data.
 	if err = body.SetExecutionData(
 		envelope.GetExecutionPayload(),
@@ -142,6 +154,15 @@ func (s *Service[
 		return blk, sidecars, err
 	}
 
+	// Set the execution requests, which are only returned from Electra.
+	executionRequests, err := engineprimitives.DecodeExecutionRequests(
+		envelope.GetExecutionRequests(),
+	)
+	if err != nil {
+		return blk, sidecars, err
+	}
+	body.SetExecutionRequests(executionRequests)
+
 	// Produce block sidecars.
 	g.Go(func() error {
 		var sidecarErr error
@@ -152,14 +173,24 @@ func (s *Service[
 		return sidecarErr
 	})
 
+	var valUpdates []*transition.ValidatorUpdate
 	g.Go(func() error {
-		return s.computeAndSetStateRoot(ctx, st, blk)
+		var stateRootErr error
+		valUpdates, stateRootErr = s.computeAndSetStateRoot(ctx, st, blk)
+		return stateRootErr
 	})
 
 	if err = g.Wait(); err != nil {
 		return blk, sidecars, err
 	}
 
+	// The block is complete, its post-state is that of the block verified.
+	blockRoot, err := blk.HashTreeRoot()
+	if err != nil {
+		return blk, sidecars, err
+	}
+	s.postStates.CachePostState(blockRoot, st, valUpdates)
+
 	s.logger.Info(
 		"beacon block successfully built 🛠️ ",
 		"slot", requestedSlot,
@@ -224,10 +255,11 @@ func (s *Service[
 	}
 
 	epoch := s.chainSpec.SlotToEpoch(slot)
+	forkVersion := version.FromUint32[primitives.Version](
+		s.chainSpec.ActiveForkVersionForEpoch(epoch),
+	)
 	signingRoot, err := forkData.New(
-		version.FromUint32[primitives.Version](
-			s.chainSpec.ActiveForkVersionForEpoch(epoch),
-		), genesisValidatorsRoot,
+		forkVersion, genesisValidatorsRoot,
 	).ComputeRandaoSigningRoot(
 		s.chainSpec.DomainTypeRandao(),
 		epoch,
@@ -236,24 +268,29 @@ func (s *Service[
 	if err != nil {
 		return crypto.BLSSignature{}, err
 	}
+
+	if signer, ok := s.signer.(RandaoSigner); ok {
+		return signer.SignRandaoReveal(
+			forkVersion, genesisValidatorsRoot, epoch, signingRoot,
+		)
+	}
 	return s.signer.Sign(signingRoot[:])
 }
 
-// retrieveExecutionPayload retrieves the execution payload for the block.
+// retrieveLocalPayload retrieves the execution payload for the block from
+// the local builder.
 func (s *Service[
 	BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
 	BlobSidecarsT, DepositStoreT, ForkDataT,
-]) retrieveExecutionPayload(
+]) retrieveLocalPayload(
 	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
 ) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
-	//
-	// TODO: Add external block builders to this flow.
-	//
 	// Get the payload for the block.
 	envelope, err := s.localPayloadBuilder.
 		RetrievePayload(
 			ctx,
 			blk.GetSlot(),
+			blk.GetProposerIndex(),
 			blk.GetParentBlockRoot(),
 		)
 	if err != nil {
@@ -270,6 +307,12 @@ func (s *Service[
 			return nil, err
 		}
 
+		var fcs *engineprimitives.ForkchoiceStateV1
+		fcs, err = s.forkchoice.ForkchoiceState(st)
+		if err != nil {
+			return nil, err
+		}
+
 		// If we failed to retrieve the payload, request a synchrnous payload.
 		//
 		// NOTE: The state here is properly configured by the
@@ -282,6 +325,7 @@ func (s *Service[
 			ctx,
 			st,
 			blk.GetSlot(),
+			blk.GetProposerIndex(),
 			// TODO: this is hood.
 			max(
 				//#nosec:G701
@@ -289,8 +333,8 @@ func (s *Service[
 				uint64((lph.GetTimestamp()+1)),
 			),
 			blk.GetParentBlockRoot(),
-			lph.GetBlockHash(),
-			lph.GetParentHash(),
+			fcs.HeadBlockHash,
+			fcs.FinalizedBlockHash,
 		)
 	}
 	return envelope, nil
@@ -77,6 +77,12 @@ type Service[
 		BeaconStateT,
 		*transition.Context,
 	]
+	// forkchoice provides the forkchoice state of the execution chain that
+	// payloads are built on.
+	forkchoice ForkchoiceReader[BeaconStateT]
+	// postStates caches the post-states of the blocks built, which are
+	// shared with their verification.
+	postStates PostStateCache[BeaconStateT]
 	// localPayloadBuilder represents the local block builder, this builder
 	// is connected to this nodes execution client via the EngineAPI.
 	// Building blocks is done by submitting forkchoice updates through.
@@ -85,6 +91,11 @@ type Service[
 	// remotePayloadBuilders represents a list of remote block builders, these
 	// builders are connected to other execution clients via the EngineAPI.
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, *types.ExecutionPayload]
+	// externalBuilder sources payloads from external block builders, whose
+	// bids compete with the payload of the local builder.
+	externalBuilder ExternalBuilder
+	// graffitiBuilder builds the graffiti of the proposed blocks.
+	graffitiBuilder *GraffitiBuilder
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
 }
@@ -122,8 +133,12 @@ func NewService[
 	blobFactory BlobFactory[
 		BeaconBlockT, BeaconBlockBodyT, BlobSidecarsT,
 	],
+	forkchoice ForkchoiceReader[BeaconStateT],
+	postStates PostStateCache[BeaconStateT],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, *types.ExecutionPayload],
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, *types.ExecutionPayload],
+	externalBuilder ExternalBuilder,
+	graffitiBuilder *GraffitiBuilder,
 	ts TelemetrySink,
 ) *Service[
 	BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
@@ -141,8 +156,12 @@ func NewService[
 		signer:                signer,
 		stateProcessor:        stateProcessor,
 		blobFactory:           blobFactory,
+		forkchoice:            forkchoice,
+		postStates:            postStates,
 		localPayloadBuilder:   localPayloadBuilder,
 		remotePayloadBuilders: remotePayloadBuilders,
+		externalBuilder:       externalBuilder,
+		graffitiBuilder:       graffitiBuilder,
 		metrics:               newValidatorMetrics(ts),
 	}
 }
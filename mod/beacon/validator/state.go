@@ -29,7 +29,8 @@ import (
 )
 
 // computeAndSetStateRoot computes the state root of an outgoing block
-// and sets it in the block.
+// and sets it in the block. It returns the validator updates of the
+// transition of the block.
 func (s *Service[
 	BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
 	BlobSidecarsT, DepositStoreT, ForkDataT,
@@ -37,21 +38,20 @@ func (s *Service[
 	ctx context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
-) error {
+) ([]*transition.ValidatorUpdate, error) {
 	s.logger.Info(
 		"computing state root for block 🌲",
 		"slot", blk.GetSlot(),
 	)
 
-	var stateRoot primitives.Root
-	stateRoot, err := s.computeStateRoot(ctx, st, blk)
+	stateRoot, valUpdates, err := s.computeStateRoot(ctx, st, blk)
 	if err != nil {
 		s.logger.Error(
 			"failed to compute state root while building block ❗️ ",
 			"slot", blk.GetSlot(),
 			"error", err,
 		)
-		return err
+		return nil, err
 	}
 
 	s.logger.Info("state root computed for block 💻 ",
@@ -59,10 +59,11 @@ func (s *Service[
 		"state_root", stateRoot,
 	)
 	blk.SetStateRoot(stateRoot)
-	return nil
+	return valUpdates, nil
 }
 
-// computeStateRoot computes the state root of an outgoing block.
+// computeStateRoot computes the state root of an outgoing block, and returns
+// the validator updates of its transition.
 func (s *Service[
 	BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
 	BlobSidecarsT, DepositStoreT, ForkDataT,
@@ -70,10 +71,10 @@ func (s *Service[
 	ctx context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
-) (primitives.Root, error) {
+) (primitives.Root, []*transition.ValidatorUpdate, error) {
 	startTime := time.Now()
 	defer s.metrics.measureStateRootComputationTime(startTime)
-	if _, err := s.stateProcessor.Transition(
+	valUpdates, err := s.stateProcessor.Transition(
 		// TODO: We should think about how having optimistic
 		// engine enabled here would affect the proposer when
 		// the payload in their block has come from a remote builder.
@@ -85,9 +86,11 @@ func (s *Service[
 			SkipValidateRandao:      true,
 		},
 		st, blk,
-	); err != nil {
-		return primitives.Root{}, err
+	)
+	if err != nil {
+		return primitives.Root{}, nil, err
 	}
 
-	return st.HashTreeRoot()
+	stateRoot, err := st.HashTreeRoot()
+	return stateRoot, valUpdates, err
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
@@ -58,6 +59,8 @@ type BeaconBlock[BeaconBlockT any, BeaconBlockBodyT BeaconBlockBody[
 	GetProposerIndex() math.ValidatorIndex
 	// GetParentBlockRoot returns the parent block root of the beacon block.
 	GetParentBlockRoot() common.Root
+	// HashTreeRoot returns the hash tree root of the block.
+	HashTreeRoot() ([32]byte, error)
 	// SetStateRoot sets the state root of the beacon block.
 	SetStateRoot(common.Root)
 	// GetStateRoot returns the state root of the beacon block.
@@ -74,10 +77,18 @@ type BeaconBlockBody[
 	ssz.Marshallable
 	// IsNil checks if the beacon block body is nil.
 	IsNil() bool
+	// GetRandaoReveal returns the Randao reveal of the beacon block body.
+	GetRandaoReveal() crypto.BLSSignature
 	// SetRandaoReveal sets the Randao reveal of the beacon block body.
 	SetRandaoReveal(crypto.BLSSignature)
+	// GetEth1Data returns the Eth1 data of the beacon block body.
+	GetEth1Data() Eth1DataT
 	// SetEth1Data sets the Eth1 data of the beacon block body.
 	SetEth1Data(Eth1DataT)
+	// GetGraffiti returns the graffiti of the beacon block body.
+	GetGraffiti() bytes.B32
+	// SetGraffiti sets the graffiti of the beacon block body.
+	SetGraffiti(bytes.B32)
 	// GetDeposits returns the deposits of the beacon block body.
 	GetDeposits() []DepositT
 	// SetDeposits sets the deposits of the beacon block body.
@@ -93,6 +104,9 @@ type BeaconBlockBody[
 	// GetExecutionPayload returns the execution payload of the beacon block
 	// body.
 	GetExecutionPayload() ExecutionPayloadT
+	// SetExecutionRequests sets the execution requests of the beacon block
+	// body.
+	SetExecutionRequests(*engineprimitives.ExecutionRequests)
 }
 
 // BeaconState represents a beacon state interface.
@@ -102,6 +116,9 @@ type BeaconState[
 ] interface {
 	// Copy creates a copy of the beacon state.
 	Copy() BeaconStateT
+	// Fork creates a copy of an in-memory fork of the beacon state, which
+	// the copy saves its changes to rather than to the beacon state.
+	Fork() BeaconStateT
 	// GetBlockRootAtIndex returns the block root at the given index.
 	GetBlockRootAtIndex(uint64) (primitives.Root, error)
 	// GetLatestExecutionPayloadHeader returns the latest execution payload
@@ -163,6 +180,15 @@ type BlobSidecars interface {
 	Len() int
 }
 
+// ClientVersionReader retrieves the version of the execution client.
+type ClientVersionReader interface {
+	// GetClientVersionV1 returns the versions of the execution client, as
+	// returned by engine_getClientVersionV1.
+	GetClientVersionV1(
+		ctx context.Context,
+	) ([]engineprimitives.ClientVersionV1, error)
+}
+
 // DepositStore defines the interface for deposit storage.
 type DepositStore[DepositT any] interface {
 	// GetDepositsByIndex returns `numView` expected deposits.
@@ -172,15 +198,52 @@ type DepositStore[DepositT any] interface {
 	) ([]DepositT, error)
 }
 
+// ExternalBuilder represents a service that sources execution payloads from
+// external block builders through their relays.
+type ExternalBuilder interface {
+	// Enabled returns true if payloads are sourced from external builders.
+	Enabled() bool
+	// GetBid returns the most valuable bid of the external builders for the
+	// payload of the given slot built on top of the given parent.
+	GetBid(
+		ctx context.Context,
+		slot math.Slot,
+		parentHash common.ExecutionHash,
+	) (*types.SignedBuilderBidDeneb, error)
+	// Unblind signs the blinded block committing to the payload of the bid
+	// and returns the payload revealed in exchange.
+	Unblind(
+		ctx context.Context,
+		bid *types.SignedBuilderBidDeneb,
+		blk *types.BlindedBeaconBlockDeneb,
+		genesisValidatorsRoot common.Root,
+	) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error)
+}
+
+// ForkchoiceReader represents a service that tracks the forkchoice state of
+// the execution chain.
+type ForkchoiceReader[BeaconStateT any] interface {
+	// ForkchoiceState returns the forkchoice state of the execution chain as
+	// of the latest block committed to the given state.
+	ForkchoiceState(
+		st BeaconStateT,
+	) (*engineprimitives.ForkchoiceStateV1, error)
+	// IsExecutionSynced returns true if the execution client is consistent
+	// with the beacon chain.
+	IsExecutionSynced() bool
+}
+
 // PayloadBuilder represents a service that is responsible for
 // building eth1 blocks.
 type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 	// Enabled returns true if the payload builder is enabled.
 	Enabled() bool
-	// RetrievePayload retrieves the payload for the given slot.
+	// RetrievePayload retrieves the payload for the given slot, built for
+	// the proposer with the given validator index.
 	RetrievePayload(
 		ctx context.Context,
 		slot math.Slot,
+		proposerIndex math.ValidatorIndex,
 		parentBlockRoot primitives.Root,
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 	// RequestPayloadAsync requests a payload for the given slot and returns
@@ -194,12 +257,14 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 		headEth1BlockHash common.ExecutionHash,
 		finalEth1BlockHash common.ExecutionHash,
 	) (*engineprimitives.PayloadID, error)
-	// RequestPayloadSync requests a payload for the given slot and
-	// blocks until the payload is delivered.
+	// RequestPayloadSync requests a payload for the given slot, for the
+	// proposer with the given validator index, and blocks until the payload
+	// is delivered.
 	RequestPayloadSync(
 		ctx context.Context,
 		st BeaconStateT,
 		slot math.Slot,
+		proposerIndex math.ValidatorIndex,
 		timestamp uint64,
 		parentBlockRoot primitives.Root,
 		headEth1BlockHash common.ExecutionHash,
@@ -208,11 +273,37 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 	// SendForceHeadFCU sends a force head FCU to the execution client.
 	SendForceHeadFCU(
 		ctx context.Context,
-		st BeaconStateT,
 		slot math.Slot,
+		headEth1BlockHash common.ExecutionHash,
+		finalEth1BlockHash common.ExecutionHash,
 	) error
 }
 
+// PostStateCache caches the post-states of the blocks built, so that they
+// are not transitioned again when they are verified.
+type PostStateCache[BeaconStateT any] interface {
+	// CachePostState caches the post-state of the block of the given root
+	// and the validator updates of its transition. The state must not be
+	// changed afterwards.
+	CachePostState(
+		blockRoot common.Root,
+		st BeaconStateT,
+		valUpdates []*transition.ValidatorUpdate,
+	)
+}
+
+// RandaoSigner is a BLS signer of typed randao reveals, e.g. guarding them
+// with a slashing protection database or sending them to a remote signer.
+type RandaoSigner interface {
+	// SignRandaoReveal signs the randao reveal of the given epoch.
+	SignRandaoReveal(
+		forkVersion common.Version,
+		genesisValidatorsRoot common.Root,
+		epoch math.Epoch,
+		signingRoot common.Root,
+	) (crypto.BLSSignature, error)
+}
+
 // StateProcessor defines the interface for processing the state.
 type StateProcessor[
 	BeaconBlockT any,
@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobs
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/archive"
+	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	beaconconfig "github.com/berachain/beacon-kit/mod/node-core/pkg/config"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// Commands creates a new command for blob sidecar related actions.
+func Commands(chainSpec primitives.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "blobs",
+		Short:                      "blob sidecar subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewExportCommand(chainSpec),
+		NewImportCommand(chainSpec),
+	)
+
+	return cmd
+}
+
+// NewExportCommand creates a new command for exporting blob sidecars from the
+// availability store into an archive.
+func NewExportCommand(chainSpec primitives.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports blob sidecars from the store into an archive",
+		Long: `Exports the blob sidecars of every slot in the given range from
+the availability store into a directory. The directory holds one file with the
+SSZ-encoded sidecars per slot and an index.json file describing them.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			from, err := cmd.Flags().GetUint64(fromSlot)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetUint64(toSlot)
+			if err != nil {
+				return err
+			}
+			out, err := cmd.Flags().GetString(outputDir)
+			if err != nil {
+				return err
+			}
+
+			store, _, err := openAvailabilityStore(cmd, chainSpec)
+			if err != nil {
+				return err
+			}
+
+			index, err := archive.Export(
+				afero.NewOsFs(), out, store, math.Slot(from), math.Slot(to),
+			)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf(
+				"Successfully exported blob sidecars of %d slots to: %s\n",
+				len(index.Entries), out,
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(fromSlot, defaultFromSlot, fromSlotMsg)
+	cmd.Flags().Uint64(toSlot, defaultToSlot, toSlotMsg)
+	cmd.Flags().String(outputDir, "", outputDirMsg)
+	if err := cmd.MarkFlagRequired(outputDir); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewImportCommand creates a new command for importing blob sidecars from an
+// archive into the availability store.
+func NewImportCommand(chainSpec primitives.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Imports blob sidecars from an archive into the store",
+		Long: `Imports the blob sidecars of an archive created by the export
+command into the availability store. The inclusion and KZG proofs of every
+sidecar are verified before it is written. Unless archive mode is enabled,
+imported sidecars outside of the data availability period are pruned again
+once the node is running.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			in, err := cmd.Flags().GetString(inputDir)
+			if err != nil {
+				return err
+			}
+
+			store, verifier, err := openAvailabilityStore(cmd, chainSpec)
+			if err != nil {
+				return err
+			}
+
+			index, err := archive.Import(
+				afero.NewOsFs(), in, verifier, store,
+				func(slot math.Slot) uint64 {
+					return types.BlockBodyKZGOffset(slot, chainSpec)
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf(
+				"Successfully imported blob sidecars of %d slots from: %s\n",
+				len(index.Entries), in,
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(inputDir, "", inputDirMsg)
+	if err := cmd.MarkFlagRequired(inputDir); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// openAvailabilityStore opens the availability store of the node along with
+// the blob verifier, as configured in its home directory. It fails if the
+// store is in use by a running node.
+func openAvailabilityStore(
+	cmd *cobra.Command,
+	chainSpec primitives.ChainSpec,
+) (*dastore.Store[*types.BeaconBlockBody], *dablob.Verifier, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	cfg, err := beaconconfig.ReadConfigFromAppOpts(serverCtx.Viper)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	trustedSetup, err := components.ReadTrustedSetup(cfg.KZG.TrustedSetupPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	proofVerifier, err := kzg.NewBlobProofVerifier(
+		cfg.KZG.Implementation, trustedSetup,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sink := &metrics.TelemetrySink{}
+	store, err := components.NewAvailabilityStore[*types.BeaconBlockBody](
+		&cfg.AvailabilityStore,
+		serverCtx.Config.RootDir,
+		chainSpec,
+		proofVerifier,
+		serverCtx.Logger,
+		sink,
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to open availability store")
+	}
+	return store, dablob.NewVerifier(proofVerifier, sink), nil
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobs
+
+const (
+	// fromSlot is the flag for the first slot to export.
+	fromSlot = "from-slot"
+
+	// toSlot is the flag for the last slot to export.
+	toSlot = "to-slot"
+
+	// outputDir is the flag for the directory the archive is written to.
+	outputDir = "out"
+
+	// inputDir is the flag for the directory the archive is read from.
+	inputDir = "in"
+)
+
+const (
+	// defaultFromSlot is the default value for the fromSlot flag.
+	defaultFromSlot = 0
+
+	// defaultToSlot is the default value for the toSlot flag.
+	defaultToSlot = ^uint64(0)
+)
+
+const (
+	// fromSlotMsg is the usage description for the fromSlot flag.
+	fromSlotMsg = "first slot to export (inclusive)"
+
+	// toSlotMsg is the usage description for the toSlot flag.
+	toSlotMsg = "last slot to export (inclusive)"
+
+	// outputDirMsg is the usage description for the outputDir flag.
+	outputDirMsg = "directory to write the archive to"
+
+	// inputDirMsg is the usage description for the inputDir flag.
+	inputDirMsg = "directory to read the archive from"
+)
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// Commands creates a new command for database related actions.
+func Commands(chainSpec primitives.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "db",
+		Short:                      "database subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewInspectCommand(chainSpec),
+		NewCompareExecutionCommand(),
+	)
+
+	return cmd
+}
+
+// NewInspectCommand creates a new command for inspecting the beacon state
+// stored in the application database.
+func NewInspectCommand(chainSpec primitives.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect",
+		Short: "Inspects the beacon state stored in the application database",
+		Long: `Reads the beacon state from the application database of the
+node. The database is opened at the given height and nothing is ever written
+back. The node should be stopped while inspecting its database.`,
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.PersistentFlags().Int64(height, defaultHeight, heightMsg)
+	cmd.AddCommand(
+		NewListCollectionsCommand(),
+		NewCollectionCommand(),
+		NewStateCommand(chainSpec),
+	)
+
+	return cmd
+}
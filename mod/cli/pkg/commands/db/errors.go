@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidFormat is returned when the requested state encoding is not
+	// supported.
+	ErrInvalidFormat = errors.New("invalid format, expected json or ssz")
+
+	// ErrHeightNotFound is returned when the requested height is not
+	// available in the database.
+	ErrHeightNotFound = errors.New("height not found")
+
+	// ErrReadOnlyDB is returned when writing to a database opened for
+	// inspection.
+	ErrReadOnlyDB = errors.New("database is opened read-only")
+
+	// ErrInvalidRange is returned when the requested range of heights is
+	// empty or starts before the first block.
+	ErrInvalidRange = errors.New("invalid range of heights")
+
+	// ErrExecutionChainDiverged is returned when the canonical chain of the
+	// execution client diverges from the beacon chain.
+	ErrExecutionChainDiverged = errors.New(
+		"execution chain diverged from the beacon chain",
+	)
+)
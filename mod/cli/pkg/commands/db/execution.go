@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"context"
+	"math/big"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/execution"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum"
+	"github.com/spf13/cobra"
+)
+
+// maxPayloadBodiesRequestSize is the maximum number of payload bodies that
+// are requested from the execution client at once.
+const maxPayloadBodiesRequestSize = 1024
+
+// NewCompareExecutionCommand creates a new command for comparing the
+// canonical chain of the execution client against the execution payload
+// headers recorded by the beacon chain.
+func NewCompareExecutionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compare-execution",
+		Short: "Compares the execution chain against the beacon chain",
+		Long: `Compares the canonical chain of the execution client against the
+latest execution payload header recorded in the beacon state committed at
+each height of the given range, and reports every slot at which they diverge.
+If the execution client supports engine_getPayloadBodiesByHashV1, the payloads
+whose body it no longer has are reported as well. The node should be stopped
+while its database is read.`,
+		Args: cobra.NoArgs,
+		RunE: runCompareExecution,
+	}
+
+	cmd.Flags().Int64(startHeight, defaultStartHeight, startHeightMsg)
+	cmd.Flags().Int64(endHeight, defaultEndHeight, endHeightMsg)
+	cmd.Flags().String(rpcURL, "", rpcURLMsg)
+	cmd.Flags().String(jwtSecret, "", jwtSecretMsg)
+	return cmd
+}
+
+// runCompareExecution runs the compare-execution command.
+func runCompareExecution(cmd *cobra.Command, _ []string) error {
+	start, err := cmd.Flags().GetInt64(startHeight)
+	if err != nil {
+		return err
+	}
+	end, err := cmd.Flags().GetInt64(endHeight)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	client, err := dialExecutionClient(cmd)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	db, err := openAppDB(cmd)
+	if err != nil {
+		return err
+	}
+	defer db.Close() //nolint:errcheck // read-only.
+
+	if end == 0 {
+		end = db.LatestHeight()
+	}
+	if start < 1 || start > end {
+		return ErrInvalidRange
+	}
+
+	var (
+		diverged int
+		known    []common.ExecutionHash
+		slots    = make(map[common.ExecutionHash]math.Slot)
+	)
+	for h := start; h <= end; h++ {
+		kv, kvErr := db.BeaconStore(h)
+		if kvErr != nil {
+			return kvErr
+		}
+		slot, kvErr := kv.GetSlot()
+		if kvErr != nil {
+			return kvErr
+		}
+		header, kvErr := kv.GetLatestExecutionPayloadHeader()
+		if kvErr != nil {
+			return kvErr
+		}
+
+		hash, number := header.GetBlockHash(), header.GetNumber()
+		elHeader, elErr := client.HeaderByNumber(
+			ctx, new(big.Int).SetUint64(number.Unwrap()),
+		)
+		switch {
+		case errors.Is(elErr, ethereum.NotFound):
+			diverged++
+			cmd.Printf(
+				"slot %d: execution block %d (%s) is missing\n",
+				slot, number, hash,
+			)
+		case elErr != nil:
+			return elErr
+		case common.ExecutionHash(elHeader.Hash()) != hash:
+			diverged++
+			cmd.Printf(
+				"slot %d: execution block %d diverged, "+
+					"beacon chain has %s, execution client has %s\n",
+				slot, number, hash, elHeader.Hash(),
+			)
+		default:
+			if _, ok := slots[hash]; !ok {
+				known = append(known, hash)
+				slots[hash] = slot
+			}
+		}
+	}
+
+	pruned, err := comparePayloadBodies(ctx, cmd, client, known, slots)
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf(
+		"compared heights %d to %d: %d diverged, %d without body\n",
+		start, end, diverged, pruned,
+	)
+	if diverged > 0 {
+		return ErrExecutionChainDiverged
+	}
+	return nil
+}
+
+// comparePayloadBodies reports the given payloads whose body the execution
+// client no longer has, if it supports engine_getPayloadBodiesByHashV1, and
+// returns their number.
+func comparePayloadBodies(
+	ctx context.Context,
+	cmd *cobra.Command,
+	client *ethclient.Eth1Client[*types.ExecutionPayload],
+	hashes []common.ExecutionHash,
+	slots map[common.ExecutionHash]math.Slot,
+) (int, error) {
+	capabilities, err := client.ExchangeCapabilities(
+		ctx, ethclient.BeaconKitSupportedCapabilities(),
+	)
+	if err != nil || !slices.Contains(
+		capabilities, ethclient.GetPayloadBodiesByHashV1,
+	) {
+		cmd.PrintErrln(
+			"execution client does not serve payload bodies, " +
+				"skipping their comparison",
+		)
+		return 0, nil
+	}
+
+	var pruned int
+	for len(hashes) > 0 {
+		batch := hashes[:min(len(hashes), maxPayloadBodiesRequestSize)]
+		hashes = hashes[len(batch):]
+
+		bodies, bErr := client.GetPayloadBodiesByHashV1(ctx, batch)
+		if bErr != nil {
+			return pruned, bErr
+		}
+		for i, hash := range batch {
+			if i < len(bodies) && bodies[i] != nil {
+				continue
+			}
+			pruned++
+			cmd.Printf(
+				"slot %d: execution client has no body for block %s\n",
+				slots[hash], hash,
+			)
+		}
+	}
+	return pruned, nil
+}
+
+// dialExecutionClient dials the execution client given by the flags of the
+// command, falling back to the one configured for the node.
+func dialExecutionClient(
+	cmd *cobra.Command,
+) (*ethclient.Eth1Client[*types.ExecutionPayload], error) {
+	v := server.GetServerContextFromCmd(cmd).Viper
+	rawURL, err := cmd.Flags().GetString(rpcURL)
+	if err != nil {
+		return nil, err
+	} else if rawURL == "" {
+		rawURL = v.GetString(flags.RPCDialURL)
+	}
+	secretPath, err := cmd.Flags().GetString(jwtSecret)
+	if err != nil {
+		return nil, err
+	} else if secretPath == "" {
+		secretPath = v.GetString(flags.JWTSecretPath)
+	}
+
+	return execution.Dial(cmd.Context(), rawURL, secretPath)
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+const (
+	// height is the flag for the height at which the state is read.
+	height = "height"
+
+	// format is the flag for the encoding of the beacon state.
+	format = "format"
+
+	// outputFile is the flag for the file the beacon state is written to.
+	outputFile = "out"
+
+	// startHeight is the flag for the first height that is compared.
+	startHeight = "start-height"
+
+	// endHeight is the flag for the last height that is compared.
+	endHeight = "end-height"
+
+	// rpcURL is the flag for the url of the execution client.
+	rpcURL = "rpc-url"
+
+	// jwtSecret is the flag for the path of the JWT secret of the execution
+	// client.
+	jwtSecret = "jwt-secret"
+)
+
+const (
+	// defaultHeight is the default value for the height flag, it selects the
+	// latest committed height.
+	defaultHeight = 0
+
+	// defaultFormat is the default value for the format flag.
+	defaultFormat = formatJSON
+
+	// defaultStartHeight is the default value for the start-height flag.
+	defaultStartHeight = 1
+
+	// defaultEndHeight is the default value for the end-height flag, it
+	// selects the latest committed height.
+	defaultEndHeight = 0
+)
+
+const (
+	// heightMsg is the usage description for the height flag.
+	heightMsg = "height to read the state at, 0 for the latest height"
+
+	// formatMsg is the usage description for the format flag.
+	formatMsg = "encoding of the beacon state, either json or ssz"
+
+	// outputFileMsg is the usage description for the outputFile flag.
+	outputFileMsg = "file to write the beacon state to, stdout if empty"
+
+	// startHeightMsg is the usage description for the startHeight flag.
+	startHeightMsg = "first height to compare"
+
+	// endHeightMsg is the usage description for the endHeight flag.
+	endHeightMsg = "last height to compare, 0 for the latest height"
+
+	// rpcURLMsg is the usage description for the rpcURL flag.
+	rpcURLMsg = "url of the execution client engine API, " +
+		"the configured one if empty"
+
+	// jwtSecretMsg is the usage description for the jwtSecret flag.
+	jwtSecretMsg = "path of the JWT secret of the execution client, " +
+		"the configured one if empty"
+)
+
+const (
+	// formatJSON encodes the beacon state as JSON.
+	formatJSON = "json"
+
+	// formatSSZ encodes the beacon state as SSZ.
+	formatSSZ = "ssz"
+)
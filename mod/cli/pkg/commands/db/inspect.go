@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+
+	ctstate "github.com/berachain/beacon-kit/mod/consensus-types/pkg/state"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	"github.com/spf13/cobra"
+)
+
+// NewListCollectionsCommand creates a new command for listing the names of
+// the collections of the beacon state.
+func NewListCollectionsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "collections",
+		Short: "Lists the collections of the beacon state",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			kv, closeFn, err := openBeaconStore(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeFn() //nolint:errcheck // read-only.
+
+			for _, name := range kv.Collections() {
+				cmd.Println(name)
+			}
+			return nil
+		},
+	}
+}
+
+// NewCollectionCommand creates a new command for dumping a collection of the
+// beacon state as JSON.
+func NewCollectionCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "collection [name]",
+		Short: "Dumps a collection of the beacon state as JSON",
+		Long: `Dumps every entry of the collection with the given name as JSON.
+The names of the collections are the human-readable prefix names of the beacon
+store, e.g. ValidatorByIndexPrefix or BalancesPrefix.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kv, closeFn, err := openBeaconStore(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeFn() //nolint:errcheck // read-only.
+
+			return kv.ExportCollection(args[0], cmd.OutOrStdout())
+		},
+	}
+}
+
+// NewStateCommand creates a new command for dumping the whole beacon state.
+func NewStateCommand(chainSpec primitives.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Dumps the beacon state as JSON or SSZ",
+		Long: `Dumps the whole beacon state as JSON or SSZ and prints its hash
+tree root.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			f, err := cmd.Flags().GetString(format)
+			if err != nil {
+				return err
+			}
+			out, err := cmd.Flags().GetString(outputFile)
+			if err != nil {
+				return err
+			}
+
+			kv, closeFn, err := openBeaconStore(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeFn() //nolint:errcheck // read-only.
+
+			st, err := state.NewBeaconStateFromDB[interface {
+				GetMarshallable() (ctstate.RawBeaconState, error)
+			}](kv, chainSpec).GetMarshallable()
+			if err != nil {
+				return err
+			}
+
+			bz, err := encodeState(st, f)
+			if err != nil {
+				return err
+			}
+			if err = writeOutput(cmd.OutOrStdout(), out, bz); err != nil {
+				return err
+			}
+
+			root, err := st.HashTreeRoot()
+			if err != nil {
+				return err
+			}
+			cmd.PrintErrf("Hash tree root: %s\n", primitives.Root(root))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(format, defaultFormat, formatMsg)
+	cmd.Flags().String(outputFile, "", outputFileMsg)
+	return cmd
+}
+
+// encodeState encodes the beacon state in the given format.
+func encodeState(st ctstate.RawBeaconState, f string) ([]byte, error) {
+	switch f {
+	case formatJSON:
+		return json.MarshalIndent(st, "", "  ")
+	case formatSSZ:
+		return st.MarshalSSZ()
+	default:
+		return nil, ErrInvalidFormat
+	}
+}
+
+// writeOutput writes bz to the given file, or to w if no file is given.
+func writeOutput(w io.Writer, file string, bz []byte) error {
+	if file == "" {
+		_, err := w.Write(bz)
+		return err
+	}
+	//#nosec:G306 // the state is public.
+	return os.WriteFile(file, bz, 0o644)
+}
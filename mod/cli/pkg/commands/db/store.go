@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"fmt"
+	"path/filepath"
+
+	sdklog "cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	beacon "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// appDBName is the name of the application database within the data
+// directory of the node.
+const appDBName = "application"
+
+// appDB is the application database of a node, opened for reading.
+type appDB struct {
+	// cms is the commit multistore of the application database.
+	cms storetypes.CommitMultiStore
+	// key is the key of the beacon store within the multistore.
+	key *storetypes.KVStoreKey
+	// logger is the logger of the command.
+	logger sdklog.Logger
+	// Close closes the underlying database.
+	Close func() error
+}
+
+// openAppDB opens the application database of the node the command runs
+// against, read-only, at its latest committed height.
+func openAppDB(cmd *cobra.Command) (*appDB, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	return newAppDB(
+		filepath.Join(serverCtx.Config.RootDir, "data"),
+		server.GetAppDBBackend(serverCtx.Viper),
+		serverCtx.Logger,
+	)
+}
+
+// newAppDB opens the application database in the given directory,
+// read-only, at its latest committed height.
+func newAppDB(
+	dir string,
+	backend dbm.BackendType,
+	logger sdklog.Logger,
+) (*appDB, error) {
+	db, err := openReadOnlyDB(dir, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	// The version is loaded as is, without store upgrades, and without the
+	// IAVL fast node upgrade which writes to the database.
+	key := storetypes.NewKVStoreKey(beacon.ModuleName)
+	cms := store.NewCommitMultiStore(
+		db, sdklog.NewNopLogger(), storemetrics.NewNoOpMetrics(),
+	)
+	cms.SetIAVLDisableFastNode(true)
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	if err = cms.LoadVersion(rootmulti.GetLatestVersion(db)); err != nil {
+		return nil, fmt.Errorf("%w: %w", err, db.Close())
+	}
+
+	return &appDB{
+		cms:    cms,
+		key:    key,
+		logger: logger,
+		Close:  db.Close,
+	}, nil
+}
+
+// openReadOnlyDB opens the application database in the given directory
+// read-only. Backends that support it open the database files read-only,
+// and every write fails with ErrReadOnlyDB.
+func openReadOnlyDB(
+	dir string,
+	backend dbm.BackendType,
+) (dbm.DB, error) {
+	var (
+		db  dbm.DB
+		err error
+	)
+	if backend == dbm.GoLevelDBBackend {
+		db, err = dbm.NewGoLevelDBWithOpts(
+			appDBName, dir, &opt.Options{ReadOnly: true},
+		)
+	} else {
+		db, err = dbm.NewDB(appDBName, backend, dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return readOnlyDB{db}, nil
+}
+
+// readOnlyDB is a database whose writes fail with ErrReadOnlyDB.
+type readOnlyDB struct {
+	dbm.DB
+}
+
+// Set fails with ErrReadOnlyDB.
+func (readOnlyDB) Set([]byte, []byte) error {
+	return ErrReadOnlyDB
+}
+
+// SetSync fails with ErrReadOnlyDB.
+func (readOnlyDB) SetSync([]byte, []byte) error {
+	return ErrReadOnlyDB
+}
+
+// Delete fails with ErrReadOnlyDB.
+func (readOnlyDB) Delete([]byte) error {
+	return ErrReadOnlyDB
+}
+
+// DeleteSync fails with ErrReadOnlyDB.
+func (readOnlyDB) DeleteSync([]byte) error {
+	return ErrReadOnlyDB
+}
+
+// NewBatch returns a batch whose writes fail with ErrReadOnlyDB.
+func (readOnlyDB) NewBatch() dbm.Batch {
+	return readOnlyBatch{}
+}
+
+// NewBatchWithSize returns a batch whose writes fail with ErrReadOnlyDB.
+func (readOnlyDB) NewBatchWithSize(int) dbm.Batch {
+	return readOnlyBatch{}
+}
+
+// readOnlyBatch is a batch of a readOnlyDB, whose writes fail with
+// ErrReadOnlyDB.
+type readOnlyBatch struct{}
+
+// Set fails with ErrReadOnlyDB.
+func (readOnlyBatch) Set([]byte, []byte) error {
+	return ErrReadOnlyDB
+}
+
+// Delete fails with ErrReadOnlyDB.
+func (readOnlyBatch) Delete([]byte) error {
+	return ErrReadOnlyDB
+}
+
+// Write fails with ErrReadOnlyDB.
+func (readOnlyBatch) Write() error {
+	return ErrReadOnlyDB
+}
+
+// WriteSync fails with ErrReadOnlyDB.
+func (readOnlyBatch) WriteSync() error {
+	return ErrReadOnlyDB
+}
+
+// Close is a no-op.
+func (readOnlyBatch) Close() error {
+	return nil
+}
+
+// GetByteSize returns 0, as nothing can be written to the batch.
+func (readOnlyBatch) GetByteSize() (int, error) {
+	return 0, nil
+}
+
+// LatestHeight returns the latest committed height of the database.
+func (db *appDB) LatestHeight() int64 {
+	return db.cms.LastCommitID().Version
+}
+
+// BeaconStore returns the beacon store at the given height. The store reads
+// from a cache branch of the committed state at that height, which is never
+// written back.
+func (db *appDB) BeaconStore(h int64) (*storage.KVStore, error) {
+	ms, err := db.cms.CacheMultiStoreWithVersion(h)
+	if err != nil {
+		return nil, fmt.Errorf("%w %d: %w", ErrHeightNotFound, h, err)
+	}
+
+	kv, err := beacondb.New[
+		*types.Fork,
+		*types.BeaconBlockHeader,
+		*types.ExecutionPayloadHeader,
+		*types.Eth1Data,
+		*types.Validator,
+	](
+		runtime.NewKVStoreService(db.key),
+		&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return kv.WithContext(sdk.NewContext(ms, false, db.logger)), nil
+}
+
+// openBeaconStore opens the beacon store of the node at the height given by
+// the height flag. The store reads from a cache branch of the committed state
+// at that height, which is never written back. The returned function closes
+// the underlying database.
+func openBeaconStore(
+	cmd *cobra.Command,
+) (*storage.KVStore, func() error, error) {
+	h, err := cmd.Flags().GetInt64(height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := openAppDB(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if h == 0 {
+		h = db.LatestHeight()
+	}
+	kv, err := db.BeaconStore(h)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", err, db.Close())
+	}
+	return kv, db.Close, nil
+}
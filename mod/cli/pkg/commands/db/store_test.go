@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package db
+
+import (
+	"testing"
+
+	sdklog "cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	beacon "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAppDB_ReadOnly(t *testing.T) {
+	dir := t.TempDir()
+
+	// Commit two versions of the beacon store.
+	db, err := dbm.NewDB(appDBName, dbm.GoLevelDBBackend, dir)
+	require.NoError(t, err)
+	key := storetypes.NewKVStoreKey(beacon.ModuleName)
+	cms := store.NewCommitMultiStore(
+		db, sdklog.NewNopLogger(), storemetrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	for _, v := range []string{"1", "2"} {
+		cms.GetKVStore(key).Set([]byte("k"), []byte(v))
+		cms.Commit()
+	}
+	require.NoError(t, db.Close())
+
+	appDB, err := newAppDB(
+		dir, dbm.GoLevelDBBackend, sdklog.NewNopLogger(),
+	)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, appDB.Close()) }()
+	require.Equal(t, int64(2), appDB.LatestHeight())
+
+	ms, err := appDB.cms.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), ms.GetKVStore(appDB.key).Get([]byte("k")))
+
+	_, err = appDB.BeaconStore(2)
+	require.NoError(t, err)
+
+	// Committing writes to the database, which is read-only.
+	require.Panics(t, func() { appDB.cms.Commit() })
+}
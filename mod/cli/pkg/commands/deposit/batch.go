@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"encoding/csv"
+	"os"
+	"strings"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/spf13/cobra"
+)
+
+// depositsFileColumns is the number of columns of a row of a deposits file.
+const depositsFileColumns = 3
+
+// NewCreateValidators creates a new command for creating the deposits of the
+// validators of a deposits file.
+func NewCreateValidators(chainSpec primitives.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-validators",
+		Short: "Creates the deposits of the validators of a CSV file",
+		Long: `Creates a validator deposit for each row of a CSV deposits
+		file. The arguments are expected in the order of deposits file, current
+		version, and genesis validator root. Each row of the deposits file holds
+		the validator key, withdrawal credentials, and deposit amount of a
+		validator, where the validator key is either a hex encoded private key
+		or the path of an EIP-2335 keystore decrypted with the password file.
+		Lines starting with # are ignored. If the broadcast flag is set to
+		true, the deposits are sent to the deposit contract one after another,
+		and verified as by create-validator.`,
+		Args: cobra.ExactArgs(3), //nolint:mnd // The number of arguments.
+		RunE: createValidatorsCmd(chainSpec),
+	}
+
+	cmd.Flags().BoolP(
+		broadcastDeposit, broadcastDepositShorthand,
+		defaultBroadcastDeposit, broadcastDepositMsg,
+	)
+	cmd.Flags().String(privateKey, defaultPrivateKey, privateKeyMsg)
+	cmd.Flags().String(passwordFile, defaultPasswordFile, passwordFileMsg)
+	cmd.Flags().String(jwtSecretPath, defaultJWTSecretPath, jwtSecretPathMsg)
+	cmd.Flags().String(engineRPCURL, defaultEngineRPCURL, engineRPCURLMsg)
+
+	return cmd
+}
+
+// createValidatorsCmd returns a command that builds the create validator
+// requests of a deposits file.
+func createValidatorsCmd(
+	chainSpec primitives.ChainSpec,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var (
+			logger = log.NewLogger(os.Stdout)
+		)
+
+		currentVersion, err := parser.ConvertVersion(args[1])
+		if err != nil {
+			return err
+		}
+
+		genesisValidatorRoot, err := parser.ConvertGenesisValidatorRoot(args[2])
+		if err != nil {
+			return err
+		}
+
+		deposits, err := readDepositsFile(
+			cmd,
+			chainSpec,
+			types.NewForkData(currentVersion, genesisValidatorRoot),
+			args[0],
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, d := range deposits {
+			logger.Info(
+				"Deposit Message CallData",
+				"pubkey", d.Pubkey.String(),
+				"withdrawal credentials", d.Credentials.String(),
+				"amount", d.Amount,
+				"signature", d.Signature.String(),
+			)
+		}
+
+		broadcast, err := cmd.Flags().GetBool(broadcastDeposit)
+		if err != nil {
+			return err
+		}
+		if !broadcast {
+			logger.Info("Send the above calldata to the deposit contract 🫡")
+			return nil
+		}
+		return broadcastDeposits(cmd, chainSpec, deposits)
+	}
+}
+
+// readDepositsFile creates the deposits of the rows of a deposits file.
+func readDepositsFile(
+	cmd *cobra.Command,
+	chainSpec primitives.ChainSpec,
+	forkData *types.ForkData,
+	path string,
+) ([]*types.Deposit, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close() //nolint:errcheck // read-only.
+
+	reader := csv.NewReader(file)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = depositsFileColumns
+	reader.TrimLeadingSpace = true
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidDepositsFile, err.Error())
+	}
+
+	deposits := make([]*types.Deposit, 0, len(rows))
+	for i, row := range rows {
+		var d *types.Deposit
+		if d, err = createDepositFromRow(
+			cmd, chainSpec, forkData, row,
+		); err != nil {
+			return nil, errors.Wrapf(err, "row %d", i+1)
+		}
+		deposits = append(deposits, d)
+	}
+	return deposits, nil
+}
+
+// createDepositFromRow creates the deposit of a row of a deposits file.
+func createDepositFromRow(
+	cmd *cobra.Command,
+	chainSpec primitives.ChainSpec,
+	forkData *types.ForkData,
+	row []string,
+) (*types.Deposit, error) {
+	blsSigner, err := getRowSigner(cmd, row[0])
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := parser.ConvertWithdrawalCredentials(row[1])
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := parser.ConvertAmount(row[2])
+	if err != nil {
+		return nil, err
+	}
+
+	return createDeposit(chainSpec, forkData, blsSigner, credentials, amount)
+}
+
+// getRowSigner returns the signer of the validator key of a row of a
+// deposits file, either a hex encoded private key or the path of a keystore.
+func getRowSigner(
+	cmd *cobra.Command,
+	validatorKey string,
+) (crypto.BLSSigner, error) {
+	if !strings.HasSuffix(validatorKey, ".json") {
+		key, err := signer.LegacyKeyFromString(
+			strings.TrimPrefix(validatorKey, "0x"),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return signer.NewLegacySigner(key)
+	}
+
+	passwordPath, err := cmd.Flags().GetString(passwordFile)
+	if err != nil {
+		return nil, err
+	}
+	return signer.NewKeystoreSigner(validatorKey, passwordPath)
+}
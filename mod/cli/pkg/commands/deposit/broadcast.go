@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/execution"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+)
+
+// depositContract is the deposit contract the deposits are sent to.
+type depositContract = deposit.WrappedBeaconDepositContract[
+	*types.Deposit, types.WithdrawalCredentials,
+]
+
+// broadcastDeposits sends a deposit transaction to the deposit contract for
+// each of the given deposits, paid by the private key of the command flags.
+// Each deposit is checked to round-trip through the deposit contract, and
+// its index is set to the one assigned by the contract.
+func broadcastDeposits(
+	cmd *cobra.Command,
+	chainSpec primitives.ChainSpec,
+	deposits []*types.Deposit,
+) error {
+	key, err := getPayerKey(cmd)
+	if err != nil {
+		return err
+	}
+	rawURL, err := cmd.Flags().GetString(engineRPCURL)
+	if err != nil {
+		return err
+	}
+	secretPath, err := cmd.Flags().GetString(jwtSecretPath)
+	if err != nil {
+		return err
+	}
+
+	client, err := execution.Dial(cmd.Context(), rawURL, secretPath)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	chainID, err := client.ChainID(cmd.Context())
+	if err != nil {
+		return err
+	}
+	// The transactor signs dynamic fee transactions, with fees suggested by
+	// the execution client.
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return err
+	}
+	opts.Context = cmd.Context()
+
+	contract, err := deposit.NewWrappedBeaconDepositContract[
+		*types.Deposit, types.WithdrawalCredentials,
+	](chainSpec.DepositContractAddress(), client)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range deposits {
+		if err = sendDeposit(
+			cmd.Context(), client, contract, opts, d,
+		); err != nil {
+			return err
+		}
+		cmd.Printf(
+			"deposit %d of %s verified\n", d.Index, d.Pubkey.String(),
+		)
+	}
+	return nil
+}
+
+// sendDeposit sends the deposit transaction of a deposit, waits for it to
+// be mined, and checks that the deposit emitted by the deposit contract
+// matches the one sent and is read back by ReadDeposits.
+func sendDeposit(
+	ctx context.Context,
+	backend bind.DeployBackend,
+	contract *depositContract,
+	opts *bind.TransactOpts,
+	d *types.Deposit,
+) error {
+	// The deposit contract credits the value of the transaction, in wei.
+	opts.Value = d.Amount.ToWei()
+	tx, err := contract.Deposit(
+		opts, d.Pubkey[:], d.Credentials[:], d.Amount.Unwrap(),
+		d.Signature[:],
+	)
+	if err != nil {
+		return err
+	}
+
+	receipt, err := bind.WaitMined(ctx, backend, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return errors.Wrapf(ErrDepositTransactionFailed, "%s", tx.Hash())
+	}
+
+	// Parse the deposit emitted by the transaction.
+	var emitted *types.Deposit
+	for _, log := range receipt.Logs {
+		event, pErr := contract.ParseDeposit(*log)
+		if pErr != nil {
+			continue
+		}
+		emitted = types.NewDeposit(
+			bytes.ToBytes48(event.Pubkey),
+			types.WithdrawalCredentials(bytes.ToBytes32(event.Credentials)),
+			math.Gwei(event.Amount),
+			bytes.ToBytes96(event.Signature),
+			event.Index,
+		)
+		break
+	}
+	if emitted == nil {
+		return errors.Wrapf(ErrDepositEventNotFound, "%s", tx.Hash())
+	}
+	d.Index = emitted.Index
+	if *emitted != *d {
+		return errors.Wrapf(ErrDepositMismatch, "%s", tx.Hash())
+	}
+
+	// Read the deposit back as the deposit service does.
+	read, err := contract.ReadDeposits(
+		ctx, math.U64(receipt.BlockNumber.Uint64()),
+	)
+	if err != nil {
+		return err
+	}
+	for _, r := range read {
+		if r.Index == d.Index && *r == *d {
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrDepositMismatch, "%s", tx.Hash())
+}
+
+// getPayerKey returns the private key of the command flags paying for the
+// deposit transactions.
+func getPayerKey(cmd *cobra.Command) (*ecdsa.PrivateKey, error) {
+	key, err := cmd.Flags().GetString(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	if key == "" {
+		return nil, ErrPrivateKeyRequired
+	}
+	return ethcrypto.HexToECDSA(strings.TrimPrefix(key, "0x"))
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/require"
+)
+
+// depositAuthSlot is the storage slot of the depositAuth mapping of the
+// deposit contract.
+const depositAuthSlot = 1
+
+// committingClient is a client of a simulated chain which commits a block
+// for each transaction sent.
+type committingClient struct {
+	simulated.Client
+	backend *simulated.Backend
+}
+
+// SendTransaction sends a transaction and commits the block including it.
+func (c committingClient) SendTransaction(
+	ctx context.Context, tx *ethtypes.Transaction,
+) error {
+	if err := c.Client.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	c.backend.Commit()
+	return nil
+}
+
+// newSimulatedDepositContract returns a simulated chain holding the deposit
+// contract of the genesis of the testing network, with the given depositor
+// allowed to deposit.
+func newSimulatedDepositContract(
+	t *testing.T,
+	address ethcommon.Address,
+	depositor ethcommon.Address,
+) (*simulated.Backend, *depositContract) {
+	t.Helper()
+	bz, err := os.ReadFile("../../../../../testing/files/eth-genesis.json")
+	require.NoError(t, err)
+	var genesis struct {
+		Alloc map[ethcommon.Address]struct {
+			Code hexutil.Bytes `json:"code"`
+		} `json:"alloc"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &genesis))
+
+	authKey := ethcrypto.Keccak256Hash(
+		ethcommon.LeftPadBytes(depositor.Bytes(), 32),
+		ethcommon.LeftPadBytes(big.NewInt(depositAuthSlot).Bytes(), 32),
+	)
+	balance, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	backend := simulated.NewBackend(ethtypes.GenesisAlloc{
+		depositor: {Balance: balance},
+		address: {
+			Code: genesis.Alloc[address].Code,
+			Storage: map[ethcommon.Hash]ethcommon.Hash{
+				authKey: ethcommon.BigToHash(big.NewInt(10)),
+			},
+		},
+	})
+	t.Cleanup(func() { require.NoError(t, backend.Close()) })
+	// Seal the genesis, so that calls run against a post-merge block.
+	backend.Commit()
+
+	contract, err := deposit.NewWrappedBeaconDepositContract[
+		*types.Deposit, types.WithdrawalCredentials,
+	](address, committingClient{backend.Client(), backend})
+	require.NoError(t, err)
+	return backend, contract
+}
+
+func TestSendDeposit(t *testing.T) {
+	chainSpec := spec.TestnetChainSpec()
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	depositor := ethcrypto.PubkeyToAddress(key.PublicKey)
+	backend, contract := newSimulatedDepositContract(
+		t, chainSpec.DepositContractAddress(), depositor,
+	)
+
+	chainID, err := backend.Client().ChainID(context.Background())
+	require.NoError(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	require.NoError(t, err)
+
+	for i := range uint64(2) {
+		blsSigner, sErr := signer.NewLegacySigner(
+			signer.LegacyKey{31: byte(i + 1)},
+		)
+		require.NoError(t, sErr)
+		d, dErr := createDeposit(
+			chainSpec,
+			types.NewForkData(common.Version{}, common.Root{}),
+			blsSigner,
+			types.NewCredentialsFromExecutionAddress(depositor),
+			math.Gwei(32e9),
+		)
+		require.NoError(t, dErr)
+
+		require.NoError(t, sendDeposit(
+			context.Background(), backend.Client(), contract, opts, d,
+		))
+		require.Equal(t, i, d.Index)
+	}
+}
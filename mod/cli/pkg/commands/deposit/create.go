@@ -31,8 +31,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // NewValidateDeposit creates a new command for validating a deposit message.
@@ -45,7 +46,12 @@ func NewCreateValidator(chainSpec primitives.ChainSpec) *cobra.Command {
 		Long: `Creates a validator deposit with the necessary credentials. The 
 		arguments are expected in the order of withdrawal credentials, deposit
 		amount, current version, and genesis validator root. If the broadcast
-		flag is set to true, a private key must be provided to sign the transaction.`,
+		flag is set to true, a private key must be provided to sign the
+		transaction, which is sent to the deposit contract through the engine
+		RPC of the execution client. The deposit is then read back from the
+		deposit contract to verify it.
+		The deposit message is signed by the signer of the node configuration,
+		possibly a remote signer, unless the node key is overridden.`,
 		Args: cobra.ExactArgs(4), //nolint:mnd // The number of arguments.
 		RunE: createValidatorCmd(chainSpec),
 	}
@@ -68,11 +74,6 @@ func NewCreateValidator(chainSpec primitives.ChainSpec) *cobra.Command {
 }
 
 // createValidatorCmd returns a command that builds a create validator request.
-//
-// TODO: Implement broadcast functionality. Currently, the implementation works
-// for the geth client but something about the Deposit binding is not handling
-// other execution layers correctly. Peep the commit history for what we had.
-// 🤷‍♂️.
 func createValidatorCmd(
 	chainSpec primitives.ChainSpec,
 ) func(*cobra.Command, []string) error {
@@ -107,10 +108,10 @@ func createValidatorCmd(
 			return err
 		}
 
-		// Create and sign the deposit message.
-		depositMsg, signature, err := types.CreateAndSignDepositMessage(
+		// Create and sign the deposit.
+		d, err := createDeposit(
+			chainSpec,
 			types.NewForkData(currentVersion, genesisValidatorRoot),
-			chainSpec.DomainTypeDeposit(),
 			blsSigner,
 			credentials,
 			amount,
@@ -119,31 +120,64 @@ func createValidatorCmd(
 			return err
 		}
 
-		// Verify the deposit message.
-		if err = depositMsg.VerifyCreateValidator(
-			types.NewForkData(currentVersion, genesisValidatorRoot),
-			signature,
-			chainSpec.DomainTypeDeposit(),
-			signer.BLSSigner{}.VerifySignature,
-		); err != nil {
-			return err
-		}
-
-		// If the broadcast flag is not set, output the deposit message and
-		// signature and return early.
 		logger.Info(
 			"Deposit Message CallData",
-			"pubkey", depositMsg.Pubkey.String(),
-			"withdrawal credentials", depositMsg.Credentials.String(),
-			"amount", depositMsg.Amount,
-			"signature", signature.String(),
+			"pubkey", d.Pubkey.String(),
+			"withdrawal credentials", d.Credentials.String(),
+			"amount", d.Amount,
+			"signature", d.Signature.String(),
 		)
 
-		// TODO: once broadcast is fixed, remove this.
-		logger.Info("Send the above calldata to the deposit contract 🫡")
+		// If the broadcast flag is not set, return early with the deposit
+		// message and signature output.
+		broadcast, err := cmd.Flags().GetBool(broadcastDeposit)
+		if err != nil {
+			return err
+		}
+		if !broadcast {
+			logger.Info("Send the above calldata to the deposit contract 🫡")
+			return nil
+		}
+		return broadcastDeposits(cmd, chainSpec, []*types.Deposit{d})
+	}
+}
+
+// createDeposit creates a deposit signed by the given signer, and verifies
+// its signature as the deposit contract's consumers will.
+func createDeposit(
+	chainSpec primitives.ChainSpec,
+	forkData *types.ForkData,
+	blsSigner crypto.BLSSigner,
+	credentials types.WithdrawalCredentials,
+	amount math.Gwei,
+) (*types.Deposit, error) {
+	depositMsg, signature, err := types.CreateAndSignDepositMessage(
+		forkData,
+		chainSpec.DomainTypeDeposit(),
+		blsSigner,
+		credentials,
+		amount,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-		return nil
+	if err = depositMsg.VerifyCreateValidator(
+		forkData,
+		signature,
+		chainSpec.DomainTypeDeposit(),
+		signer.BLSSigner{}.VerifySignature,
+	); err != nil {
+		return nil, err
 	}
+
+	return types.NewDeposit(
+		depositMsg.Pubkey,
+		depositMsg.Credentials,
+		depositMsg.Amount,
+		signature,
+		0,
+	), nil
 }
 
 // getBLSSigner returns a BLS signer based on the override commands key flag.
@@ -151,7 +185,9 @@ func getBLSSigner(
 	cmd *cobra.Command,
 ) (crypto.BLSSigner, error) {
 	var blsSigner crypto.BLSSigner
-	supplies := []interface{}{viper.GetViper()}
+	// The viper of the server context holds the configuration of the node,
+	// which selects the signer.
+	supplies := []interface{}{server.GetServerContextFromCmd(cmd).Viper}
 	overrideFlag, err := cmd.Flags().GetBool(overrideNodeKey)
 	if err != nil {
 		return nil, err
@@ -181,6 +217,7 @@ func getBLSSigner(
 		depinject.Configs(
 			depinject.Supply(supplies...),
 			depinject.Provide(
+				components.ProvideConfig,
 				components.ProvideBlsSigner,
 			),
 		),
@@ -42,6 +42,7 @@ func Commands(chainSpec primitives.ChainSpec) *cobra.Command {
 	cmd.AddCommand(
 		NewValidateDeposit(chainSpec),
 		NewCreateValidator(chainSpec),
+		NewCreateValidators(chainSpec),
 	)
 
 	return cmd
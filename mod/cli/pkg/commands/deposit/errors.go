@@ -20,7 +20,7 @@
 
 package deposit
 
-import "errors"
+import "github.com/berachain/beacon-kit/mod/errors"
 
 var (
 	// ErrValidatorPrivateKeyRequired is returned when the validator private key
@@ -28,4 +28,26 @@ var (
 	ErrValidatorPrivateKeyRequired = errors.New(
 		"validator private key required",
 	)
+
+	// ErrPrivateKeyRequired is returned when the broadcast flag is set but no
+	// private key is provided to pay for the deposit transaction.
+	ErrPrivateKeyRequired = errors.New(
+		"private key required to broadcast the deposit",
+	)
+
+	// ErrDepositTransactionFailed is returned when the deposit transaction
+	// is reverted.
+	ErrDepositTransactionFailed = errors.New("deposit transaction failed")
+
+	// ErrDepositEventNotFound is returned when the receipt of the deposit
+	// transaction does not hold a deposit event.
+	ErrDepositEventNotFound = errors.New("deposit event not found")
+
+	// ErrDepositMismatch is returned when the deposit read back from the
+	// deposit contract does not match the one sent.
+	ErrDepositMismatch = errors.New("deposit does not match the one sent")
+
+	// ErrInvalidDepositsFile is returned when a row of the deposits file is
+	// malformed.
+	ErrInvalidDepositsFile = errors.New("invalid deposits file")
 )
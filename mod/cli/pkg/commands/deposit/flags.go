@@ -38,6 +38,10 @@ const (
 
 	// engineRPCURL is the flag for the URL for the engine RPC.
 	engineRPCURL = "engine-rpc-url"
+
+	// passwordFile is the flag for the path to the password file of the
+	// keystores of a deposits file.
+	passwordFile = "password-file"
 )
 
 const (
@@ -71,6 +75,9 @@ const (
 
 	// defaultEngineRPCURL is the default value for the engineRPCURL flag.
 	defaultEngineRPCURL = "http://localhost:8551"
+
+	// defaultPasswordFile is the default value for the passwordFile flag.
+	defaultPasswordFile = ""
 )
 
 const (
@@ -97,4 +104,8 @@ const (
 
 	// engineRPCURLMsg is the usage description for the engineRPCURL flag.
 	engineRPCURLMsg = "URL for the engine RPC"
+
+	// passwordFileMsg is the usage description for the passwordFile flag.
+	passwordFileMsg = `path to the password file of the keystores of the
+	deposits file.`
 )
@@ -0,0 +1,319 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/keystore"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagPasswordFile is the flag of the file holding the password of the
+	// keystores.
+	FlagPasswordFile = "password-file"
+	// FlagMnemonicFile is the flag of the file holding the mnemonic to
+	// derive the keys from.
+	FlagMnemonicFile = "mnemonic-file"
+	// FlagNum is the flag of the number of keys to generate.
+	FlagNum = "num"
+	// FlagStartIndex is the flag of the index of the first key to generate.
+	FlagStartIndex = "start-index"
+	// FlagOutDir is the flag of the directory to write the keystores to.
+	FlagOutDir = "out-dir"
+
+	// DefaultOutDir is the default directory the keystores are written to.
+	DefaultOutDir = "validator_keys"
+
+	// blsPrivKeyType is the amino type of the BLS12-381 keys of CometBFT.
+	blsPrivKeyType = "cometbft/PrivKeyBls12_381"
+)
+
+// NewGenerateCommand creates a new command for generating BLS keys from a
+// mnemonic.
+func NewGenerateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generates EIP-2335 keystores from a mnemonic",
+		Long: `Derives BLS keys from a BIP-39 mnemonic along the EIP-2334
+signing key paths m/12381/3600/<index>/0/0, and writes each of them to an
+EIP-2335 keystore encrypted with the password of the password file. If no
+mnemonic file is given, a new mnemonic is generated and printed once: it is
+the only way to recover the keys, and must be stored safely.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			password, err := readPassword(cmd)
+			if err != nil {
+				return err
+			}
+			mnemonic, err := readMnemonic(cmd)
+			if err != nil {
+				return err
+			}
+			seed, err := keystore.SeedFromMnemonic(mnemonic)
+			if err != nil {
+				return err
+			}
+
+			num, err := cmd.Flags().GetUint32(FlagNum)
+			if err != nil {
+				return err
+			}
+			start, err := cmd.Flags().GetUint32(FlagStartIndex)
+			if err != nil {
+				return err
+			}
+			outDir, err := cmd.Flags().GetString(FlagOutDir)
+			if err != nil {
+				return err
+			}
+			if err = os.MkdirAll(outDir, 0o700); err != nil {
+				return err
+			}
+
+			for index := start; index < start+num; index++ {
+				path := keystore.SigningKeyPath(index)
+				var secretKey [constants.BLSSecretKeyLength]byte
+				if secretKey, err = keystore.DeriveSecretKey(
+					seed, path,
+				); err != nil {
+					return err
+				}
+				if err = writeKeystore(
+					cmd, secretKey, path, password, filepath.Join(
+						outDir, keystoreFileName(path),
+					),
+				); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagPasswordFile, "",
+		"file holding the password to encrypt the keystores with")
+	cmd.Flags().String(FlagMnemonicFile, "",
+		"file holding the mnemonic to derive the keys from")
+	cmd.Flags().Uint32(FlagNum, 1, "number of keys to generate")
+	cmd.Flags().Uint32(FlagStartIndex, 0, "index of the first key")
+	cmd.Flags().String(FlagOutDir, DefaultOutDir,
+		"directory to write the keystores to")
+	_ = cmd.MarkFlagRequired(FlagPasswordFile)
+	return cmd
+}
+
+// NewImportCommand creates a new command for importing an EIP-2335
+// keystore into the node.
+func NewImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [keystore]",
+		Short: "Imports an EIP-2335 keystore into the node",
+		Long: `Checks that the keystore decrypts with the password of the
+password file, and copies it to the config directory of the node. The node
+signs with the keystore once its signer is configured with type "keystore",
+the path of the keystore, and the path of the password file.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			password, err := readPassword(cmd)
+			if err != nil {
+				return err
+			}
+			ks, err := keystore.Load(args[0])
+			if err != nil {
+				return err
+			}
+			if _, err = ks.Decrypt(password); err != nil {
+				return err
+			}
+
+			path := filepath.Join(
+				server.GetServerContextFromCmd(cmd).Config.RootDir,
+				"config", filepath.Base(args[0]),
+			)
+			if err = ks.Save(path); err != nil {
+				return err
+			}
+			cmd.Printf(`Imported keystore of 0x%x to %s.
+Set the following in the [beacon-kit.signer] section of app.toml to sign
+with it:
+  type = "%s"
+  keystore = "%s"
+  keystore-password-file = "<path of the password file>"
+`, []byte(ks.Pubkey), path, signer.TypeKeystore, path)
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagPasswordFile, "",
+		"file holding the password of the keystore")
+	_ = cmd.MarkFlagRequired(FlagPasswordFile)
+	return cmd
+}
+
+// NewExportCommand creates a new command for exporting the BLS key of the
+// node to an EIP-2335 keystore.
+func NewExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [keystore]",
+		Short: "Exports the BLS key of the node to an EIP-2335 keystore",
+		Long: `Encrypts the BLS key of the private validator key file of the
+node with the password of the password file, and writes it to an EIP-2335
+keystore. The key file can then be removed from the node, which signs with the
+keystore instead once its signer is configured with type "keystore".`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			password, err := readPassword(cmd)
+			if err != nil {
+				return err
+			}
+			secretKey, err := readValidatorKey(
+				server.GetServerContextFromCmd(cmd).Config.PrivValidatorKeyFile(),
+			)
+			if err != nil {
+				return err
+			}
+			return writeKeystore(cmd, secretKey, "", password, args[0])
+		},
+	}
+	cmd.Flags().String(FlagPasswordFile, "",
+		"file holding the password to encrypt the keystore with")
+	_ = cmd.MarkFlagRequired(FlagPasswordFile)
+	return cmd
+}
+
+// writeKeystore encrypts a secret key to an EIP-2335 keystore at the given
+// path and prints its public key.
+func writeKeystore(
+	cmd *cobra.Command,
+	secretKey [constants.BLSSecretKeyLength]byte,
+	path string,
+	password string,
+	file string,
+) error {
+	blsSigner, err := signer.NewLegacySigner(secretKey)
+	if err != nil {
+		return err
+	}
+	pubkey := blsSigner.PublicKey()
+	ks, err := keystore.Encrypt(
+		secretKey, pubkey, path, password, keystore.DefaultScryptParams,
+	)
+	if err != nil {
+		return err
+	}
+	if err = ks.Save(file); err != nil {
+		return err
+	}
+	cmd.Printf("0x%x %s\n", pubkey[:], file)
+	return nil
+}
+
+// readPassword reads the password of the password file flag.
+func readPassword(cmd *cobra.Command) (string, error) {
+	file, err := cmd.Flags().GetString(FlagPasswordFile)
+	if err != nil {
+		return "", err
+	}
+	return keystore.ReadPasswordFile(file)
+}
+
+// readMnemonic reads the mnemonic of the mnemonic file flag, or generates a
+// new one and prints it if the flag is not set.
+func readMnemonic(cmd *cobra.Command) (string, error) {
+	file, err := cmd.Flags().GetString(FlagMnemonicFile)
+	if err != nil {
+		return "", err
+	}
+	if file != "" {
+		var bz []byte
+		if bz, err = os.ReadFile(file); err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(bz)), nil
+	}
+
+	mnemonic, err := keystore.NewMnemonic()
+	if err != nil {
+		return "", err
+	}
+	cmd.PrintErrf(`Generated a new mnemonic. Write it down and store it safely,
+it is the only way to recover the keys and will not be shown again:
+
+%s
+
+`, mnemonic)
+	return mnemonic, nil
+}
+
+// readValidatorKey reads the BLS secret key of a private validator key file.
+func readValidatorKey(
+	file string,
+) ([constants.BLSSecretKeyLength]byte, error) {
+	var (
+		secretKey [constants.BLSSecretKeyLength]byte
+		key       struct {
+			PrivKey struct {
+				Type  string `json:"type"`
+				Value string `json:"value"`
+			} `json:"priv_key"`
+		}
+	)
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return secretKey, err
+	}
+	if err = json.Unmarshal(bz, &key); err != nil {
+		return secretKey, err
+	}
+	if key.PrivKey.Type != blsPrivKeyType {
+		return secretKey, errors.Wrapf(
+			ErrUnsupportedKeyType, "%s", key.PrivKey.Type,
+		)
+	}
+	if bz, err = base64.StdEncoding.DecodeString(
+		key.PrivKey.Value,
+	); err != nil {
+		return secretKey, err
+	}
+	if len(bz) != constants.BLSSecretKeyLength {
+		return secretKey, ErrInvalidKeyLength
+	}
+	return [constants.BLSSecretKeyLength]byte(bz), nil
+}
+
+// keystoreFileName returns the conventional file name of the keystore of
+// the given path, e.g. keystore-m_12381_3600_0_0_0-1700000000.json.
+func keystoreFileName(path string) string {
+	return fmt.Sprintf(
+		"keystore-%s-%d.json",
+		strings.ReplaceAll(path, "/", "_"), time.Now().Unix(),
+	)
+}
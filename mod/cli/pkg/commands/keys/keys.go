@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkkeys "github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/spf13/cobra"
+)
+
+// Commands creates a new command for managing keys, extending the keyring
+// commands of the sdk with the management of the BLS keys of validators.
+func Commands() *cobra.Command {
+	cmd := sdkkeys.Commands()
+	cmd.AddCommand(NewBLSCommand())
+	return cmd
+}
+
+// NewBLSCommand creates a new command for managing the BLS keys of
+// validators.
+func NewBLSCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "bls",
+		Short:                      "BLS validator key subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewGenerateCommand(),
+		NewImportCommand(),
+		NewExportCommand(),
+	)
+
+	return cmd
+}
@@ -22,14 +22,17 @@ package commands
 
 import (
 	confixcmd "cosmossdk.io/tools/confix/cmd"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/blobs"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/client"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/cometbft"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/db"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/jwt"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/keys"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/slashingprotection"
 	beaconconfig "github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/primitives"
-	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/pruning"
 	"github.com/cosmos/cosmos-sdk/client/snapshot"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -57,12 +60,16 @@ func DefaultRootCommandSetup[T servertypes.Application](
 
 	// Add all the commands to the root command.
 	rootCmd.AddCommand(
+		// `blobs`
+		blobs.Commands(chainSpec),
 		// `comet`
 		cometbft.Commands(newApp),
 		// `client`
 		client.Commands[T](),
 		// `config`
 		confixcmd.ConfigCommand(),
+		// `db`
+		db.Commands(chainSpec),
 		// `init`
 		genutilcli.InitCmd(mm),
 		// `genesis`
@@ -77,6 +84,8 @@ func DefaultRootCommandSetup[T servertypes.Application](
 		pruning.Cmd(newApp),
 		// `rollback`
 		server.NewRollbackCmd(newApp),
+		// `slashing-protection`
+		slashingprotection.Commands(),
 		// `snapshots`
 		snapshot.Cmd(newApp),
 		// `start`
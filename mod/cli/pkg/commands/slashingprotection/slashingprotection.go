@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/slashingprotection"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+// Commands creates a new command for slashing protection related actions.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "slashing-protection",
+		Short:                      "slashing protection subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewImportCommand(),
+		NewExportCommand(),
+	)
+
+	return cmd
+}
+
+// NewImportCommand creates a new command for importing an EIP-3076
+// interchange file into the slashing protection database.
+func NewImportCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import [file]",
+		Short: "Imports an EIP-3076 interchange file",
+		Long: `Imports the signed blocks and attestations of an EIP-3076
+slashing protection interchange file into the slashing protection database of
+the node. Messages conflicting with those of the database are recorded without
+a signing root, so that nothing is ever signed at their slot again. The node
+should be stopped while importing.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var interchange slashingprotection.Interchange
+			if err = json.Unmarshal(bz, &interchange); err != nil {
+				return err
+			}
+
+			db, err := openDB(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.Close() //nolint:errcheck // written synchronously.
+			return db.Import(&interchange)
+		},
+	}
+}
+
+// NewExportCommand creates a new command for exporting the slashing
+// protection database to an EIP-3076 interchange file.
+func NewExportCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export [file]",
+		Short: "Exports the database to an EIP-3076 interchange file",
+		Long: `Exports the signed blocks of the slashing protection database
+of the node to an EIP-3076 slashing protection interchange file. Randao
+reveals are not part of the interchange format, thus are not exported. The
+node should be stopped while exporting, and must not sign anything afterwards
+if the keys are migrated to another machine.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := openDB(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.Close() //nolint:errcheck // read-only.
+
+			interchange, err := db.Export()
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(interchange, "", "  ")
+			if err != nil {
+				return err
+			}
+			//#nosec:G306 // the signed messages are public.
+			return os.WriteFile(args[0], bz, 0o644)
+		},
+	}
+}
+
+// openDB opens the slashing protection database of the node the command
+// runs against.
+func openDB(cmd *cobra.Command) (*slashingprotection.KVStore, error) {
+	return components.OpenSlashingProtectionDB(
+		server.GetServerContextFromCmd(cmd).Config.RootDir,
+	)
+}
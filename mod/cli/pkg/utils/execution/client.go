@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package execution
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// Dial dials the execution client at the given url, authenticating with
+// the JWT secret of the given file unless the url is an IPC path.
+func Dial(
+	ctx context.Context,
+	rawURL string,
+	secretPath string,
+) (*ethclient.Eth1Client[*types.ExecutionPayload], error) {
+	dialURL, err := url.NewFromRaw(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var client *ethrpc.Client
+	if dialURL.IsIPC() {
+		client, err = ethrpc.DialIPC(ctx, dialURL.Path)
+	} else {
+		var secret *jwt.Secret
+		if secret, err = components.LoadJWTFromFile(secretPath); err != nil {
+			return nil, err
+		}
+		client, err = ethrpc.DialOptions(
+			ctx, dialURL.String(),
+			ethrpc.WithHTTPAuth(func(header http.Header) error {
+				token, tErr := jwt.BuildSignedJWT(secret)
+				if tErr != nil {
+					return tErr
+				}
+				header.Set("Authorization", "Bearer "+token)
+				return nil
+			}),
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ethclient.NewFromRPCClient[*types.ExecutionPayload](client)
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: MIT
+//
+// # Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+//
+
+package electra_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state/deneb"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state/electra"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/stretchr/testify/require"
+)
+
+// generateValidBeaconState generates a valid beacon state for the Electra.
+func generateValidBeaconState() *electra.BeaconState {
+	var byteArray [256]byte
+	return &electra.BeaconState{
+		BlockRoots:  []primitives.Root{},
+		StateRoots:  []primitives.Root{},
+		Validators:  []*types.Validator{},
+		Balances:    []uint64{},
+		RandaoMixes: []primitives.Bytes32{},
+		Slashings:   []uint64{},
+		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeaderElectra{
+			ExecutionPayloadHeaderDeneb: types.ExecutionPayloadHeaderDeneb{
+				LogsBloom: byteArray[:],
+				ExtraData: []byte{},
+			},
+		},
+	}
+}
+
+func TestBeaconStateMarshalUnmarshalSSZ(t *testing.T) {
+	state := generateValidBeaconState()
+
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+	require.NotNil(t, data)
+
+	newState := &electra.BeaconState{}
+	err = newState.UnmarshalSSZ(data)
+	require.NoError(t, err)
+
+	require.Equal(t, state, newState)
+}
+
+func TestHashTreeRoot_MatchesDeneb(t *testing.T) {
+	state := generateValidBeaconState()
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+
+	// The Electra state shares the Deneb layout, so the roots must match.
+	denebState := &deneb.BeaconState{
+		BlockRoots:  state.BlockRoots,
+		StateRoots:  state.StateRoots,
+		Validators:  state.Validators,
+		Balances:    state.Balances,
+		RandaoMixes: state.RandaoMixes,
+		Slashings:   state.Slashings,
+		LatestExecutionPayloadHeader: &state.LatestExecutionPayloadHeader.
+			ExecutionPayloadHeaderDeneb,
+	}
+	denebRoot, err := denebState.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, denebRoot, root)
+}
@@ -25,12 +25,19 @@ import (
 	"reflect"
 
 	deneb "github.com/berachain/beacon-kit/mod/consensus-types/pkg/state/deneb"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state/electra"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
+// RawBeaconState is the fork specific, marshallable form of the beacon state.
+type RawBeaconState interface {
+	ssz.Marshallable
+}
+
 // BeaconState is the interface for the beacon state.
 type BeaconState[
 	BeaconBlockHeaderT,
@@ -39,8 +46,7 @@ type BeaconState[
 	ForkT,
 	ValidatorT any,
 ] struct {
-	// TODO: decouple from deneb.BeaconState
-	*deneb.BeaconState
+	RawBeaconState
 }
 
 // New creates a new BeaconState.
@@ -75,6 +81,15 @@ func (st *BeaconState[
 	ForkT,
 	ValidatorT,
 ], error) {
+	// TODO: Unhack reflection.
+	forkT := reflect.ValueOf(fork).Interface().(*types.Fork)
+	lbh := reflect.ValueOf(latestBlockHeader).
+		Interface().(*types.BeaconBlockHeader)
+	lph := reflect.ValueOf(latestExecutionPayloadHeader).
+		Interface().(*types.ExecutionPayloadHeader)
+	eth1DataT := reflect.ValueOf(eth1Data).Interface().(*types.Eth1Data)
+	vals := reflect.ValueOf(validators).Interface().([]*types.Validator)
+
 	switch forkVersion {
 	case version.Deneb:
 		return &BeaconState[
@@ -84,25 +99,61 @@ func (st *BeaconState[
 			ForkT,
 			ValidatorT,
 		]{
-			// TODO: Unhack reflection.
-			BeaconState: &deneb.BeaconState{
+			RawBeaconState: &deneb.BeaconState{
 				Slot:                  slot,
 				GenesisValidatorsRoot: genesisValidatorsRoot,
-				Fork: reflect.ValueOf(fork).
-					Interface().(*types.Fork),
-				LatestBlockHeader: reflect.ValueOf(latestBlockHeader).
-					Interface().(*types.BeaconBlockHeader),
-				BlockRoots: blockRoots,
-				StateRoots: stateRoots,
-				LatestExecutionPayloadHeader: reflect.
-					ValueOf(latestExecutionPayloadHeader).
-					Interface().(*types.ExecutionPayloadHeader).
+				Fork:                  forkT,
+				LatestBlockHeader:     lbh,
+				BlockRoots:            blockRoots,
+				StateRoots:            stateRoots,
+				LatestExecutionPayloadHeader: lph.
 					InnerExecutionPayloadHeader.(*types.ExecutionPayloadHeaderDeneb),
-				Eth1Data: reflect.ValueOf(eth1Data).
-					Interface().(*types.Eth1Data),
-				Eth1DepositIndex: eth1DepositIndex,
-				Validators: reflect.ValueOf(validators).
-					Interface().([]*types.Validator),
+				Eth1Data:                     eth1DataT,
+				Eth1DepositIndex:             eth1DepositIndex,
+				Validators:                   vals,
+				Balances:                     balances,
+				RandaoMixes:                  randaoMixes,
+				NextWithdrawalIndex:          nextWithdrawalIndex,
+				NextWithdrawalValidatorIndex: nextWithdrawalValidatorIndex,
+				Slashings:                    slashings,
+				TotalSlashing:                totalSlashing,
+			},
+		}, nil
+	case version.Electra:
+		// The first Electra slots still carry the header of the last Deneb
+		// payload, which shares the Electra header layout.
+		var header *types.ExecutionPayloadHeaderElectra
+		switch h := lph.InnerExecutionPayloadHeader.(type) {
+		case *types.ExecutionPayloadHeaderElectra:
+			header = h
+		case *types.ExecutionPayloadHeaderDeneb:
+			header = &types.ExecutionPayloadHeaderElectra{
+				ExecutionPayloadHeaderDeneb: *h,
+			}
+		default:
+			return nil, fmt.Errorf(
+				"unsupported execution payload header %T", h,
+			)
+		}
+
+		return &BeaconState[
+			BeaconBlockHeaderT,
+			ExecutionPayloadHeaderT,
+			Eth1DataT,
+			ForkT,
+			ValidatorT,
+		]{
+			RawBeaconState: &electra.BeaconState{
+				Slot:                         slot,
+				GenesisValidatorsRoot:        genesisValidatorsRoot,
+				Fork:                         forkT,
+				LatestBlockHeader:            lbh,
+				BlockRoots:                   blockRoots,
+				StateRoots:                   stateRoots,
+				LatestExecutionPayloadHeader: header,
+				Eth1Data:                     eth1DataT,
+				Eth1DepositIndex:             eth1DepositIndex,
+				Validators:                   vals,
 				Balances:                     balances,
 				RandaoMixes:                  randaoMixes,
 				NextWithdrawalIndex:          nextWithdrawalIndex,
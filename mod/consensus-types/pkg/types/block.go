@@ -21,6 +21,7 @@
 package types
 
 import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -39,6 +40,10 @@ func (w *BeaconBlock) Empty(forkVersion uint32) *BeaconBlock {
 		return &BeaconBlock{
 			RawBeaconBlock: (*BeaconBlockDeneb)(nil),
 		}
+	case version.Electra:
+		return &BeaconBlock{
+			RawBeaconBlock: (*BeaconBlockElectra)(nil),
+		}
 	default:
 		panic("fork version not supported")
 	}
@@ -67,6 +72,13 @@ func (w *BeaconBlock) NewWithVersion(
 			BeaconBlockHeaderBase: base,
 			Body:                  &BeaconBlockBodyDeneb{},
 		}
+	case version.Electra:
+		block = &BeaconBlockElectra{
+			BeaconBlockHeaderBase: base,
+			Body: &BeaconBlockBodyElectra{
+				ExecutionRequests: &engineprimitives.ExecutionRequests{},
+			},
+		}
 	default:
 		return &BeaconBlock{}, ErrForkVersionNotSupported
 	}
@@ -85,6 +97,8 @@ func (w *BeaconBlock) NewFromSSZ(
 	switch forkVersion {
 	case version.Deneb:
 		block.RawBeaconBlock = &BeaconBlockDeneb{}
+	case version.Electra:
+		block.RawBeaconBlock = &BeaconBlockElectra{}
 	default:
 		return block, ErrForkVersionNotSupported
 	}
@@ -105,7 +119,7 @@ func (w *BeaconBlock) IsNil() bool {
 // BeaconBlockDeneb represents a block in the beacon chain during
 // the Deneb fork.
 //
-//go:generate go run github.com/ferranbt/fastssz/sszgen --path block.go -objs BeaconBlockDeneb -include ../../../primitives/pkg/common,../../../primitives/pkg/crypto,../../../primitives/pkg/math,..,./header.go,./withdrawal_credentials.go,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,./deposit.go,./payload.go,./deposit.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,../../../primitives/pkg/math,../../../primitives/pkg/common,./body.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output block.ssz.go
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path block.go -objs BeaconBlockDeneb,BeaconBlockElectra -include ../../../primitives/pkg/common,../../../primitives/pkg/crypto,../../../primitives/pkg/math,..,./header.go,./withdrawal_credentials.go,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,../../../engine-primitives/pkg/engine-primitives/execution_requests.go,./deposit.go,./payload.go,./deposit.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,../../../primitives/pkg/math,../../../primitives/pkg/common,./body.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output block.ssz.go
 type BeaconBlockDeneb struct {
 	// BeaconBlockHeaderBase is the base of the BeaconBlockDeneb.
 	BeaconBlockHeaderBase
@@ -151,3 +165,51 @@ func (b BeaconBlockDeneb) GetHeader() *BeaconBlockHeader {
 		BodyRoot: bodyRoot,
 	}
 }
+
+// BeaconBlockElectra represents a block in the beacon chain during
+// the Electra fork.
+type BeaconBlockElectra struct {
+	// BeaconBlockHeaderBase is the base of the BeaconBlockElectra.
+	BeaconBlockHeaderBase
+	// Body is the body of the BeaconBlockElectra, containing the block's
+	// operations.
+	Body *BeaconBlockBodyElectra
+}
+
+// Version identifies the version of the BeaconBlockElectra.
+func (b *BeaconBlockElectra) Version() uint32 {
+	return version.Electra
+}
+
+// IsNil checks if the BeaconBlockElectra instance is nil.
+func (b *BeaconBlockElectra) IsNil() bool {
+	return b == nil
+}
+
+// SetStateRoot sets the state root of the BeaconBlockElectra.
+func (b *BeaconBlockElectra) SetStateRoot(root common.Root) {
+	b.StateRoot = root
+}
+
+// GetBody retrieves the body of the BeaconBlockElectra.
+func (b *BeaconBlockElectra) GetBody() *BeaconBlockBody {
+	return &BeaconBlockBody{RawBeaconBlockBody: b.Body}
+}
+
+// GetHeader builds a BeaconBlockHeader from the BeaconBlockElectra.
+func (b BeaconBlockElectra) GetHeader() *BeaconBlockHeader {
+	bodyRoot, err := b.GetBody().HashTreeRoot()
+	if err != nil {
+		return nil
+	}
+
+	return &BeaconBlockHeader{
+		BeaconBlockHeaderBase: BeaconBlockHeaderBase{
+			Slot:            b.Slot,
+			ProposerIndex:   b.ProposerIndex,
+			ParentBlockRoot: b.ParentBlockRoot,
+			StateRoot:       b.StateRoot,
+		},
+		BodyRoot: bodyRoot,
+	}
+}
@@ -196,3 +196,37 @@ func TestBeaconBlockDeneb_GetTree(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, tree)
 }
+
+func TestBeaconBlockElectra_MarshalUnmarshalSSZ(t *testing.T) {
+	deneb := generateValidBeaconBlockDeneb()
+	block := &types.BeaconBlockElectra{
+		BeaconBlockHeaderBase: deneb.BeaconBlockHeaderBase,
+		Body: &types.BeaconBlockBodyElectra{
+			BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+				Deposits: []*types.Deposit{},
+			},
+			ExecutionPayload: &types.ExecutableDataElectra{
+				ExecutableDataDeneb: *deneb.Body.ExecutionPayload,
+			},
+			BlobKzgCommitments: []eip4844.KZGCommitment{},
+			ExecutionRequests: &engineprimitives.ExecutionRequests{
+				Deposits: []*engineprimitives.DepositRequest{
+					{Amount: 32e9, Index: 7},
+				},
+				Withdrawals:    []*engineprimitives.WithdrawalRequest{},
+				Consolidations: []*engineprimitives.ConsolidationRequest{},
+			},
+		},
+	}
+	require.Equal(t, version.Electra, block.Version())
+
+	bz, err := block.MarshalSSZ()
+	require.NoError(t, err)
+
+	wrapped, err := (&types.BeaconBlock{}).NewFromSSZ(bz, version.Electra)
+	require.NoError(t, err)
+	require.Equal(t, block, wrapped.RawBeaconBlock)
+	require.Len(
+		t, wrapped.GetBody().GetExecutionRequests().GetDeposits(), 1,
+	)
+}
@@ -26,6 +26,7 @@
 package types
 
 import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -47,6 +48,19 @@ const (
 	// KZGMerkleIndexDeneb is the merkle index of BlobKzgCommitments' root
 	// in the merkle tree built from the block body.
 	KZGMerkleIndexDeneb = 26
+
+	// BodyLengthElectra is the number of fields in the BeaconBlockBodyElectra
+	// struct.
+	BodyLengthElectra uint64 = 7
+
+	// KZGPositionElectra is the position of BlobKzgCommitments in the block
+	// body, which is unchanged as ExecutionRequests are appended after it.
+	KZGPositionElectra = KZGPositionDeneb
+
+	// KZGMerkleIndexElectra is the merkle index of BlobKzgCommitments' root
+	// in the merkle tree built from the block body. The body tree depth is
+	// unchanged from Deneb, so is the index.
+	KZGMerkleIndexElectra = KZGMerkleIndexDeneb
 )
 
 type BeaconBlockBody struct {
@@ -66,6 +80,19 @@ func (b *BeaconBlockBody) Empty(forkVersion uint32) *BeaconBlockBody {
 				ExtraData: make([]byte, 32),
 			},
 		}}
+	case version.Electra:
+		return &BeaconBlockBody{RawBeaconBlockBody: &BeaconBlockBodyElectra{
+			BeaconBlockBodyBase: BeaconBlockBodyBase{},
+			ExecutionPayload: &ExecutableDataElectra{
+				ExecutableDataDeneb: ExecutableDataDeneb{
+					//nolint:mnd // todo fix.
+					LogsBloom: make([]byte, 256),
+					//nolint:mnd // todo fix.
+					ExtraData: make([]byte, 32),
+				},
+			},
+			ExecutionRequests: &engineprimitives.ExecutionRequests{},
+		}}
 	default:
 		panic("unsupported fork version")
 	}
@@ -81,6 +108,8 @@ func BlockBodyKZGOffset(
 	switch cs.ActiveForkVersionForSlot(slot) {
 	case version.Deneb:
 		return KZGMerkleIndexDeneb * cs.MaxBlobCommitmentsPerBlock()
+	case version.Electra:
+		return KZGMerkleIndexElectra * cs.MaxBlobCommitmentsPerBlock()
 	default:
 		panic("unsupported fork version")
 	}
@@ -125,6 +154,11 @@ func (b *BeaconBlockBodyBase) GetGraffiti() bytes.B32 {
 	return b.Graffiti
 }
 
+// SetGraffiti sets the Graffiti of the Body.
+func (b *BeaconBlockBodyBase) SetGraffiti(graffiti bytes.B32) {
+	b.Graffiti = graffiti
+}
+
 // GetDeposits returns the Deposits of the BeaconBlockBodyBase.
 func (b *BeaconBlockBodyBase) GetDeposits() []*Deposit {
 	return b.Deposits
@@ -138,7 +172,7 @@ func (b *BeaconBlockBodyBase) SetDeposits(deposits []*Deposit) {
 // BeaconBlockBodyDeneb represents the body of a beacon block in the Deneb
 // chain.
 //
-//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./body.go -objs BeaconBlockBodyDeneb -include ../../../primitives/pkg/crypto,./payload.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,../../../primitives/pkg/math,../../../primitives/pkg/common,./deposit.go,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,./withdrawal_credentials.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output body.ssz.go
+//go:generate go run github.com/ferranbt/fastssz/sszgen --path ./body.go -objs BeaconBlockBodyDeneb,BeaconBlockBodyElectra -include ../../../primitives/pkg/crypto,./payload.go,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,./eth1data.go,../../../primitives/pkg/math,../../../primitives/pkg/common,./deposit.go,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,../../../engine-primitives/pkg/engine-primitives/execution_requests.go,./withdrawal_credentials.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output body.ssz.go
 type BeaconBlockBodyDeneb struct {
 	BeaconBlockBodyBase
 	// ExecutionPayload is the execution payload of the body.
@@ -187,6 +221,21 @@ func (b *BeaconBlockBodyDeneb) SetBlobKzgCommitments(
 	b.BlobKzgCommitments = commitments
 }
 
+// GetExecutionRequests returns nil, as execution requests were introduced in
+// Electra.
+func (
+	b *BeaconBlockBodyDeneb,
+) GetExecutionRequests() *engineprimitives.ExecutionRequests {
+	return nil
+}
+
+// SetExecutionRequests is a no-op, as execution requests were introduced in
+// Electra.
+func (b *BeaconBlockBodyDeneb) SetExecutionRequests(
+	*engineprimitives.ExecutionRequests,
+) {
+}
+
 // GetTopLevelRoots returns the top-level roots of the BeaconBlockBodyDeneb.
 func (b *BeaconBlockBodyDeneb) GetTopLevelRoots() ([][32]byte, error) {
 	layer := make([][32]byte, BodyLengthDeneb)
@@ -222,3 +271,116 @@ func (b *BeaconBlockBodyDeneb) GetTopLevelRoots() ([][32]byte, error) {
 func (b *BeaconBlockBodyDeneb) Length() uint64 {
 	return BodyLengthDeneb
 }
+
+// BeaconBlockBodyElectra represents the body of a beacon block in the Electra
+// chain.
+type BeaconBlockBodyElectra struct {
+	BeaconBlockBodyBase
+	// ExecutionPayload is the execution payload of the body.
+	ExecutionPayload *ExecutableDataElectra
+	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
+	BlobKzgCommitments []eip4844.KZGCommitment `ssz-size:"?,48" ssz-max:"16"`
+	// ExecutionRequests are the requests produced by the execution layer.
+	ExecutionRequests *engineprimitives.ExecutionRequests
+}
+
+// IsNil checks if the BeaconBlockBodyElectra is nil.
+func (b *BeaconBlockBodyElectra) IsNil() bool {
+	return b == nil
+}
+
+// SetEth1Data sets the Eth1Data of the BeaconBlockBodyElectra.
+func (b *BeaconBlockBodyElectra) SetEth1Data(eth1Data *Eth1Data) {
+	b.Eth1Data = eth1Data
+}
+
+// GetExecutionPayload returns the ExecutionPayload of the Body.
+func (
+	b *BeaconBlockBodyElectra,
+) GetExecutionPayload() *ExecutionPayload {
+	return &ExecutionPayload{InnerExecutionPayload: b.ExecutionPayload}
+}
+
+// SetExecutionData sets the ExecutionData of the BeaconBlockBodyElectra.
+func (b *BeaconBlockBodyElectra) SetExecutionData(
+	executionData *ExecutionPayload,
+) error {
+	var ok bool
+	b.ExecutionPayload, ok = executionData.
+		InnerExecutionPayload.(*ExecutableDataElectra)
+	if !ok {
+		return errors.New("invalid execution data type")
+	}
+	return nil
+}
+
+// GetBlobKzgCommitments returns the BlobKzgCommitments of the Body.
+func (
+	b *BeaconBlockBodyElectra,
+) GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash] {
+	return b.BlobKzgCommitments
+}
+
+// SetBlobKzgCommitments sets the BlobKzgCommitments of the
+// BeaconBlockBodyElectra.
+func (b *BeaconBlockBodyElectra) SetBlobKzgCommitments(
+	commitments eip4844.KZGCommitments[common.ExecutionHash],
+) {
+	b.BlobKzgCommitments = commitments
+}
+
+// GetExecutionRequests returns the ExecutionRequests of the Body.
+func (
+	b *BeaconBlockBodyElectra,
+) GetExecutionRequests() *engineprimitives.ExecutionRequests {
+	return b.ExecutionRequests
+}
+
+// SetExecutionRequests sets the ExecutionRequests of the
+// BeaconBlockBodyElectra.
+func (b *BeaconBlockBodyElectra) SetExecutionRequests(
+	requests *engineprimitives.ExecutionRequests,
+) {
+	b.ExecutionRequests = requests
+}
+
+// GetTopLevelRoots returns the top-level roots of the BeaconBlockBodyElectra.
+func (b *BeaconBlockBodyElectra) GetTopLevelRoots() ([][32]byte, error) {
+	layer := make([][32]byte, BodyLengthElectra)
+	var err error
+	randao := b.GetRandaoReveal()
+	layer[0], err = ssz.MerkleizeByteSlice[math.U64, [32]byte](randao[:])
+	if err != nil {
+		return nil, err
+	}
+
+	layer[1], err = b.Eth1Data.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[2] = b.GetGraffiti()
+
+	layer[3], err = Deposits(b.GetDeposits()).HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	layer[4], err = b.GetExecutionPayload().HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	// KZG commitments is not needed, but the execution requests are part of
+	// the KZG commitments inclusion proof.
+	layer[6], err = b.ExecutionRequests.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	return layer, nil
+}
+
+// Length returns the number of fields in the BeaconBlockBodyElectra struct.
+func (b *BeaconBlockBodyElectra) Length() uint64 {
+	return BodyLengthElectra
+}
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// ValidatorRegistration is the preference of a validator for the payloads
+// built for it by external builders, as per the builder API.
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen -path builder.go -objs ValidatorRegistration,SignedValidatorRegistration,BuilderBidDeneb,SignedBuilderBidDeneb,BlindedBeaconBlockBodyDeneb,BlindedBeaconBlockDeneb,SignedBlindedBeaconBlockDeneb,BlobsBundleDeneb,ExecutionPayloadAndBlobsBundleDeneb -include ../../../primitives/pkg/common,../../../primitives/pkg/crypto,../../../primitives/pkg/math,../../../primitives/pkg/eip4844,../../../primitives/pkg/bytes,../../../primitives/mod.go,./header.go,./body.go,./payload.go,./payload_header.go,./deposit.go,./eth1data.go,./withdrawal_credentials.go,../../../engine-primitives/pkg/engine-primitives/withdrawal.go,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil,$GOPATH/pkg/mod/github.com/holiman/uint256@v1.2.4 -output builder.ssz.go
+type ValidatorRegistration struct {
+	// FeeRecipient is the address that receives the fees of the payload.
+	FeeRecipient common.ExecutionAddress `ssz-size:"20"`
+	// GasLimit is the gas limit the payload should target.
+	GasLimit math.U64
+	// Timestamp is the time of the registration.
+	Timestamp math.U64
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey `ssz-size:"48"`
+}
+
+// SignedValidatorRegistration is a validator registration signed by the
+// validator.
+type SignedValidatorRegistration struct {
+	// Message is the validator registration.
+	Message *ValidatorRegistration
+	// Signature is the signature of the validator over the registration.
+	Signature crypto.BLSSignature `ssz-size:"96"`
+}
+
+// BuilderBidDeneb is the bid of an external builder for the payload of a
+// slot in the Deneb fork.
+type BuilderBidDeneb struct {
+	// Header is the header of the payload that is offered.
+	Header *ExecutionPayloadHeaderDeneb
+	// BlobKzgCommitments are the KZG commitments to the blobs of the
+	// payload.
+	BlobKzgCommitments []eip4844.KZGCommitment `ssz-size:"?,48" ssz-max:"16"`
+	// Value is the value of the payload to the proposer.
+	Value math.Wei `ssz-size:"32"`
+	// Pubkey is the public key of the builder.
+	Pubkey crypto.BLSPubkey `ssz-size:"48"`
+}
+
+// SignedBuilderBidDeneb is a bid signed by the builder.
+type SignedBuilderBidDeneb struct {
+	// Message is the bid.
+	Message *BuilderBidDeneb
+	// Signature is the signature of the builder over the bid.
+	Signature crypto.BLSSignature `ssz-size:"96"`
+}
+
+// BlindedBeaconBlockBodyDeneb is a BeaconBlockBodyDeneb that commits to the
+// header of its execution payload instead of the payload itself. Its hash
+// tree root matches the one of the full body.
+type BlindedBeaconBlockBodyDeneb struct {
+	BeaconBlockBodyBase
+	// ExecutionPayloadHeader is the header of the execution payload.
+	ExecutionPayloadHeader *ExecutionPayloadHeaderDeneb
+	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
+	BlobKzgCommitments []eip4844.KZGCommitment `ssz-size:"?,48" ssz-max:"16"`
+}
+
+// BlindedBeaconBlockDeneb is a BeaconBlockDeneb with a blinded body.
+type BlindedBeaconBlockDeneb struct {
+	// BeaconBlockHeaderBase is the base of the BlindedBeaconBlockDeneb.
+	BeaconBlockHeaderBase
+	// Body is the blinded body of the BlindedBeaconBlockDeneb.
+	Body *BlindedBeaconBlockBodyDeneb
+}
+
+// NewBlindedBeaconBlockDeneb assembles a blinded block for the payload with
+// the given header from the fields of a block that is being built.
+func NewBlindedBeaconBlockDeneb(
+	slot math.Slot,
+	proposerIndex math.ValidatorIndex,
+	parentBlockRoot common.Root,
+	body interface {
+		GetRandaoReveal() crypto.BLSSignature
+		GetEth1Data() *Eth1Data
+		GetGraffiti() bytes.B32
+		GetDeposits() []*Deposit
+	},
+	header *ExecutionPayloadHeaderDeneb,
+	blobKzgCommitments []eip4844.KZGCommitment,
+) *BlindedBeaconBlockDeneb {
+	return &BlindedBeaconBlockDeneb{
+		BeaconBlockHeaderBase: BeaconBlockHeaderBase{
+			Slot:            slot.Unwrap(),
+			ProposerIndex:   proposerIndex.Unwrap(),
+			ParentBlockRoot: parentBlockRoot,
+		},
+		Body: &BlindedBeaconBlockBodyDeneb{
+			BeaconBlockBodyBase: BeaconBlockBodyBase{
+				RandaoReveal: body.GetRandaoReveal(),
+				Eth1Data:     body.GetEth1Data(),
+				Graffiti:     body.GetGraffiti(),
+				Deposits:     body.GetDeposits(),
+			},
+			ExecutionPayloadHeader: header,
+			BlobKzgCommitments:     blobKzgCommitments,
+		},
+	}
+}
+
+// Version identifies the version of the BlindedBeaconBlockDeneb.
+func (b *BlindedBeaconBlockDeneb) Version() uint32 {
+	return version.Deneb
+}
+
+// SignedBlindedBeaconBlockDeneb is a blinded block signed by its proposer.
+type SignedBlindedBeaconBlockDeneb struct {
+	// Message is the blinded block.
+	Message *BlindedBeaconBlockDeneb
+	// Signature is the signature of the proposer over the blinded block.
+	Signature crypto.BLSSignature `ssz-size:"96"`
+}
+
+// BlobsBundleDeneb is the blobs bundle of a payload that is revealed by an
+// external builder.
+type BlobsBundleDeneb struct {
+	// Commitments are the KZG commitments to the blobs.
+	Commitments []eip4844.KZGCommitment `ssz-size:"?,48"     ssz-max:"16"`
+	// Proofs are the KZG proofs of the blobs.
+	Proofs []eip4844.KZGProof `ssz-size:"?,48"     ssz-max:"16"`
+	// Blobs are the blobs.
+	Blobs []eip4844.Blob `ssz-size:"?,131072" ssz-max:"16"`
+}
+
+// GetCommitments returns the KZG commitments of the BlobsBundleDeneb.
+func (b *BlobsBundleDeneb) GetCommitments() []eip4844.KZGCommitment {
+	return b.Commitments
+}
+
+// GetProofs returns the KZG proofs of the BlobsBundleDeneb.
+func (b *BlobsBundleDeneb) GetProofs() []eip4844.KZGProof {
+	return b.Proofs
+}
+
+// GetBlobs returns the blobs of the BlobsBundleDeneb.
+func (b *BlobsBundleDeneb) GetBlobs() []*eip4844.Blob {
+	blobs := make([]*eip4844.Blob, len(b.Blobs))
+	for i := range b.Blobs {
+		blobs[i] = &b.Blobs[i]
+	}
+	return blobs
+}
+
+// ExecutionPayloadAndBlobsBundleDeneb is the payload that is revealed by an
+// external builder in exchange for a signed blinded block.
+type ExecutionPayloadAndBlobsBundleDeneb struct {
+	// ExecutionPayload is the execution payload.
+	ExecutionPayload *ExecutableDataDeneb
+	// BlobsBundle is the blobs bundle of the execution payload.
+	BlobsBundle *BlobsBundleDeneb
+}
@@ -0,0 +1,1223 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 18d10b70283ef57fd49f2a85b99e28209bed919f2c17ff76032bed74590a81b5
+// Version: 0.1.3
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the ValidatorRegistration object
+func (v *ValidatorRegistration) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the ValidatorRegistration object to a target array
+func (v *ValidatorRegistration) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'FeeRecipient'
+	dst = append(dst, v.FeeRecipient[:]...)
+
+	// Field (1) 'GasLimit'
+	dst = ssz.MarshalUint64(dst, uint64(v.GasLimit))
+
+	// Field (2) 'Timestamp'
+	dst = ssz.MarshalUint64(dst, uint64(v.Timestamp))
+
+	// Field (3) 'Pubkey'
+	dst = append(dst, v.Pubkey[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ValidatorRegistration object
+func (v *ValidatorRegistration) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 84 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'FeeRecipient'
+	copy(v.FeeRecipient[:], buf[0:20])
+
+	// Field (1) 'GasLimit'
+	v.GasLimit = math.U64(ssz.UnmarshallUint64(buf[20:28]))
+
+	// Field (2) 'Timestamp'
+	v.Timestamp = math.U64(ssz.UnmarshallUint64(buf[28:36]))
+
+	// Field (3) 'Pubkey'
+	copy(v.Pubkey[:], buf[36:84])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ValidatorRegistration object
+func (v *ValidatorRegistration) SizeSSZ() (size int) {
+	size = 84
+	return
+}
+
+// HashTreeRoot ssz hashes the ValidatorRegistration object
+func (v *ValidatorRegistration) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the ValidatorRegistration object with a hasher
+func (v *ValidatorRegistration) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'FeeRecipient'
+	hh.PutBytes(v.FeeRecipient[:])
+
+	// Field (1) 'GasLimit'
+	hh.PutUint64(uint64(v.GasLimit))
+
+	// Field (2) 'Timestamp'
+	hh.PutUint64(uint64(v.Timestamp))
+
+	// Field (3) 'Pubkey'
+	hh.PutBytes(v.Pubkey[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ValidatorRegistration object
+func (v *ValidatorRegistration) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
+}
+
+// MarshalSSZ ssz marshals the SignedValidatorRegistration object
+func (s *SignedValidatorRegistration) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedValidatorRegistration object to a target array
+func (s *SignedValidatorRegistration) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(ValidatorRegistration)
+	}
+	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	dst = append(dst, s.Signature[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedValidatorRegistration object
+func (s *SignedValidatorRegistration) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 180 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(ValidatorRegistration)
+	}
+	if err = s.Message.UnmarshalSSZ(buf[0:84]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	copy(s.Signature[:], buf[84:180])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedValidatorRegistration object
+func (s *SignedValidatorRegistration) SizeSSZ() (size int) {
+	size = 180
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedValidatorRegistration object
+func (s *SignedValidatorRegistration) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedValidatorRegistration object with a hasher
+func (s *SignedValidatorRegistration) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(ValidatorRegistration)
+	}
+	if err = s.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	hh.PutBytes(s.Signature[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedValidatorRegistration object
+func (s *SignedValidatorRegistration) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the BuilderBidDeneb object
+func (b *BuilderBidDeneb) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BuilderBidDeneb object to a target array
+func (b *BuilderBidDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(88)
+
+	// Offset (0) 'Header'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.Header == nil {
+		b.Header = new(ExecutionPayloadHeaderDeneb)
+	}
+	offset += b.Header.SizeSSZ()
+
+	// Offset (1) 'BlobKzgCommitments'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'Value'
+	dst = append(dst, b.Value[:]...)
+
+	// Field (3) 'Pubkey'
+	dst = append(dst, b.Pubkey[:]...)
+
+	// Field (0) 'Header'
+	if dst, err = b.Header.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'BlobKzgCommitments'
+	if size := len(b.BlobKzgCommitments); size > 16 {
+		err = ssz.ErrListTooBigFn("BuilderBidDeneb.BlobKzgCommitments", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.BlobKzgCommitments); ii++ {
+		dst = append(dst, b.BlobKzgCommitments[ii][:]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BuilderBidDeneb object
+func (b *BuilderBidDeneb) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 88 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'Header'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 88 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'BlobKzgCommitments'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (2) 'Value'
+	copy(b.Value[:], buf[8:40])
+
+	// Field (3) 'Pubkey'
+	copy(b.Pubkey[:], buf[40:88])
+
+	// Field (0) 'Header'
+	{
+		buf = tail[o0:o1]
+		if b.Header == nil {
+			b.Header = new(ExecutionPayloadHeaderDeneb)
+		}
+		if err = b.Header.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'BlobKzgCommitments'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 48, 16)
+		if err != nil {
+			return err
+		}
+		b.BlobKzgCommitments = make([]eip4844.KZGCommitment, num)
+		for ii := 0; ii < num; ii++ {
+			copy(b.BlobKzgCommitments[ii][:], buf[ii*48:(ii+1)*48])
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BuilderBidDeneb object
+func (b *BuilderBidDeneb) SizeSSZ() (size int) {
+	size = 88
+
+	// Field (0) 'Header'
+	if b.Header == nil {
+		b.Header = new(ExecutionPayloadHeaderDeneb)
+	}
+	size += b.Header.SizeSSZ()
+
+	// Field (1) 'BlobKzgCommitments'
+	size += len(b.BlobKzgCommitments) * 48
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BuilderBidDeneb object
+func (b *BuilderBidDeneb) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BuilderBidDeneb object with a hasher
+func (b *BuilderBidDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Header'
+	if err = b.Header.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'BlobKzgCommitments'
+	{
+		if size := len(b.BlobKzgCommitments); size > 16 {
+			err = ssz.ErrListTooBigFn("BuilderBidDeneb.BlobKzgCommitments", size, 16)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.BlobKzgCommitments {
+			hh.PutBytes(i[:])
+		}
+		numItems := uint64(len(b.BlobKzgCommitments))
+		hh.MerkleizeWithMixin(subIndx, numItems, 16)
+	}
+
+	// Field (2) 'Value'
+	hh.PutBytes(b.Value[:])
+
+	// Field (3) 'Pubkey'
+	hh.PutBytes(b.Pubkey[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BuilderBidDeneb object
+func (b *BuilderBidDeneb) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// MarshalSSZ ssz marshals the SignedBuilderBidDeneb object
+func (s *SignedBuilderBidDeneb) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBuilderBidDeneb object to a target array
+func (s *SignedBuilderBidDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(100)
+
+	// Offset (0) 'Message'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Signature'
+	dst = append(dst, s.Signature[:]...)
+
+	// Field (0) 'Message'
+	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBuilderBidDeneb object
+func (s *SignedBuilderBidDeneb) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 100 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Message'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 100 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Signature'
+	copy(s.Signature[:], buf[4:100])
+
+	// Field (0) 'Message'
+	{
+		buf = tail[o0:]
+		if s.Message == nil {
+			s.Message = new(BuilderBidDeneb)
+		}
+		if err = s.Message.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBuilderBidDeneb object
+func (s *SignedBuilderBidDeneb) SizeSSZ() (size int) {
+	size = 100
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(BuilderBidDeneb)
+	}
+	size += s.Message.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBuilderBidDeneb object
+func (s *SignedBuilderBidDeneb) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBuilderBidDeneb object with a hasher
+func (s *SignedBuilderBidDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if err = s.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	hh.PutBytes(s.Signature[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedBuilderBidDeneb object
+func (s *SignedBuilderBidDeneb) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the BlindedBeaconBlockBodyDeneb object
+func (b *BlindedBeaconBlockBodyDeneb) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlindedBeaconBlockBodyDeneb object to a target array
+func (b *BlindedBeaconBlockBodyDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(212)
+
+	// Field (0) 'RandaoReveal'
+	dst = append(dst, b.RandaoReveal[:]...)
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	dst = append(dst, b.Graffiti[:]...)
+
+	// Offset (3) 'Deposits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Deposits) * 192
+
+	// Offset (4) 'ExecutionPayloadHeader'
+	dst = ssz.WriteOffset(dst, offset)
+	if b.ExecutionPayloadHeader == nil {
+		b.ExecutionPayloadHeader = new(ExecutionPayloadHeaderDeneb)
+	}
+	offset += b.ExecutionPayloadHeader.SizeSSZ()
+
+	// Offset (5) 'BlobKzgCommitments'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (3) 'Deposits'
+	if size := len(b.Deposits); size > 16 {
+		err = ssz.ErrListTooBigFn("BlindedBeaconBlockBodyDeneb.Deposits", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Deposits); ii++ {
+		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'ExecutionPayloadHeader'
+	if dst, err = b.ExecutionPayloadHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'BlobKzgCommitments'
+	if size := len(b.BlobKzgCommitments); size > 16 {
+		err = ssz.ErrListTooBigFn("BlindedBeaconBlockBodyDeneb.BlobKzgCommitments", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.BlobKzgCommitments); ii++ {
+		dst = append(dst, b.BlobKzgCommitments[ii][:]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlindedBeaconBlockBodyDeneb object
+func (b *BlindedBeaconBlockBodyDeneb) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 212 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o3, o4, o5 uint64
+
+	// Field (0) 'RandaoReveal'
+	copy(b.RandaoReveal[:], buf[0:96])
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Graffiti'
+	copy(b.Graffiti[:], buf[168:200])
+
+	// Offset (3) 'Deposits'
+	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+		return ssz.ErrOffset
+	}
+
+	if o3 < 212 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (4) 'ExecutionPayloadHeader'
+	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (5) 'BlobKzgCommitments'
+	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Field (3) 'Deposits'
+	{
+		buf = tail[o3:o4]
+		num, err := ssz.DivideInt2(len(buf), 192, 16)
+		if err != nil {
+			return err
+		}
+		b.Deposits = make([]*Deposit, num)
+		for ii := 0; ii < num; ii++ {
+			if b.Deposits[ii] == nil {
+				b.Deposits[ii] = new(Deposit)
+			}
+			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*192 : (ii+1)*192]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (4) 'ExecutionPayloadHeader'
+	{
+		buf = tail[o4:o5]
+		if b.ExecutionPayloadHeader == nil {
+			b.ExecutionPayloadHeader = new(ExecutionPayloadHeaderDeneb)
+		}
+		if err = b.ExecutionPayloadHeader.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (5) 'BlobKzgCommitments'
+	{
+		buf = tail[o5:]
+		num, err := ssz.DivideInt2(len(buf), 48, 16)
+		if err != nil {
+			return err
+		}
+		b.BlobKzgCommitments = make([]eip4844.KZGCommitment, num)
+		for ii := 0; ii < num; ii++ {
+			copy(b.BlobKzgCommitments[ii][:], buf[ii*48:(ii+1)*48])
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlindedBeaconBlockBodyDeneb object
+func (b *BlindedBeaconBlockBodyDeneb) SizeSSZ() (size int) {
+	size = 212
+
+	// Field (3) 'Deposits'
+	size += len(b.Deposits) * 192
+
+	// Field (4) 'ExecutionPayloadHeader'
+	if b.ExecutionPayloadHeader == nil {
+		b.ExecutionPayloadHeader = new(ExecutionPayloadHeaderDeneb)
+	}
+	size += b.ExecutionPayloadHeader.SizeSSZ()
+
+	// Field (5) 'BlobKzgCommitments'
+	size += len(b.BlobKzgCommitments) * 48
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BlindedBeaconBlockBodyDeneb object
+func (b *BlindedBeaconBlockBodyDeneb) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlindedBeaconBlockBodyDeneb object with a hasher
+func (b *BlindedBeaconBlockBodyDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'RandaoReveal'
+	hh.PutBytes(b.RandaoReveal[:])
+
+	// Field (1) 'Eth1Data'
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(Eth1Data)
+	}
+	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'Graffiti'
+	hh.PutBytes(b.Graffiti[:])
+
+	// Field (3) 'Deposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Deposits))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Deposits {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (4) 'ExecutionPayloadHeader'
+	if err = b.ExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (5) 'BlobKzgCommitments'
+	{
+		if size := len(b.BlobKzgCommitments); size > 16 {
+			err = ssz.ErrListTooBigFn("BlindedBeaconBlockBodyDeneb.BlobKzgCommitments", size, 16)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.BlobKzgCommitments {
+			hh.PutBytes(i[:])
+		}
+		numItems := uint64(len(b.BlobKzgCommitments))
+		hh.MerkleizeWithMixin(subIndx, numItems, 16)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BlindedBeaconBlockBodyDeneb object
+func (b *BlindedBeaconBlockBodyDeneb) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// MarshalSSZ ssz marshals the BlindedBeaconBlockDeneb object
+func (b *BlindedBeaconBlockDeneb) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlindedBeaconBlockDeneb object to a target array
+func (b *BlindedBeaconBlockDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(84)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, b.ProposerIndex)
+
+	// Field (2) 'ParentBlockRoot'
+	dst = append(dst, b.ParentBlockRoot[:]...)
+
+	// Field (3) 'StateRoot'
+	dst = append(dst, b.StateRoot[:]...)
+
+	// Offset (4) 'Body'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (4) 'Body'
+	if dst, err = b.Body.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlindedBeaconBlockDeneb object
+func (b *BlindedBeaconBlockDeneb) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 84 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o4 uint64
+
+	// Field (0) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ProposerIndex'
+	b.ProposerIndex = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'ParentBlockRoot'
+	copy(b.ParentBlockRoot[:], buf[16:48])
+
+	// Field (3) 'StateRoot'
+	copy(b.StateRoot[:], buf[48:80])
+
+	// Offset (4) 'Body'
+	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
+		return ssz.ErrOffset
+	}
+
+	if o4 < 84 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (4) 'Body'
+	{
+		buf = tail[o4:]
+		if b.Body == nil {
+			b.Body = new(BlindedBeaconBlockBodyDeneb)
+		}
+		if err = b.Body.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlindedBeaconBlockDeneb object
+func (b *BlindedBeaconBlockDeneb) SizeSSZ() (size int) {
+	size = 84
+
+	// Field (4) 'Body'
+	if b.Body == nil {
+		b.Body = new(BlindedBeaconBlockBodyDeneb)
+	}
+	size += b.Body.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BlindedBeaconBlockDeneb object
+func (b *BlindedBeaconBlockDeneb) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlindedBeaconBlockDeneb object with a hasher
+func (b *BlindedBeaconBlockDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(b.ProposerIndex)
+
+	// Field (2) 'ParentBlockRoot'
+	hh.PutBytes(b.ParentBlockRoot[:])
+
+	// Field (3) 'StateRoot'
+	hh.PutBytes(b.StateRoot[:])
+
+	// Field (4) 'Body'
+	if err = b.Body.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BlindedBeaconBlockDeneb object
+func (b *BlindedBeaconBlockDeneb) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// MarshalSSZ ssz marshals the SignedBlindedBeaconBlockDeneb object
+func (s *SignedBlindedBeaconBlockDeneb) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBlindedBeaconBlockDeneb object to a target array
+func (s *SignedBlindedBeaconBlockDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(100)
+
+	// Offset (0) 'Message'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Signature'
+	dst = append(dst, s.Signature[:]...)
+
+	// Field (0) 'Message'
+	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBlindedBeaconBlockDeneb object
+func (s *SignedBlindedBeaconBlockDeneb) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 100 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Message'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 100 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Signature'
+	copy(s.Signature[:], buf[4:100])
+
+	// Field (0) 'Message'
+	{
+		buf = tail[o0:]
+		if s.Message == nil {
+			s.Message = new(BlindedBeaconBlockDeneb)
+		}
+		if err = s.Message.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBlindedBeaconBlockDeneb object
+func (s *SignedBlindedBeaconBlockDeneb) SizeSSZ() (size int) {
+	size = 100
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(BlindedBeaconBlockDeneb)
+	}
+	size += s.Message.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SignedBlindedBeaconBlockDeneb object
+func (s *SignedBlindedBeaconBlockDeneb) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBlindedBeaconBlockDeneb object with a hasher
+func (s *SignedBlindedBeaconBlockDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if err = s.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	hh.PutBytes(s.Signature[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedBlindedBeaconBlockDeneb object
+func (s *SignedBlindedBeaconBlockDeneb) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+// MarshalSSZ ssz marshals the BlobsBundleDeneb object
+func (b *BlobsBundleDeneb) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlobsBundleDeneb object to a target array
+func (b *BlobsBundleDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(12)
+
+	// Offset (0) 'Commitments'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Commitments) * 48
+
+	// Offset (1) 'Proofs'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Proofs) * 48
+
+	// Offset (2) 'Blobs'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Commitments'
+	if size := len(b.Commitments); size > 16 {
+		err = ssz.ErrListTooBigFn("BlobsBundleDeneb.Commitments", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Commitments); ii++ {
+		dst = append(dst, b.Commitments[ii][:]...)
+	}
+
+	// Field (1) 'Proofs'
+	if size := len(b.Proofs); size > 16 {
+		err = ssz.ErrListTooBigFn("BlobsBundleDeneb.Proofs", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Proofs); ii++ {
+		dst = append(dst, b.Proofs[ii][:]...)
+	}
+
+	// Field (2) 'Blobs'
+	if size := len(b.Blobs); size > 16 {
+		err = ssz.ErrListTooBigFn("BlobsBundleDeneb.Blobs", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.Blobs); ii++ {
+		dst = append(dst, b.Blobs[ii][:]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlobsBundleDeneb object
+func (b *BlobsBundleDeneb) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o2 uint64
+
+	// Offset (0) 'Commitments'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 12 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Proofs'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (2) 'Blobs'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Commitments'
+	{
+		buf = tail[o0:o1]
+		num, err := ssz.DivideInt2(len(buf), 48, 16)
+		if err != nil {
+			return err
+		}
+		b.Commitments = make([]eip4844.KZGCommitment, num)
+		for ii := 0; ii < num; ii++ {
+			copy(b.Commitments[ii][:], buf[ii*48:(ii+1)*48])
+		}
+	}
+
+	// Field (1) 'Proofs'
+	{
+		buf = tail[o1:o2]
+		num, err := ssz.DivideInt2(len(buf), 48, 16)
+		if err != nil {
+			return err
+		}
+		b.Proofs = make([]eip4844.KZGProof, num)
+		for ii := 0; ii < num; ii++ {
+			copy(b.Proofs[ii][:], buf[ii*48:(ii+1)*48])
+		}
+	}
+
+	// Field (2) 'Blobs'
+	{
+		buf = tail[o2:]
+		num, err := ssz.DivideInt2(len(buf), 131072, 16)
+		if err != nil {
+			return err
+		}
+		b.Blobs = make([]eip4844.Blob, num)
+		for ii := 0; ii < num; ii++ {
+			copy(b.Blobs[ii][:], buf[ii*131072:(ii+1)*131072])
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlobsBundleDeneb object
+func (b *BlobsBundleDeneb) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (0) 'Commitments'
+	size += len(b.Commitments) * 48
+
+	// Field (1) 'Proofs'
+	size += len(b.Proofs) * 48
+
+	// Field (2) 'Blobs'
+	size += len(b.Blobs) * 131072
+
+	return
+}
+
+// HashTreeRoot ssz hashes the BlobsBundleDeneb object
+func (b *BlobsBundleDeneb) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlobsBundleDeneb object with a hasher
+func (b *BlobsBundleDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Commitments'
+	{
+		if size := len(b.Commitments); size > 16 {
+			err = ssz.ErrListTooBigFn("BlobsBundleDeneb.Commitments", size, 16)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Commitments {
+			hh.PutBytes(i[:])
+		}
+		numItems := uint64(len(b.Commitments))
+		hh.MerkleizeWithMixin(subIndx, numItems, 16)
+	}
+
+	// Field (1) 'Proofs'
+	{
+		if size := len(b.Proofs); size > 16 {
+			err = ssz.ErrListTooBigFn("BlobsBundleDeneb.Proofs", size, 16)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Proofs {
+			hh.PutBytes(i[:])
+		}
+		numItems := uint64(len(b.Proofs))
+		hh.MerkleizeWithMixin(subIndx, numItems, 16)
+	}
+
+	// Field (2) 'Blobs'
+	{
+		if size := len(b.Blobs); size > 16 {
+			err = ssz.ErrListTooBigFn("BlobsBundleDeneb.Blobs", size, 16)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.Blobs {
+			hh.PutBytes(i[:])
+		}
+		numItems := uint64(len(b.Blobs))
+		hh.MerkleizeWithMixin(subIndx, numItems, 16)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BlobsBundleDeneb object
+func (b *BlobsBundleDeneb) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// MarshalSSZ ssz marshals the ExecutionPayloadAndBlobsBundleDeneb object
+func (e *ExecutionPayloadAndBlobsBundleDeneb) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ExecutionPayloadAndBlobsBundleDeneb object to a target array
+func (e *ExecutionPayloadAndBlobsBundleDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'ExecutionPayload'
+	dst = ssz.WriteOffset(dst, offset)
+	if e.ExecutionPayload == nil {
+		e.ExecutionPayload = new(ExecutableDataDeneb)
+	}
+	offset += e.ExecutionPayload.SizeSSZ()
+
+	// Offset (1) 'BlobsBundle'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'ExecutionPayload'
+	if dst, err = e.ExecutionPayload.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'BlobsBundle'
+	if dst, err = e.BlobsBundle.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ExecutionPayloadAndBlobsBundleDeneb object
+func (e *ExecutionPayloadAndBlobsBundleDeneb) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'ExecutionPayload'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'BlobsBundle'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'ExecutionPayload'
+	{
+		buf = tail[o0:o1]
+		if e.ExecutionPayload == nil {
+			e.ExecutionPayload = new(ExecutableDataDeneb)
+		}
+		if err = e.ExecutionPayload.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'BlobsBundle'
+	{
+		buf = tail[o1:]
+		if e.BlobsBundle == nil {
+			e.BlobsBundle = new(BlobsBundleDeneb)
+		}
+		if err = e.BlobsBundle.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayloadAndBlobsBundleDeneb object
+func (e *ExecutionPayloadAndBlobsBundleDeneb) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'ExecutionPayload'
+	if e.ExecutionPayload == nil {
+		e.ExecutionPayload = new(ExecutableDataDeneb)
+	}
+	size += e.ExecutionPayload.SizeSSZ()
+
+	// Field (1) 'BlobsBundle'
+	if e.BlobsBundle == nil {
+		e.BlobsBundle = new(BlobsBundleDeneb)
+	}
+	size += e.BlobsBundle.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the ExecutionPayloadAndBlobsBundleDeneb object
+func (e *ExecutionPayloadAndBlobsBundleDeneb) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the ExecutionPayloadAndBlobsBundleDeneb object with a hasher
+func (e *ExecutionPayloadAndBlobsBundleDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ExecutionPayload'
+	if err = e.ExecutionPayload.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'BlobsBundle'
+	if err = e.BlobsBundle.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ExecutionPayloadAndBlobsBundleDeneb object
+func (e *ExecutionPayloadAndBlobsBundleDeneb) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlindedBeaconBlockDeneb_HashTreeRoot(t *testing.T) {
+	block := generateValidBeaconBlockDeneb()
+	block.Body.Eth1Data = &types.Eth1Data{DepositCount: 3}
+	block.Body.Deposits = []*types.Deposit{{Amount: 32e9, Index: 1}}
+	block.Body.BlobKzgCommitments = []eip4844.KZGCommitment{{1}, {2}}
+
+	header, err := block.GetBody().GetExecutionPayload().ToHeader()
+	require.NoError(t, err)
+	denebHeader, ok := header.InnerExecutionPayloadHeader.(*types.ExecutionPayloadHeaderDeneb)
+	require.True(t, ok)
+
+	blinded := types.NewBlindedBeaconBlockDeneb(
+		math.Slot(block.Slot),
+		math.ValidatorIndex(block.ProposerIndex),
+		block.ParentBlockRoot,
+		block.Body,
+		denebHeader,
+		block.Body.BlobKzgCommitments,
+	)
+	blinded.StateRoot = block.StateRoot
+
+	// The blinded block commits to the same block as the full one.
+	expected, err := block.HashTreeRoot()
+	require.NoError(t, err)
+	actual, err := blinded.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestSignedBlindedBeaconBlockDeneb_MarshalUnmarshalSSZ(t *testing.T) {
+	block := generateValidBeaconBlockDeneb()
+	block.Body.Eth1Data = &types.Eth1Data{}
+	block.Body.Deposits = []*types.Deposit{}
+
+	header, err := block.GetBody().GetExecutionPayload().ToHeader()
+	require.NoError(t, err)
+	signed := &types.SignedBlindedBeaconBlockDeneb{
+		Message: types.NewBlindedBeaconBlockDeneb(
+			math.Slot(block.Slot),
+			math.ValidatorIndex(block.ProposerIndex),
+			block.ParentBlockRoot,
+			block.Body,
+			header.InnerExecutionPayloadHeader.(*types.ExecutionPayloadHeaderDeneb),
+			[]eip4844.KZGCommitment{},
+		),
+		Signature: [96]byte{1},
+	}
+
+	bz, err := signed.MarshalSSZ()
+	require.NoError(t, err)
+	unmarshalled := new(types.SignedBlindedBeaconBlockDeneb)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(bz))
+	require.Equal(t, signed, unmarshalled)
+}
+
+func TestBlobsBundleDeneb_GetBlobs(t *testing.T) {
+	bundle := &types.BlobsBundleDeneb{
+		Blobs: []eip4844.Blob{{1}, {2}},
+	}
+	blobs := bundle.GetBlobs()
+	require.Len(t, blobs, 2)
+	require.Equal(t, byte(2), blobs[1][0])
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	fastssz "github.com/ferranbt/fastssz"
 )
 
 // Deposit into the consensus layer from the deposit contract in the execution
@@ -106,6 +107,22 @@ func (d *Deposit) VerifySignature(
 	)
 }
 
+// DepositDataRoot returns the hash tree root of the DepositData of the
+// deposit, i.e. the deposit without its index, which is the leaf the deposit
+// contract inserts into its deposit tree.
+func (d *Deposit) DepositDataRoot() (common.Root, error) {
+	hh := fastssz.DefaultHasherPool.Get()
+	defer fastssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+	hh.PutBytes(d.Pubkey[:])
+	hh.PutBytes(d.Credentials[:])
+	hh.PutUint64(uint64(d.Amount))
+	hh.PutBytes(d.Signature[:])
+	hh.Merkleize(indx)
+	return hh.HashRoot()
+}
+
 // GetAmount returns the deposit amount in gwei.
 func (d *Deposit) GetAmount() math.Gwei {
 	return d.Amount
@@ -44,6 +44,16 @@ type DepositMessage struct {
 	Amount math.Gwei `json:"amount"`
 }
 
+// depositSigner is a BLS signer of typed deposit messages, e.g. a remote
+// signer.
+type depositSigner interface {
+	SignDeposit(
+		forkVersion common.Version,
+		msg *DepositMessage,
+		signingRoot common.Root,
+	) (crypto.BLSSignature, error)
+}
+
 // CreateAndSignDepositMessage constructs and signs a deposit message.
 func CreateAndSignDepositMessage(
 	forkData *ForkData,
@@ -68,7 +78,14 @@ func CreateAndSignDepositMessage(
 		return nil, crypto.BLSSignature{}, err
 	}
 
-	signature, err := signer.Sign(signingRoot[:])
+	var signature crypto.BLSSignature
+	if ds, ok := signer.(depositSigner); ok {
+		signature, err = ds.SignDeposit(
+			forkData.CurrentVersion, depositMessage, signingRoot,
+		)
+	} else {
+		signature, err = signer.Sign(signingRoot[:])
+	}
 	if err != nil {
 		return nil, crypto.BLSSignature{}, err
 	}
@@ -21,6 +21,8 @@
 package types_test
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -150,3 +152,47 @@ func TestDeposit_Getters(t *testing.T) {
 	require.Equal(t, deposit.Signature, deposit.GetSignature())
 	require.Equal(t, deposit.Index, deposit.GetIndex())
 }
+
+func TestDeposit_DepositDataRoot(t *testing.T) {
+	deposit := generateValidDeposit()
+	for i := range deposit.Pubkey {
+		deposit.Pubkey[i] = byte(i)
+	}
+	for i := range deposit.Credentials {
+		deposit.Credentials[i] = byte(i + 1)
+	}
+	for i := range deposit.Signature {
+		deposit.Signature[i] = byte(i + 2)
+	}
+	deposit.Amount = math.Gwei(32e9)
+
+	// The leaf as computed by the deposit contract.
+	sum := func(data ...[]byte) []byte {
+		h := sha256.New()
+		for _, d := range data {
+			h.Write(d)
+		}
+		return h.Sum(nil)
+	}
+	amount := make([]byte, 32)
+	binary.LittleEndian.PutUint64(amount, uint64(deposit.Amount))
+	pubkeyRoot := sum(deposit.Pubkey[:], make([]byte, 16))
+	signatureRoot := sum(
+		sum(deposit.Signature[:64]),
+		sum(deposit.Signature[64:], make([]byte, 32)),
+	)
+	expected := sum(
+		sum(pubkeyRoot, deposit.Credentials[:]),
+		sum(amount, signatureRoot),
+	)
+
+	root, err := deposit.DepositDataRoot()
+	require.NoError(t, err)
+	require.Equal(t, expected, root[:])
+
+	// The index is not part of the leaf.
+	deposit.Index++
+	other, err := deposit.DepositDataRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, other)
+}
@@ -23,6 +23,7 @@ package types
 import (
 	"encoding/json"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -46,6 +47,8 @@ type WriteOnlyBeaconBlockBody interface {
 	SetExecutionData(*ExecutionPayload) error
 	SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
 	SetRandaoReveal(crypto.BLSSignature)
+	SetGraffiti(bytes.B32)
+	SetExecutionRequests(*engineprimitives.ExecutionRequests)
 }
 
 // ReadOnlyBeaconBlockBody is the interface for
@@ -61,6 +64,7 @@ type ReadOnlyBeaconBlockBody interface {
 	GetRandaoReveal() crypto.BLSSignature
 	GetExecutionPayload() *ExecutionPayload
 	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+	GetExecutionRequests() *engineprimitives.ExecutionRequests
 	GetTopLevelRoots() ([][32]byte, error)
 }
 
@@ -772,6 +772,39 @@ func (_c *BeaconBlockBody_SetExecutionData_Call) RunAndReturn(run func(*types.Ex
 	return _c
 }
 
+// SetGraffiti provides a mock function with given fields: _a0
+func (_m *BeaconBlockBody) SetGraffiti(_a0 bytes.B32) {
+	_m.Called(_a0)
+}
+
+// BeaconBlockBody_SetGraffiti_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetGraffiti'
+type BeaconBlockBody_SetGraffiti_Call struct {
+	*mock.Call
+}
+
+// SetGraffiti is a helper method to define mock.On call
+//   - _a0 bytes.B32
+func (_e *BeaconBlockBody_Expecter) SetGraffiti(_a0 interface{}) *BeaconBlockBody_SetGraffiti_Call {
+	return &BeaconBlockBody_SetGraffiti_Call{Call: _e.mock.On("SetGraffiti", _a0)}
+}
+
+func (_c *BeaconBlockBody_SetGraffiti_Call) Run(run func(_a0 bytes.B32)) *BeaconBlockBody_SetGraffiti_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bytes.B32))
+	})
+	return _c
+}
+
+func (_c *BeaconBlockBody_SetGraffiti_Call) Return() *BeaconBlockBody_SetGraffiti_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *BeaconBlockBody_SetGraffiti_Call) RunAndReturn(run func(bytes.B32)) *BeaconBlockBody_SetGraffiti_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetRandaoReveal provides a mock function with given fields: _a0
 func (_m *BeaconBlockBody) SetRandaoReveal(_a0 bytes.B96) {
 	_m.Called(_a0)
@@ -8,6 +8,8 @@ import (
 
 	eip4844 "github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+
 	mock "github.com/stretchr/testify/mock"
 
 	types "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -214,6 +216,53 @@ func (_c *RawBeaconBlockBody_GetExecutionPayload_Call) RunAndReturn(run func() *
 	return _c
 }
 
+// GetExecutionRequests provides a mock function with given fields:
+func (_m *RawBeaconBlockBody) GetExecutionRequests() *engineprimitives.ExecutionRequests {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExecutionRequests")
+	}
+
+	var r0 *engineprimitives.ExecutionRequests
+	if rf, ok := ret.Get(0).(func() *engineprimitives.ExecutionRequests); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*engineprimitives.ExecutionRequests)
+		}
+	}
+
+	return r0
+}
+
+// RawBeaconBlockBody_GetExecutionRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExecutionRequests'
+type RawBeaconBlockBody_GetExecutionRequests_Call struct {
+	*mock.Call
+}
+
+// GetExecutionRequests is a helper method to define mock.On call
+func (_e *RawBeaconBlockBody_Expecter) GetExecutionRequests() *RawBeaconBlockBody_GetExecutionRequests_Call {
+	return &RawBeaconBlockBody_GetExecutionRequests_Call{Call: _e.mock.On("GetExecutionRequests")}
+}
+
+func (_c *RawBeaconBlockBody_GetExecutionRequests_Call) Run(run func()) *RawBeaconBlockBody_GetExecutionRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_GetExecutionRequests_Call) Return(_a0 *engineprimitives.ExecutionRequests) *RawBeaconBlockBody_GetExecutionRequests_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_GetExecutionRequests_Call) RunAndReturn(run func() *engineprimitives.ExecutionRequests) *RawBeaconBlockBody_GetExecutionRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetGraffiti provides a mock function with given fields:
 func (_m *RawBeaconBlockBody) GetGraffiti() bytes.B32 {
 	ret := _m.Called()
@@ -772,6 +821,72 @@ func (_c *RawBeaconBlockBody_SetExecutionData_Call) RunAndReturn(run func(*types
 	return _c
 }
 
+// SetExecutionRequests provides a mock function with given fields: _a0
+func (_m *RawBeaconBlockBody) SetExecutionRequests(_a0 *engineprimitives.ExecutionRequests) {
+	_m.Called(_a0)
+}
+
+// RawBeaconBlockBody_SetExecutionRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetExecutionRequests'
+type RawBeaconBlockBody_SetExecutionRequests_Call struct {
+	*mock.Call
+}
+
+// SetExecutionRequests is a helper method to define mock.On call
+//   - _a0 *engineprimitives.ExecutionRequests
+func (_e *RawBeaconBlockBody_Expecter) SetExecutionRequests(_a0 interface{}) *RawBeaconBlockBody_SetExecutionRequests_Call {
+	return &RawBeaconBlockBody_SetExecutionRequests_Call{Call: _e.mock.On("SetExecutionRequests", _a0)}
+}
+
+func (_c *RawBeaconBlockBody_SetExecutionRequests_Call) Run(run func(_a0 *engineprimitives.ExecutionRequests)) *RawBeaconBlockBody_SetExecutionRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*engineprimitives.ExecutionRequests))
+	})
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_SetExecutionRequests_Call) Return() *RawBeaconBlockBody_SetExecutionRequests_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_SetExecutionRequests_Call) RunAndReturn(run func(*engineprimitives.ExecutionRequests)) *RawBeaconBlockBody_SetExecutionRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetGraffiti provides a mock function with given fields: _a0
+func (_m *RawBeaconBlockBody) SetGraffiti(_a0 bytes.B32) {
+	_m.Called(_a0)
+}
+
+// RawBeaconBlockBody_SetGraffiti_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetGraffiti'
+type RawBeaconBlockBody_SetGraffiti_Call struct {
+	*mock.Call
+}
+
+// SetGraffiti is a helper method to define mock.On call
+//   - _a0 bytes.B32
+func (_e *RawBeaconBlockBody_Expecter) SetGraffiti(_a0 interface{}) *RawBeaconBlockBody_SetGraffiti_Call {
+	return &RawBeaconBlockBody_SetGraffiti_Call{Call: _e.mock.On("SetGraffiti", _a0)}
+}
+
+func (_c *RawBeaconBlockBody_SetGraffiti_Call) Run(run func(_a0 bytes.B32)) *RawBeaconBlockBody_SetGraffiti_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bytes.B32))
+	})
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_SetGraffiti_Call) Return() *RawBeaconBlockBody_SetGraffiti_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RawBeaconBlockBody_SetGraffiti_Call) RunAndReturn(run func(bytes.B32)) *RawBeaconBlockBody_SetGraffiti_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetRandaoReveal provides a mock function with given fields: _a0
 func (_m *RawBeaconBlockBody) SetRandaoReveal(_a0 bytes.B96) {
 	_m.Called(_a0)
@@ -8,6 +8,8 @@ import (
 
 	eip4844 "github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+
 	mock "github.com/stretchr/testify/mock"
 
 	types "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -214,6 +216,53 @@ func (_c *ReadOnlyBeaconBlockBody_GetExecutionPayload_Call) RunAndReturn(run fun
 	return _c
 }
 
+// GetExecutionRequests provides a mock function with given fields:
+func (_m *ReadOnlyBeaconBlockBody) GetExecutionRequests() *engineprimitives.ExecutionRequests {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExecutionRequests")
+	}
+
+	var r0 *engineprimitives.ExecutionRequests
+	if rf, ok := ret.Get(0).(func() *engineprimitives.ExecutionRequests); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*engineprimitives.ExecutionRequests)
+		}
+	}
+
+	return r0
+}
+
+// ReadOnlyBeaconBlockBody_GetExecutionRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExecutionRequests'
+type ReadOnlyBeaconBlockBody_GetExecutionRequests_Call struct {
+	*mock.Call
+}
+
+// GetExecutionRequests is a helper method to define mock.On call
+func (_e *ReadOnlyBeaconBlockBody_Expecter) GetExecutionRequests() *ReadOnlyBeaconBlockBody_GetExecutionRequests_Call {
+	return &ReadOnlyBeaconBlockBody_GetExecutionRequests_Call{Call: _e.mock.On("GetExecutionRequests")}
+}
+
+func (_c *ReadOnlyBeaconBlockBody_GetExecutionRequests_Call) Run(run func()) *ReadOnlyBeaconBlockBody_GetExecutionRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ReadOnlyBeaconBlockBody_GetExecutionRequests_Call) Return(_a0 *engineprimitives.ExecutionRequests) *ReadOnlyBeaconBlockBody_GetExecutionRequests_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReadOnlyBeaconBlockBody_GetExecutionRequests_Call) RunAndReturn(run func() *engineprimitives.ExecutionRequests) *ReadOnlyBeaconBlockBody_GetExecutionRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetGraffiti provides a mock function with given fields:
 func (_m *ReadOnlyBeaconBlockBody) GetGraffiti() bytes.B32 {
 	ret := _m.Called()
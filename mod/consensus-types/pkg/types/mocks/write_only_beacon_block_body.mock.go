@@ -8,6 +8,8 @@ import (
 
 	eip4844 "github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+
 	mock "github.com/stretchr/testify/mock"
 
 	types "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -171,6 +173,72 @@ func (_c *WriteOnlyBeaconBlockBody_SetExecutionData_Call) RunAndReturn(run func(
 	return _c
 }
 
+// SetExecutionRequests provides a mock function with given fields: _a0
+func (_m *WriteOnlyBeaconBlockBody) SetExecutionRequests(_a0 *engineprimitives.ExecutionRequests) {
+	_m.Called(_a0)
+}
+
+// WriteOnlyBeaconBlockBody_SetExecutionRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetExecutionRequests'
+type WriteOnlyBeaconBlockBody_SetExecutionRequests_Call struct {
+	*mock.Call
+}
+
+// SetExecutionRequests is a helper method to define mock.On call
+//   - _a0 *engineprimitives.ExecutionRequests
+func (_e *WriteOnlyBeaconBlockBody_Expecter) SetExecutionRequests(_a0 interface{}) *WriteOnlyBeaconBlockBody_SetExecutionRequests_Call {
+	return &WriteOnlyBeaconBlockBody_SetExecutionRequests_Call{Call: _e.mock.On("SetExecutionRequests", _a0)}
+}
+
+func (_c *WriteOnlyBeaconBlockBody_SetExecutionRequests_Call) Run(run func(_a0 *engineprimitives.ExecutionRequests)) *WriteOnlyBeaconBlockBody_SetExecutionRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*engineprimitives.ExecutionRequests))
+	})
+	return _c
+}
+
+func (_c *WriteOnlyBeaconBlockBody_SetExecutionRequests_Call) Return() *WriteOnlyBeaconBlockBody_SetExecutionRequests_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *WriteOnlyBeaconBlockBody_SetExecutionRequests_Call) RunAndReturn(run func(*engineprimitives.ExecutionRequests)) *WriteOnlyBeaconBlockBody_SetExecutionRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetGraffiti provides a mock function with given fields: _a0
+func (_m *WriteOnlyBeaconBlockBody) SetGraffiti(_a0 bytes.B32) {
+	_m.Called(_a0)
+}
+
+// WriteOnlyBeaconBlockBody_SetGraffiti_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetGraffiti'
+type WriteOnlyBeaconBlockBody_SetGraffiti_Call struct {
+	*mock.Call
+}
+
+// SetGraffiti is a helper method to define mock.On call
+//   - _a0 bytes.B32
+func (_e *WriteOnlyBeaconBlockBody_Expecter) SetGraffiti(_a0 interface{}) *WriteOnlyBeaconBlockBody_SetGraffiti_Call {
+	return &WriteOnlyBeaconBlockBody_SetGraffiti_Call{Call: _e.mock.On("SetGraffiti", _a0)}
+}
+
+func (_c *WriteOnlyBeaconBlockBody_SetGraffiti_Call) Run(run func(_a0 bytes.B32)) *WriteOnlyBeaconBlockBody_SetGraffiti_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bytes.B32))
+	})
+	return _c
+}
+
+func (_c *WriteOnlyBeaconBlockBody_SetGraffiti_Call) Return() *WriteOnlyBeaconBlockBody_SetGraffiti_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *WriteOnlyBeaconBlockBody_SetGraffiti_Call) RunAndReturn(run func(bytes.B32)) *WriteOnlyBeaconBlockBody_SetGraffiti_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetRandaoReveal provides a mock function with given fields: _a0
 func (_m *WriteOnlyBeaconBlockBody) SetRandaoReveal(_a0 bytes.B96) {
 	_m.Called(_a0)
@@ -52,6 +52,8 @@ func (e *ExecutionPayload) Empty(forkVersion uint32) *ExecutionPayload {
 	switch forkVersion {
 	case version.Deneb:
 		e.InnerExecutionPayload = &ExecutableDataDeneb{}
+	case version.Electra:
+		e.InnerExecutionPayload = &ExecutableDataElectra{}
 	default:
 		panic("unknown fork version")
 	}
@@ -88,27 +90,35 @@ func (e *ExecutionPayload) ToHeader() (*ExecutionPayloadHeader, error) {
 		return nil, err
 	}
 
+	header := ExecutionPayloadHeaderDeneb{
+		ParentHash:       e.GetParentHash(),
+		FeeRecipient:     e.GetFeeRecipient(),
+		StateRoot:        e.GetStateRoot(),
+		ReceiptsRoot:     e.GetReceiptsRoot(),
+		LogsBloom:        e.GetLogsBloom(),
+		Random:           e.GetPrevRandao(),
+		Number:           e.GetNumber(),
+		GasLimit:         e.GetGasLimit(),
+		GasUsed:          e.GetGasUsed(),
+		Timestamp:        e.GetTimestamp(),
+		ExtraData:        e.GetExtraData(),
+		BaseFeePerGas:    e.GetBaseFeePerGas(),
+		BlockHash:        e.GetBlockHash(),
+		TransactionsRoot: txsRoot,
+		WithdrawalsRoot:  withdrawalsRoot,
+		BlobGasUsed:      e.GetBlobGasUsed(),
+		ExcessBlobGas:    e.GetExcessBlobGas(),
+	}
+
 	switch e.Version() {
 	case version.Deneb:
 		return &ExecutionPayloadHeader{
-			InnerExecutionPayloadHeader: &ExecutionPayloadHeaderDeneb{
-				ParentHash:       e.GetParentHash(),
-				FeeRecipient:     e.GetFeeRecipient(),
-				StateRoot:        e.GetStateRoot(),
-				ReceiptsRoot:     e.GetReceiptsRoot(),
-				LogsBloom:        e.GetLogsBloom(),
-				Random:           e.GetPrevRandao(),
-				Number:           e.GetNumber(),
-				GasLimit:         e.GetGasLimit(),
-				GasUsed:          e.GetGasUsed(),
-				Timestamp:        e.GetTimestamp(),
-				ExtraData:        e.GetExtraData(),
-				BaseFeePerGas:    e.GetBaseFeePerGas(),
-				BlockHash:        e.GetBlockHash(),
-				TransactionsRoot: txsRoot,
-				WithdrawalsRoot:  withdrawalsRoot,
-				BlobGasUsed:      e.GetBlobGasUsed(),
-				ExcessBlobGas:    e.GetExcessBlobGas(),
+			InnerExecutionPayloadHeader: &header,
+		}, nil
+	case version.Electra:
+		return &ExecutionPayloadHeader{
+			InnerExecutionPayloadHeader: &ExecutionPayloadHeaderElectra{
+				ExecutionPayloadHeaderDeneb: header,
 			},
 		}, nil
 	default:
@@ -247,3 +257,22 @@ func (d *ExecutableDataDeneb) GetBlobGasUsed() math.U64 {
 func (d *ExecutableDataDeneb) GetExcessBlobGas() math.U64 {
 	return d.ExcessBlobGas
 }
+
+// ExecutableDataElectra is the execution payload for Electra. The payload
+// itself is unchanged from Deneb, the execution requests introduced in Electra
+// are carried in the beacon block body instead.
+type ExecutableDataElectra struct {
+	// ExecutableDataDeneb holds the fields of the payload, which are
+	// unchanged from Deneb.
+	ExecutableDataDeneb
+}
+
+// Version returns the version of the ExecutableDataElectra.
+func (d *ExecutableDataElectra) Version() uint32 {
+	return version.Electra
+}
+
+// IsNil checks if the ExecutableDataElectra is nil.
+func (d *ExecutableDataElectra) IsNil() bool {
+	return d == nil
+}
@@ -51,6 +51,8 @@ func (e *ExecutionPayloadHeader) Empty(
 	switch forkVersion {
 	case version.Deneb:
 		e.InnerExecutionPayloadHeader = &ExecutionPayloadHeaderDeneb{}
+	case version.Electra:
+		e.InnerExecutionPayloadHeader = &ExecutionPayloadHeaderElectra{}
 	default:
 		panic(
 			"unknown fork version, cannot create empty ExecutionPayloadHeader",
@@ -71,9 +73,15 @@ func (e *ExecutionPayloadHeader) NewFromSSZ(
 }
 
 // UnmarshalJSON unmarshals the JSON bytes into the ExecutionPayloadHeader.
+// The JSON encoding does not carry the fork version, so the header keeps the
+// fork it was created for with Empty, and defaults to Deneb otherwise.
 func (e *ExecutionPayloadHeader) UnmarshalJSON(bz []byte) error {
-	// TODO: Generalize somehow.
-	e = e.Empty(version.Deneb)
+	forkVersion := uint32(version.Deneb)
+	if e.InnerExecutionPayloadHeader != nil {
+		forkVersion = e.Version()
+	}
+	e.InnerExecutionPayloadHeader = e.Empty(forkVersion).
+		InnerExecutionPayloadHeader
 	return e.InnerExecutionPayloadHeader.UnmarshalJSON(bz)
 }
 
@@ -214,3 +222,21 @@ func (d *ExecutionPayloadHeaderDeneb) GetBlobGasUsed() math.U64 {
 func (d *ExecutionPayloadHeaderDeneb) GetExcessBlobGas() math.U64 {
 	return d.ExcessBlobGas
 }
+
+// ExecutionPayloadHeaderElectra is the execution header payload of Electra,
+// which shares its layout with Deneb.
+type ExecutionPayloadHeaderElectra struct {
+	// ExecutionPayloadHeaderDeneb holds the fields of the header, which are
+	// unchanged from Deneb.
+	ExecutionPayloadHeaderDeneb
+}
+
+// Version returns the version of the ExecutionPayloadHeaderElectra.
+func (d *ExecutionPayloadHeaderElectra) Version() uint32 {
+	return version.Electra
+}
+
+// IsNil checks if the ExecutionPayloadHeaderElectra is nil.
+func (d *ExecutionPayloadHeaderElectra) IsNil() bool {
+	return d == nil
+}
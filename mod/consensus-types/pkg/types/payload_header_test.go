@@ -258,3 +258,22 @@ func TestExecutablePayloadHeaderDeneb_UnmarshalJSON_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestExecutionPayloadHeader_UnmarshalJSON(t *testing.T) {
+	originalHeader := generateExecutionPayloadHeaderDeneb()
+	originalHeader.Number = math.U64(10)
+	data, err := originalHeader.MarshalJSON()
+	require.NoError(t, err)
+
+	// Without a fork, the header is unmarshalled as a Deneb header.
+	var header types.ExecutionPayloadHeader
+	require.NoError(t, json.Unmarshal(data, &header))
+	require.Equal(t, version.Deneb, header.Version())
+	require.Equal(t, math.U64(10), header.GetNumber())
+
+	// Otherwise it keeps the fork it was created for.
+	electraHeader := new(types.ExecutionPayloadHeader).Empty(version.Electra)
+	require.NoError(t, json.Unmarshal(data, electraHeader))
+	require.Equal(t, version.Electra, electraHeader.Version())
+	require.Equal(t, math.U64(10), electraHeader.GetNumber())
+}
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/spf13/afero"
+)
+
+const (
+	// dirPerms are the permissions of the directory of an archive.
+	dirPerms = 0o755
+	// filePerms are the permissions of the files of an archive.
+	filePerms = 0o644
+)
+
+// Export writes the sidecars stored for every slot in the inclusive range
+// [from, to] into an archive in the given directory. The archive holds one
+// file with the SSZ-encoded BlobSidecars per slot and an index describing
+// them. Slots without sidecars are skipped.
+func Export(
+	fs afero.Fs,
+	dir string,
+	reader Reader,
+	from, to math.Slot,
+) (*Index, error) {
+	if from > to {
+		return nil, errors.Wrapf(ErrInvalidSlotRange, "%d > %d", from, to)
+	}
+
+	slots, err := reader.Indexes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = fs.MkdirAll(dir, dirPerms); err != nil {
+		return nil, err
+	}
+
+	index := &Index{Entries: make([]*IndexEntry, 0)}
+	for _, s := range slots {
+		slot := math.Slot(s)
+		if slot < from || slot > to {
+			continue
+		}
+
+		var sidecars *types.BlobSidecars
+		if sidecars, err = reader.GetBlobSidecars(slot); err != nil {
+			return nil, errors.Wrapf(err, "failed to read slot %d", slot)
+		} else if sidecars.IsNil() || sidecars.Len() == 0 {
+			continue
+		}
+
+		var entry *IndexEntry
+		if entry, err = exportSlot(fs, dir, slot, sidecars); err != nil {
+			return nil, errors.Wrapf(err, "failed to export slot %d", slot)
+		}
+		index.Entries = append(index.Entries, entry)
+	}
+
+	bz, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err = afero.WriteFile(
+		fs, filepath.Join(dir, IndexFileName), bz, filePerms,
+	); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// exportSlot writes the sidecars of the given slot into the archive and
+// returns their index entry.
+func exportSlot(
+	fs afero.Fs,
+	dir string,
+	slot math.Slot,
+	sidecars *types.BlobSidecars,
+) (*IndexEntry, error) {
+	entry, err := newIndexEntry(slot, sidecars)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := sidecars.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	if err = afero.WriteFile(
+		fs, filepath.Join(dir, entry.File), bz, filePerms,
+	); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// Import reads the archive in the given directory and writes its sidecars
+// into the store. The sidecars of every slot are checked against the index
+// and verified with the given verifier before they are written.
+func Import(
+	fs afero.Fs,
+	dir string,
+	verifier Verifier,
+	writer Writer,
+	kzgOffsetFn func(math.Slot) uint64,
+) (*Index, error) {
+	bz, err := afero.ReadFile(fs, filepath.Join(dir, IndexFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	index := new(Index)
+	if err = json.Unmarshal(bz, index); err != nil {
+		return nil, err
+	}
+
+	for _, entry := range index.Entries {
+		if err = importSlot(
+			fs, dir, verifier, writer, kzgOffsetFn, entry,
+		); err != nil {
+			return nil, errors.Wrapf(
+				err, "failed to import slot %d", entry.Slot,
+			)
+		}
+	}
+	return index, nil
+}
+
+// importSlot reads, verifies and stores the sidecars described by the given
+// index entry.
+func importSlot(
+	fs afero.Fs,
+	dir string,
+	verifier Verifier,
+	writer Writer,
+	kzgOffsetFn func(math.Slot) uint64,
+	entry *IndexEntry,
+) error {
+	// The archive only holds files in its directory, the index must not
+	// point anywhere else.
+	if !filepath.IsLocal(entry.File) ||
+		filepath.Base(entry.File) != entry.File {
+		return errors.Wrapf(ErrInvalidFile, "%q", entry.File)
+	}
+
+	bz, err := afero.ReadFile(fs, filepath.Join(dir, entry.File))
+	if err != nil {
+		return err
+	}
+
+	sidecars := new(types.BlobSidecars)
+	if err = sidecars.UnmarshalSSZ(bz); err != nil {
+		return err
+	}
+
+	for _, sidecar := range sidecars.Sidecars {
+		if sidecar == nil || sidecar.BeaconBlockHeader == nil {
+			return types.ErrAttemptedToVerifyNilSidecar
+		}
+		if sidecar.BeaconBlockHeader.GetSlot() != entry.Slot {
+			return ErrSlotMismatch
+		}
+	}
+
+	matches, err := entry.matches(sidecars)
+	if err != nil {
+		return err
+	} else if !matches {
+		return ErrIndexMismatch
+	}
+
+	if err = verifier.VerifyBlobs(
+		sidecars, kzgOffsetFn(entry.Slot),
+	); err != nil {
+		return err
+	}
+
+	return writer.Restore(entry.Slot, sidecars)
+}
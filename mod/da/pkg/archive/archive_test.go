@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive_test
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/archive"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+// memStore is an in-memory implementation of archive.Reader and
+// archive.Writer.
+type memStore map[math.Slot]*types.BlobSidecars
+
+// Indexes returns the slots of the store in ascending order.
+func (s memStore) Indexes() ([]uint64, error) {
+	indexes := make([]uint64, 0, len(s))
+	for slot := range s {
+		indexes = append(indexes, slot.Unwrap())
+	}
+	slices.Sort(indexes)
+	return indexes, nil
+}
+
+// GetBlobSidecars returns the sidecars of the slot, empty if there are none.
+func (s memStore) GetBlobSidecars(
+	slot math.Slot,
+) (*types.BlobSidecars, error) {
+	if sidecars, ok := s[slot]; ok {
+		return sidecars, nil
+	}
+	return &types.BlobSidecars{Sidecars: []*types.BlobSidecar{}}, nil
+}
+
+// Restore stores the sidecars under the slot.
+func (s memStore) Restore(
+	slot math.Slot,
+	sidecars *types.BlobSidecars,
+) error {
+	s[slot] = sidecars
+	return nil
+}
+
+// mockVerifier fails verification for the sidecars of the configured slots.
+type mockVerifier struct {
+	invalid map[math.Slot]bool
+}
+
+// VerifyBlobs fails for the sidecars of the invalid slots.
+func (v *mockVerifier) VerifyBlobs(
+	sidecars *types.BlobSidecars,
+	_ uint64,
+) error {
+	if v.invalid[sidecars.Sidecars[0].BeaconBlockHeader.GetSlot()] {
+		return errors.New("invalid sidecars")
+	}
+	return nil
+}
+
+func newSidecars(slot math.Slot, count int) *types.BlobSidecars {
+	sidecars := &types.BlobSidecars{
+		Sidecars: make([]*types.BlobSidecar, count),
+	}
+	header := ctypes.NewBeaconBlockHeader(
+		slot, 0, [32]byte{}, [32]byte{}, [32]byte{byte(slot)},
+	)
+	for i := range count {
+		sidecars.Sidecars[i] = &types.BlobSidecar{
+			Index:             uint64(i),
+			KzgCommitment:     eip4844.KZGCommitment{byte(slot), byte(i)},
+			BeaconBlockHeader: header,
+			InclusionProof:    make([][32]byte, 8),
+		}
+	}
+	return sidecars
+}
+
+func offset(math.Slot) uint64 { return 0 }
+
+func TestExportImport(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	src := memStore{
+		1: newSidecars(1, 1),
+		2: newSidecars(2, 3),
+		4: newSidecars(4, 2),
+		5: newSidecars(5, 1),
+	}
+
+	index, err := archive.Export(fs, "out", src, 2, 4)
+	require.NoError(t, err)
+	require.Len(t, index.Entries, 2)
+	require.Equal(t, math.Slot(2), index.Entries[0].Slot)
+	require.Len(t, index.Entries[0].Commitments, 3)
+	require.Equal(t, math.Slot(4), index.Entries[1].Slot)
+
+	root, err := src[2].Sidecars[0].BeaconBlockHeader.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, [32]byte(index.Entries[0].BlockRoot))
+
+	dst := memStore{}
+	imported, err := archive.Import(
+		fs, "out", &mockVerifier{}, dst, offset,
+	)
+	require.NoError(t, err)
+	require.Equal(t, index, imported)
+	require.Len(t, dst, 2)
+	require.Equal(t, src[2], dst[2])
+	require.Equal(t, src[4], dst[4])
+}
+
+func TestExportInvalidRange(t *testing.T) {
+	_, err := archive.Export(afero.NewMemMapFs(), "out", memStore{}, 2, 1)
+	require.ErrorIs(t, err, archive.ErrInvalidSlotRange)
+}
+
+func TestImportVerificationFailure(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	_, err := archive.Export(
+		fs, "out", memStore{1: newSidecars(1, 1), 2: newSidecars(2, 1)}, 0, 2,
+	)
+	require.NoError(t, err)
+
+	dst := memStore{}
+	_, err = archive.Import(
+		fs, "out",
+		&mockVerifier{invalid: map[math.Slot]bool{2: true}},
+		dst, offset,
+	)
+	require.ErrorContains(t, err, "invalid sidecars")
+	require.Contains(t, dst, math.Slot(1))
+	require.NotContains(t, dst, math.Slot(2))
+}
+
+func TestImportIndexMismatch(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	index, err := archive.Export(
+		fs, "out", memStore{1: newSidecars(1, 2)}, 0, 1,
+	)
+	require.NoError(t, err)
+
+	// Tamper with the index so that it no longer describes the sidecars.
+	index.Entries[0].Commitments[1] = eip4844.KZGCommitment{0xff}
+	bz, err := json.Marshal(index)
+	require.NoError(t, err)
+	require.NoError(t, afero.WriteFile(
+		fs, filepath.Join("out", archive.IndexFileName), bz, 0o600,
+	))
+
+	dst := memStore{}
+	_, err = archive.Import(fs, "out", &mockVerifier{}, dst, offset)
+	require.ErrorIs(t, err, archive.ErrIndexMismatch)
+	require.Empty(t, dst)
+}
+
+func TestImportInvalidFile(t *testing.T) {
+	for _, file := range []string{
+		"/etc/passwd", "../1.ssz", "sub/../../1.ssz", "sub/1.ssz", "",
+	} {
+		fs := afero.NewMemMapFs()
+		index, err := archive.Export(
+			fs, "out", memStore{1: newSidecars(1, 1)}, 0, 1,
+		)
+		require.NoError(t, err)
+
+		// Point the index outside of the directory of the archive.
+		index.Entries[0].File = file
+		bz, err := json.Marshal(index)
+		require.NoError(t, err)
+		require.NoError(t, afero.WriteFile(
+			fs, filepath.Join("out", archive.IndexFileName), bz, 0o600,
+		))
+
+		dst := memStore{}
+		_, err = archive.Import(fs, "out", &mockVerifier{}, dst, offset)
+		require.ErrorIs(t, err, archive.ErrInvalidFile, file)
+		require.Empty(t, dst)
+	}
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidSlotRange is returned when the start of the requested slot
+	// range is after its end.
+	ErrInvalidSlotRange = errors.New("invalid slot range")
+
+	// ErrIndexMismatch is returned when the sidecars of an archive do not
+	// match the entry of the archive index that describes them.
+	ErrIndexMismatch = errors.New("archive index mismatch")
+
+	// ErrSlotMismatch is returned when a sidecar in an archive references a
+	// block header from a different slot than the one it is archived under.
+	ErrSlotMismatch = errors.New("archived sidecar slot mismatch")
+
+	// ErrInvalidFile is returned when an entry of the archive index points
+	// to a file outside of the directory of the archive.
+	ErrInvalidFile = errors.New("invalid archive file")
+)
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// IndexFileName is the name of the index file of an archive.
+	IndexFileName = "index.json"
+	// sidecarsFileFormat is the format of the name of the file holding the
+	// SSZ-encoded sidecars of a slot.
+	sidecarsFileFormat = "%d.ssz"
+)
+
+// Index describes the contents of an archive.
+type Index struct {
+	// Entries holds one entry per archived slot, in ascending slot order.
+	Entries []*IndexEntry `json:"entries"`
+}
+
+// IndexEntry describes the sidecars archived for a single slot.
+type IndexEntry struct {
+	// Slot is the slot of the block the sidecars belong to.
+	Slot math.Slot `json:"slot"`
+	// BlockRoot is the root of the block header the sidecars belong to.
+	BlockRoot common.Root `json:"block_root"`
+	// Commitments are the KZG commitments of the sidecars, ordered by the
+	// index of the sidecar.
+	Commitments []eip4844.KZGCommitment `json:"commitments"`
+	// File is the name of the file holding the SSZ-encoded sidecars.
+	File string `json:"file"`
+}
+
+// newIndexEntry builds the index entry for the given sidecars.
+func newIndexEntry(
+	slot math.Slot,
+	sidecars *types.BlobSidecars,
+) (*IndexEntry, error) {
+	entry := &IndexEntry{
+		Slot:        slot,
+		Commitments: make([]eip4844.KZGCommitment, 0, sidecars.Len()),
+		File:        fmt.Sprintf(sidecarsFileFormat, slot.Unwrap()),
+	}
+	for i, sidecar := range sidecars.Sidecars {
+		if sidecar == nil || sidecar.BeaconBlockHeader == nil {
+			return nil, types.ErrAttemptedToVerifyNilSidecar
+		}
+		if i == 0 {
+			root, err := sidecar.BeaconBlockHeader.HashTreeRoot()
+			if err != nil {
+				return nil, err
+			}
+			entry.BlockRoot = root
+		}
+		entry.Commitments = append(entry.Commitments, sidecar.KzgCommitment)
+	}
+	return entry, nil
+}
+
+// matches returns whether the entry describes the given sidecars.
+func (e *IndexEntry) matches(sidecars *types.BlobSidecars) (bool, error) {
+	other, err := newIndexEntry(e.Slot, sidecars)
+	if err != nil {
+		return false, err
+	}
+	if other.BlockRoot != e.BlockRoot ||
+		len(other.Commitments) != len(e.Commitments) {
+		return false, nil
+	}
+	for i, commitment := range other.Commitments {
+		if commitment != e.Commitments[i] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Reader is the interface for reading sidecars out of the availability store.
+type Reader interface {
+	// Indexes returns all of the slots that currently hold sidecars, in
+	// ascending order.
+	Indexes() ([]uint64, error)
+	// GetBlobSidecars returns the sidecars stored for the given slot.
+	GetBlobSidecars(math.Slot) (*types.BlobSidecars, error)
+}
+
+// Writer is the interface for writing sidecars into the availability store.
+type Writer interface {
+	// Restore stores the sidecars under the given slot regardless of the
+	// data availability period.
+	Restore(math.Slot, *types.BlobSidecars) error
+}
+
+// Verifier is the interface for verifying sidecars before they are imported.
+type Verifier interface {
+	// VerifyBlobs verifies the inclusion and KZG proofs of the sidecars, as
+	// well as that they all belong to the same block.
+	VerifyBlobs(sidecars *types.BlobSidecars, kzgOffset uint64) error
+}
@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// IntegrityChecker is a service that periodically re-verifies every sidecar
+// held by the availability store, against the stored block of its slot when
+// that block is still held, and reports the slots whose stored sidecars are
+// corrupted.
+type IntegrityChecker[
+	BeaconBlockT StoredBeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
+] struct {
+	// store is the availability store to check.
+	store *Store[BeaconBlockBodyT]
+	// blockStore holds the blocks the sidecars are checked against.
+	blockStore BlockStore[BeaconBlockT]
+	// logger is used for logging.
+	logger log.Logger[any]
+	// interval is the interval at which the store is checked. A zero
+	// interval disables the checker.
+	interval time.Duration
+	// metrics is used to report the results of the check.
+	metrics *storeMetrics
+}
+
+// NewIntegrityChecker creates a new IntegrityChecker that checks the given
+// store against the blocks of the given block store, at the interval of the
+// given config.
+func NewIntegrityChecker[
+	BeaconBlockT StoredBeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
+](
+	cfg *Config,
+	store *Store[BeaconBlockBodyT],
+	blockStore BlockStore[BeaconBlockT],
+	logger log.Logger[any],
+	telemetrySink TelemetrySink,
+) *IntegrityChecker[BeaconBlockT, BeaconBlockBodyT] {
+	return &IntegrityChecker[BeaconBlockT, BeaconBlockBodyT]{
+		store:      store,
+		blockStore: blockStore,
+		logger:     logger,
+		interval:   cfg.IntegrityCheckInterval,
+		metrics:    newStoreMetrics(telemetrySink),
+	}
+}
+
+// Name returns the name of the service.
+func (*IntegrityChecker[BeaconBlockT, BeaconBlockBodyT]) Name() string {
+	return "da-integrity-checker"
+}
+
+// Start starts the periodic integrity check, if enabled.
+func (c *IntegrityChecker[BeaconBlockT, BeaconBlockBodyT]) Start(
+	ctx context.Context,
+) error {
+	if c.interval == 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(c.interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				c.Check()
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Status returns nil if the service is healthy.
+func (*IntegrityChecker[BeaconBlockT, BeaconBlockBodyT]) Status() error {
+	return nil
+}
+
+// WaitForHealthy waits for the service to be healthy.
+func (*IntegrityChecker[BeaconBlockT, BeaconBlockBodyT]) WaitForHealthy(
+	context.Context,
+) {
+}
+
+// Check walks every slot held by the store and verifies its sidecars. It
+// returns the slots whose sidecars failed to be read or verified.
+func (c *IntegrityChecker[
+	BeaconBlockT, BeaconBlockBodyT,
+]) Check() []math.Slot {
+	indexes, err := c.store.IndexDB.Indexes()
+	if err != nil {
+		c.logger.Error("failed to list stored blob slots", "error", err)
+		return nil
+	}
+
+	startTime := time.Now()
+	defer c.metrics.measureIntegrityCheckDuration(startTime, len(indexes))
+
+	var corrupted []math.Slot
+	for _, index := range indexes {
+		slot := math.Slot(index)
+		if err = c.check(slot); err != nil {
+			c.metrics.markCorruptedSidecars()
+			c.logger.Error(
+				"corrupted blob sidecars found in store",
+				"slot", slot, "error", err,
+			)
+			corrupted = append(corrupted, slot)
+		}
+	}
+	return corrupted
+}
+
+// check verifies the sidecars stored for the given slot. A slot pruned since
+// the store was listed holds no sidecars and passes the check.
+func (c *IntegrityChecker[BeaconBlockT, BeaconBlockBodyT]) check(
+	slot math.Slot,
+) error {
+	c.store.mu.RLock()
+	sidecars, err := c.store.readBlobSidecars(slot)
+	c.store.mu.RUnlock()
+	if err != nil {
+		return err
+	} else if sidecars.Len() == 0 {
+		return nil
+	}
+
+	if err = c.store.VerifyBlobSidecars(slot, sidecars); err != nil {
+		return err
+	}
+
+	// The blocks are retained for a shorter period than their sidecars, the
+	// sidecars of a slot whose block was pruned are only checked against the
+	// block header they embed.
+	blk, err := c.blockStore.Get(slot)
+	if err != nil {
+		c.logger.Debug(
+			"block of stored blob sidecars not available",
+			"slot", slot, "error", err,
+		)
+		return nil
+	}
+	return verifyAgainstBlock(blk, sidecars)
+}
+
+// verifyAgainstBlock verifies that the given sidecars, which share the same
+// block header, are all of the sidecars of the given block.
+func verifyAgainstBlock[
+	BeaconBlockT StoredBeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody,
+](
+	blk BeaconBlockT,
+	sidecars *types.BlobSidecars,
+) error {
+	blockRoot, err := blk.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	headerRoot, err := sidecars.Sidecars[0].BeaconBlockHeader.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if blockRoot != headerRoot {
+		return errors.Wrapf(
+			ErrSidecarBlockMismatch,
+			"expected block root %x, got %x", blockRoot, headerRoot,
+		)
+	}
+
+	if numCommitments := len(
+		blk.GetBody().GetBlobKzgCommitments(),
+	); numCommitments != sidecars.Len() {
+		return errors.Wrapf(
+			ErrSidecarBlockMismatch,
+			"expected %d sidecars, got %d", numCommitments, sidecars.Len(),
+		)
+	}
+	return nil
+}
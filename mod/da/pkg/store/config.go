@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import "time"
+
+const (
+	// defaultVerifyOnRead is the default setting for re-verifying sidecars
+	// when they are read from the store.
+	defaultVerifyOnRead = false
+	// defaultIntegrityCheckInterval is the default interval at which the
+	// integrity checker walks the store. A zero interval disables it.
+	defaultIntegrityCheckInterval = time.Duration(0)
+	// defaultArchiveMode is the default setting for archive mode.
+	defaultArchiveMode = false
+)
+
+// Config is the configuration for the availability store.
+type Config struct {
+	// VerifyOnRead enables re-verification of the KZG and inclusion proofs
+	// of sidecars every time they are read from the store.
+	VerifyOnRead bool `mapstructure:"verify-on-read"`
+	// IntegrityCheckInterval is the interval at which all stored sidecars
+	// are re-verified in the background. A zero interval disables the check.
+	IntegrityCheckInterval time.Duration `mapstructure:"integrity-check-interval"`
+	// ArchiveMode disables the pruning of sidecars that fall outside of the
+	// data availability period.
+	ArchiveMode bool `mapstructure:"archive-mode"`
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() Config {
+	return Config{
+		VerifyOnRead:           defaultVerifyOnRead,
+		IntegrityCheckInterval: defaultIntegrityCheckInterval,
+		ArchiveMode:            defaultArchiveMode,
+	}
+}
@@ -34,4 +34,20 @@ var (
 	ErrAttemptedToVerifyNilSidecars = errors.New(
 		"attempted to verify nil sidecars",
 	)
+
+	// ErrSidecarNotFound is returned when a requested sidecar is not present
+	// in the store.
+	ErrSidecarNotFound = errors.New("sidecar not found")
+
+	// ErrSidecarSlotMismatch is returned when a stored sidecar references a
+	// block header from a different slot than the one it is stored under.
+	ErrSidecarSlotMismatch = errors.New("sidecar slot mismatch")
+
+	// ErrSidecarCommitmentMismatch is returned when a stored sidecar does not
+	// match the commitment it is stored under.
+	ErrSidecarCommitmentMismatch = errors.New("sidecar commitment mismatch")
+
+	// ErrSidecarBlockMismatch is returned when the stored sidecars of a slot
+	// are not those of the block stored for the slot.
+	ErrSidecarBlockMismatch = errors.New("sidecar block mismatch")
 )
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"strconv"
+	"time"
+)
+
+// storeMetrics is a struct that contains metrics for the availability store.
+type storeMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newStoreMetrics creates a new storeMetrics.
+func newStoreMetrics(
+	sink TelemetrySink,
+) *storeMetrics {
+	return &storeMetrics{
+		sink: sink,
+	}
+}
+
+// measureVerifyOnReadDuration measures the duration of the verification of
+// sidecars read from the store.
+func (sm *storeMetrics) measureVerifyOnReadDuration(
+	startTime time.Time,
+	numSidecars int,
+) {
+	sm.sink.MeasureSince(
+		"beacon_kit.da.store.verify_on_read_duration",
+		startTime,
+		"num_sidecars",
+		strconv.Itoa(numSidecars),
+	)
+}
+
+// markVerifyOnReadFailure increments the counter for sidecars that failed
+// verification when read from the store.
+func (sm *storeMetrics) markVerifyOnReadFailure() {
+	sm.sink.IncrementCounter("beacon_kit.da.store.verify_on_read_failure")
+}
+
+// markCorruptedSidecars increments the counter for slots whose stored
+// sidecars failed the background integrity check.
+func (sm *storeMetrics) markCorruptedSidecars() {
+	sm.sink.IncrementCounter("beacon_kit.da.store.integrity_check.corrupted")
+}
+
+// measureIntegrityCheckDuration measures the duration of a full pass of the
+// integrity checker.
+func (sm *storeMetrics) measureIntegrityCheckDuration(
+	startTime time.Time,
+	numSlots int,
+) {
+	sm.sink.MeasureSince(
+		"beacon_kit.da.store.integrity_check.duration",
+		startTime,
+		"num_slots",
+		strconv.Itoa(numSlots),
+	)
+}
@@ -22,18 +22,45 @@ package store
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// BuildPruneRangeFn builds a function that returns the [start, end) range of
+// slots to prune for a finalized block. See PruneRange.
 func BuildPruneRangeFn[
 	BeaconBlockT BeaconBlock,
 	BlockEventT BlockEvent[BeaconBlockT],
-](cs primitives.ChainSpec) func(BlockEventT) (uint64, uint64) {
+](
+	cfg *Config,
+	cs primitives.ChainSpec,
+	retentionSlots uint64,
+) func(BlockEventT) (uint64, uint64) {
 	return func(event BlockEventT) (uint64, uint64) {
-		window := cs.MinEpochsForBlobsSidecarsRequest() * cs.SlotsPerEpoch()
-		if event.Data().GetSlot().Unwrap() < window {
-			return 0, 0
-		}
+		return PruneRange(cfg, cs, retentionSlots, event.Data().GetSlot())
+	}
+}
+
+// PruneRange returns the [start, end) range of slots to prune given the
+// current head slot. Sidecars are retained for retentionSlots slots, which
+// is never allowed to fall below the data availability window. A
+// retentionSlots of zero retains exactly the data availability window.
+func PruneRange(
+	cfg *Config,
+	cs primitives.ChainSpec,
+	retentionSlots uint64,
+	head math.Slot,
+) (uint64, uint64) {
+	// Nothing is ever pruned in archive mode.
+	if cfg.ArchiveMode {
+		return 0, 0
+	}
 
-		return 0, event.Data().GetSlot().Unwrap() - window
+	window := max(
+		retentionSlots,
+		cs.MinEpochsForBlobsSidecarsRequest()*cs.SlotsPerEpoch(),
+	)
+	if head.Unwrap() < window {
+		return 0, 0
 	}
+	return 0, head.Unwrap() - window
 }
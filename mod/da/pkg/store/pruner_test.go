@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneRange(t *testing.T) {
+	cs := chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{
+		SlotsPerEpoch:                    8,
+		MinEpochsForBlobsSidecarsRequest: 4,
+	})
+
+	tests := []struct {
+		name           string
+		cfg            store.Config
+		retentionSlots uint64
+		head           math.Slot
+		expectedEnd    uint64
+	}{
+		{
+			name:        "WithinWindow",
+			head:        16,
+			expectedEnd: 0,
+		},
+		{
+			name:        "DefaultRetention",
+			head:        100,
+			expectedEnd: 68,
+		},
+		{
+			name:           "RetentionBelowWindow",
+			retentionSlots: 10,
+			head:           100,
+			expectedEnd:    68,
+		},
+		{
+			name:           "RetentionAboveWindow",
+			retentionSlots: 50,
+			head:           100,
+			expectedEnd:    50,
+		},
+		{
+			name:        "ArchiveMode",
+			cfg:         store.Config{ArchiveMode: true},
+			head:        100,
+			expectedEnd: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := store.PruneRange(
+				&tt.cfg, cs, tt.retentionSlots, tt.head,
+			)
+			require.Zero(t, start)
+			require.Equal(t, tt.expectedEnd, end)
+		})
+	}
+}
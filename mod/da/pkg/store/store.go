@@ -21,12 +21,17 @@
 package store
 
 import (
+	"bytes"
 	"context"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/sourcegraph/conc/iter"
 )
@@ -39,18 +44,39 @@ type Store[BeaconBlockBodyT BeaconBlockBody] struct {
 	logger log.Logger[any]
 	// chainSpec contains the chain specification.
 	chainSpec primitives.ChainSpec
+	// verifier is used to re-verify sidecars read from the store.
+	verifier BlobVerifier
+	// blockBodyOffsetFn is a function that calculates the block body offset
+	// based on the slot and chain specifications.
+	blockBodyOffsetFn func(math.Slot, primitives.ChainSpec) uint64
+	// verifyOnRead determines whether sidecars are re-verified every time
+	// they are read from the store.
+	verifyOnRead bool
+	// metrics is used to collect and report store metrics.
+	metrics *storeMetrics
+	// mu serializes the pruning of the store with the reads of its sidecars,
+	// so that a slot is never read while it is being pruned.
+	mu sync.RWMutex
 }
 
 // New creates a new instance of the AvailabilityStore.
 func New[BeaconBlockT BeaconBlockBody](
+	cfg *Config,
 	db IndexDB,
 	logger log.Logger[any],
 	chainSpec primitives.ChainSpec,
+	verifier BlobVerifier,
+	blockBodyOffsetFn func(math.Slot, primitives.ChainSpec) uint64,
+	telemetrySink TelemetrySink,
 ) *Store[BeaconBlockT] {
 	return &Store[BeaconBlockT]{
-		IndexDB:   db,
-		chainSpec: chainSpec,
-		logger:    logger,
+		IndexDB:           db,
+		chainSpec:         chainSpec,
+		logger:            logger,
+		verifier:          verifier,
+		blockBodyOffsetFn: blockBodyOffsetFn,
+		verifyOnRead:      cfg.VerifyOnRead,
+		metrics:           newStoreMetrics(telemetrySink),
 	}
 }
 
@@ -94,8 +120,35 @@ func (s *Store[BeaconBlockT]) Persist(
 		return nil
 	}
 
+	if err := s.write(slot, sidecars); err != nil {
+		return err
+	}
+
+	s.logger.Info("successfully stored all blob sidecars 🚗", "slot", slot)
+	return nil
+}
+
+// Restore stores the given sidecars under the given slot regardless of
+// whether they are still within the data availability period. It is used to
+// import sidecars from an archive, the caller is responsible for verifying
+// them beforehand.
+func (s *Store[BeaconBlockT]) Restore(
+	slot math.Slot,
+	sidecars *types.BlobSidecars,
+) error {
+	if sidecars.IsNil() || sidecars.Len() == 0 {
+		return nil
+	}
+	return s.write(slot, sidecars)
+}
+
+// write stores each of the given sidecars under the given slot.
+func (s *Store[BeaconBlockT]) write(
+	slot math.Slot,
+	sidecars *types.BlobSidecars,
+) error {
 	// Store each sidecar in parallel.
-	if err := errors.Join(iter.Map(
+	return errors.Join(iter.Map(
 		sidecars.Sidecars,
 		func(sidecar **types.BlobSidecar) error {
 			if *sidecar == nil {
@@ -108,10 +161,173 @@ func (s *Store[BeaconBlockT]) Persist(
 			}
 			return s.Set(uint64(slot), sc.KzgCommitment[:], bz)
 		},
-	)...); err != nil {
+	)...)
+}
+
+// GetBlobSidecars returns all of the blob sidecars stored for the given slot,
+// ordered by their index. If verification on read is enabled, the sidecars
+// are re-verified before being returned.
+func (s *Store[BeaconBlockT]) GetBlobSidecars(
+	slot math.Slot,
+) (*types.BlobSidecars, error) {
+	s.mu.RLock()
+	sidecars, err := s.readBlobSidecars(slot)
+	s.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	if s.verifyOnRead {
+		if err = s.VerifyBlobSidecars(slot, sidecars); err != nil {
+			s.metrics.markVerifyOnReadFailure()
+			return nil, err
+		}
+	}
+	return sidecars, nil
+}
+
+// GetBlobSidecar returns the blob sidecar stored for the given slot and
+// commitment. If verification on read is enabled, the sidecar is re-verified
+// before being returned.
+func (s *Store[BeaconBlockT]) GetBlobSidecar(
+	slot math.Slot,
+	commitment eip4844.KZGCommitment,
+) (*types.BlobSidecar, error) {
+	s.mu.RLock()
+	sidecar, err := s.readBlobSidecar(slot, commitment[:])
+	s.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	if s.verifyOnRead {
+		if err = s.VerifyBlobSidecars(
+			slot, &types.BlobSidecars{Sidecars: []*types.BlobSidecar{sidecar}},
+		); err != nil {
+			s.metrics.markVerifyOnReadFailure()
+			return nil, err
+		}
+	}
+	return sidecar, nil
+}
+
+// VerifyBlobSidecars verifies that the given sidecars belong to a block at
+// the given slot, that they all share the same block header, that their
+// inclusion proofs are valid against the body root of that header and that
+// their KZG proofs are valid.
+func (s *Store[BeaconBlockT]) VerifyBlobSidecars(
+	slot math.Slot,
+	sidecars *types.BlobSidecars,
+) error {
+	if sidecars.IsNil() {
+		return ErrAttemptedToVerifyNilSidecars
+	}
+
+	startTime := time.Now()
+	defer s.metrics.measureVerifyOnReadDuration(startTime, sidecars.Len())
+
+	for _, sidecar := range sidecars.Sidecars {
+		if sidecar == nil || sidecar.BeaconBlockHeader == nil {
+			return ErrAttemptedToVerifyNilSidecars
+		}
+		if sidecar.BeaconBlockHeader.GetSlot() != slot {
+			return errors.Wrapf(
+				ErrSidecarSlotMismatch,
+				"expected %d, got %d",
+				slot, sidecar.BeaconBlockHeader.GetSlot(),
+			)
+		}
+	}
+
+	if err := sidecars.ValidateBlockRoots(); err != nil {
 		return err
 	}
 
-	s.logger.Info("successfully stored all blob sidecars 🚗", "slot", slot)
-	return nil
+	if err := s.verifier.VerifyInclusionProofs(
+		sidecars, s.blockBodyOffsetFn(slot, s.chainSpec),
+	); err != nil {
+		return err
+	}
+
+	return s.verifier.VerifyKZGProofs(sidecars)
+}
+
+// Prune removes the sidecars of the slots in the range [start, end) from the
+// store.
+func (s *Store[BeaconBlockT]) Prune(start, end uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.IndexDB.Prune(start, end)
+}
+
+// Size returns the number of bytes held by the sidecars of the slots in the
+// range [start, end).
+func (s *Store[BeaconBlockT]) Size(start, end uint64) (uint64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.IndexDB.Size(start, end)
+}
+
+// readBlobSidecars reads and decodes all of the sidecars stored for the
+// given slot without verifying them.
+func (s *Store[BeaconBlockT]) readBlobSidecars(
+	slot math.Slot,
+) (*types.BlobSidecars, error) {
+	keys, err := s.IndexDB.Keys(uint64(slot))
+	if err != nil {
+		return nil, err
+	}
+
+	sidecars := &types.BlobSidecars{
+		Sidecars: make([]*types.BlobSidecar, 0, len(keys)),
+	}
+	for _, key := range keys {
+		var sidecar *types.BlobSidecar
+		if sidecar, err = s.readBlobSidecar(slot, key); err != nil {
+			return nil, err
+		}
+		sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+	}
+
+	slices.SortFunc(sidecars.Sidecars, func(a, b *types.BlobSidecar) int {
+		switch {
+		case a.Index < b.Index:
+			return -1
+		case a.Index > b.Index:
+			return 1
+		default:
+			return 0
+		}
+	})
+	return sidecars, nil
+}
+
+// readBlobSidecar reads and decodes the sidecar stored under the given slot
+// and key without verifying it.
+func (s *Store[BeaconBlockT]) readBlobSidecar(
+	slot math.Slot,
+	key []byte,
+) (*types.BlobSidecar, error) {
+	found, err := s.IndexDB.Has(uint64(slot), key)
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, ErrSidecarNotFound
+	}
+
+	bz, err := s.IndexDB.Get(uint64(slot), key)
+	if err != nil {
+		return nil, err
+	}
+
+	sidecar := new(types.BlobSidecar)
+	if err = sidecar.UnmarshalSSZ(bz); err != nil {
+		return nil, err
+	}
+
+	// The sidecar must match the commitment it is stored under.
+	if !bytes.Equal(sidecar.KzgCommitment[:], key) {
+		return nil, ErrSidecarCommitmentMismatch
+	}
+	return sidecar, nil
 }
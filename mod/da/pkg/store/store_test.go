@@ -0,0 +1,369 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// memIndexDB is an in-memory implementation of store.IndexDB.
+type memIndexDB map[uint64]map[string][]byte
+
+func (db memIndexDB) Has(index uint64, key []byte) (bool, error) {
+	_, ok := db[index][string(key)]
+	return ok, nil
+}
+
+func (db memIndexDB) Get(index uint64, key []byte) ([]byte, error) {
+	return db[index][string(key)], nil
+}
+
+func (db memIndexDB) Set(index uint64, key []byte, value []byte) error {
+	if db[index] == nil {
+		db[index] = make(map[string][]byte)
+	}
+	db[index][string(key)] = value
+	return nil
+}
+
+func (db memIndexDB) Keys(index uint64) ([][]byte, error) {
+	keys := make([][]byte, 0, len(db[index]))
+	for key := range db[index] {
+		keys = append(keys, []byte(key))
+	}
+	return keys, nil
+}
+
+func (db memIndexDB) Indexes() ([]uint64, error) {
+	indexes := make([]uint64, 0, len(db))
+	for index := range db {
+		indexes = append(indexes, index)
+	}
+	slices.Sort(indexes)
+	return indexes, nil
+}
+
+func (db memIndexDB) Prune(start, end uint64) error {
+	for index := range db {
+		if index >= start && index < end {
+			delete(db, index)
+		}
+	}
+	return nil
+}
+
+func (db memIndexDB) Size(start, end uint64) (uint64, error) {
+	var size uint64
+	for index, values := range db {
+		if index < start || index >= end {
+			continue
+		}
+		for _, value := range values {
+			size += uint64(len(value))
+		}
+	}
+	return size, nil
+}
+
+// mockVerifier is a store.BlobVerifier that fails KZG verification for the
+// configured commitments.
+type mockVerifier struct {
+	invalid map[eip4844.KZGCommitment]bool
+}
+
+func (*mockVerifier) VerifyInclusionProofs(*types.BlobSidecars, uint64) error {
+	return nil
+}
+
+func (v *mockVerifier) VerifyKZGProofs(scs *types.BlobSidecars) error {
+	for _, sc := range scs.Sidecars {
+		if v.invalid[sc.KzgCommitment] {
+			return errors.New("invalid kzg proof")
+		}
+	}
+	return nil
+}
+
+// noopSink is a store.TelemetrySink that discards all metrics.
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string) {}
+
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+type body struct {
+	commitments eip4844.KZGCommitments[common.ExecutionHash]
+}
+
+func (b body) GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash] {
+	return b.commitments
+}
+
+// block is a store.StoredBeaconBlock with a fixed root.
+type block struct {
+	root [32]byte
+	body body
+}
+
+func (b block) HashTreeRoot() ([32]byte, error) { return b.root, nil }
+
+func (b block) GetBody() body { return b.body }
+
+// memBlockStore is an in-memory store.BlockStore.
+type memBlockStore map[math.Slot]block
+
+func (bs memBlockStore) Get(slot math.Slot) (block, error) {
+	blk, ok := bs[slot]
+	if !ok {
+		return block{}, errors.New("block not found")
+	}
+	return blk, nil
+}
+
+// newBlock returns a block whose root is the one of the header of the given
+// sidecar, with the given number of commitments.
+func newBlock(
+	t *testing.T,
+	sidecar *types.BlobSidecar,
+	numCommitments int,
+) block {
+	t.Helper()
+	root, err := sidecar.BeaconBlockHeader.HashTreeRoot()
+	require.NoError(t, err)
+	return block{
+		root: root,
+		body: body{
+			commitments: make(
+				eip4844.KZGCommitments[common.ExecutionHash], numCommitments,
+			),
+		},
+	}
+}
+
+func newTestStore(
+	db memIndexDB,
+	verifier *mockVerifier,
+	verifyOnRead bool,
+) *store.Store[body] {
+	return store.New[body](
+		&store.Config{VerifyOnRead: verifyOnRead},
+		db,
+		noop.NewLogger(),
+		nil,
+		verifier,
+		func(math.Slot, primitives.ChainSpec) uint64 { return 0 },
+		noopSink{},
+	)
+}
+
+func newSidecar(slot math.Slot, index uint64) *types.BlobSidecar {
+	return &types.BlobSidecar{
+		Index:         index,
+		KzgCommitment: eip4844.KZGCommitment{byte(slot), byte(index)},
+		BeaconBlockHeader: ctypes.NewBeaconBlockHeader(
+			slot, 0, [32]byte{}, [32]byte{}, [32]byte{},
+		),
+		InclusionProof: make([][32]byte, 8),
+	}
+}
+
+func persist(t *testing.T, db memIndexDB, sidecars ...*types.BlobSidecar) {
+	t.Helper()
+	for _, sc := range sidecars {
+		bz, err := sc.MarshalSSZ()
+		require.NoError(t, err)
+		require.NoError(t, db.Set(
+			sc.BeaconBlockHeader.GetSlot().Unwrap(), sc.KzgCommitment[:], bz,
+		))
+	}
+}
+
+func TestGetBlobSidecars(t *testing.T) {
+	db := memIndexDB{}
+	persist(t, db, newSidecar(1, 2), newSidecar(1, 0), newSidecar(1, 1))
+	s := newTestStore(db, &mockVerifier{}, true)
+
+	sidecars, err := s.GetBlobSidecars(1)
+	require.NoError(t, err)
+	require.Equal(t, 3, sidecars.Len())
+	for i, sc := range sidecars.Sidecars {
+		require.Equal(t, uint64(i), sc.Index)
+	}
+
+	sidecars, err = s.GetBlobSidecars(2)
+	require.NoError(t, err)
+	require.Equal(t, 0, sidecars.Len())
+}
+
+func TestGetBlobSidecar(t *testing.T) {
+	db := memIndexDB{}
+	sidecar := newSidecar(1, 0)
+	persist(t, db, sidecar)
+	s := newTestStore(db, &mockVerifier{}, true)
+
+	got, err := s.GetBlobSidecar(1, sidecar.KzgCommitment)
+	require.NoError(t, err)
+	require.Equal(t, sidecar, got)
+
+	_, err = s.GetBlobSidecar(2, sidecar.KzgCommitment)
+	require.ErrorIs(t, err, store.ErrSidecarNotFound)
+}
+
+func TestGetBlobSidecarsVerifyOnRead(t *testing.T) {
+	sidecar := newSidecar(1, 0)
+	verifier := &mockVerifier{
+		invalid: map[eip4844.KZGCommitment]bool{sidecar.KzgCommitment: true},
+	}
+
+	tests := []struct {
+		name         string
+		verifyOnRead bool
+		sidecar      *types.BlobSidecar
+		storeSlot    uint64
+		expectedErr  error
+	}{
+		{
+			name:         "verify on read disabled",
+			verifyOnRead: false,
+			sidecar:      sidecar,
+			storeSlot:    1,
+		},
+		{
+			name:         "invalid kzg proof",
+			verifyOnRead: true,
+			sidecar:      sidecar,
+			storeSlot:    1,
+			expectedErr:  errors.New("invalid kzg proof"),
+		},
+		{
+			name:         "header slot mismatch",
+			verifyOnRead: true,
+			sidecar:      newSidecar(2, 1),
+			storeSlot:    1,
+			expectedErr:  store.ErrSidecarSlotMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := memIndexDB{}
+			bz, err := tt.sidecar.MarshalSSZ()
+			require.NoError(t, err)
+			require.NoError(
+				t, db.Set(tt.storeSlot, tt.sidecar.KzgCommitment[:], bz),
+			)
+
+			_, err = newTestStore(db, verifier, tt.verifyOnRead).
+				GetBlobSidecars(math.Slot(tt.storeSlot))
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.expectedErr.Error())
+		})
+	}
+}
+
+func TestGetBlobSidecarsCommitmentMismatch(t *testing.T) {
+	db := memIndexDB{}
+	sidecar := newSidecar(1, 0)
+	bz, err := sidecar.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, db.Set(1, []byte("not-the-commitment"), bz))
+
+	_, err = newTestStore(db, &mockVerifier{}, false).GetBlobSidecars(1)
+	require.ErrorIs(t, err, store.ErrSidecarCommitmentMismatch)
+}
+
+func TestIntegrityChecker(t *testing.T) {
+	db := memIndexDB{}
+	corrupted := newSidecar(3, 0)
+	persist(t, db, newSidecar(1, 0), newSidecar(2, 0), corrupted)
+	// Overwrite the sidecar of slot 4 with garbage.
+	require.NoError(t, db.Set(4, []byte{0x01}, []byte("garbage")))
+	// Slot 5 was pruned after being listed.
+	db[5] = map[string][]byte{}
+
+	verifier := &mockVerifier{
+		invalid: map[eip4844.KZGCommitment]bool{corrupted.KzgCommitment: true},
+	}
+	checker := store.NewIntegrityChecker(
+		&store.Config{IntegrityCheckInterval: time.Minute},
+		newTestStore(db, verifier, false),
+		memBlockStore{},
+		noop.NewLogger(),
+		noopSink{},
+	)
+	require.Equal(t, []math.Slot{3, 4}, checker.Check())
+}
+
+func TestIntegrityCheckerStoredBlock(t *testing.T) {
+	db := memIndexDB{}
+	valid, other := newSidecar(1, 0), newSidecar(2, 0)
+	incomplete := newSidecar(3, 0)
+	persist(t, db, valid, other, incomplete)
+
+	checker := store.NewIntegrityChecker(
+		&store.Config{IntegrityCheckInterval: time.Minute},
+		newTestStore(db, &mockVerifier{}, false),
+		memBlockStore{
+			1: newBlock(t, valid, 1),
+			// The sidecars of slot 2 are not those of its block.
+			2: newBlock(t, valid, 1),
+			// A sidecar of the block of slot 3 is missing.
+			3: newBlock(t, incomplete, 2),
+		},
+		noop.NewLogger(),
+		noopSink{},
+	)
+	require.Equal(t, []math.Slot{2, 3}, checker.Check())
+}
+
+func TestStorePrune(t *testing.T) {
+	db := memIndexDB{}
+	persist(t, db, newSidecar(1, 0), newSidecar(2, 0), newSidecar(3, 0))
+	s := newTestStore(db, &mockVerifier{}, false)
+
+	size, err := s.Size(1, 3)
+	require.NoError(t, err)
+	require.NotZero(t, size)
+
+	require.NoError(t, s.Prune(1, 3))
+	indexes, err := s.Indexes()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, indexes)
+	size, err = s.Size(1, 3)
+	require.NoError(t, err)
+	require.Zero(t, size)
+}
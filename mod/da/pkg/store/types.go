@@ -21,6 +21,9 @@
 package store
 
 import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -38,9 +41,49 @@ type BlockEvent[BeaconBlockT BeaconBlock] interface {
 
 // IndexDB is a database that allows prefixing by index.
 type IndexDB interface {
-	// Has
+	// Has returns whether a value is stored under the given key at the
+	// given index.
 	Has(index uint64, key []byte) (bool, error)
+	// Set stores the value under the given key at the given index.
 	Set(index uint64, key []byte, value []byte) error
+	// Get returns the value stored under the given key at the given index.
+	Get(index uint64, key []byte) ([]byte, error)
+	// Keys returns all of the keys stored at the given index.
+	Keys(index uint64) ([][]byte, error)
+	// Indexes returns all of the indexes that currently hold data, in
+	// ascending order.
+	Indexes() ([]uint64, error)
+	// Prune removes all of the values of the indexes in the range
+	// [start, end).
+	Prune(start, end uint64) error
+	// Size returns the number of bytes held by the values of the indexes in
+	// the range [start, end).
+	Size(start, end uint64) (uint64, error)
+}
+
+// BlockStore is the interface for the store of the finalized beacon blocks.
+type BlockStore[BeaconBlockT any] interface {
+	// Get returns the block stored for the given slot.
+	Get(slot math.Slot) (BeaconBlockT, error)
+}
+
+// StoredBeaconBlock is the interface for the stored beacon blocks the
+// sidecars of the store are checked against.
+type StoredBeaconBlock[BeaconBlockBodyT BeaconBlockBody] interface {
+	// HashTreeRoot returns the hash tree root of the block.
+	HashTreeRoot() ([32]byte, error)
+	// GetBody returns the body of the block.
+	GetBody() BeaconBlockBodyT
+}
+
+// BlobVerifier is the interface for the verifier used to re-verify blob
+// sidecars when they are read back from the store.
+type BlobVerifier interface {
+	// VerifyInclusionProofs verifies the inclusion proofs of the sidecars
+	// against the body root of their block header.
+	VerifyInclusionProofs(*types.BlobSidecars, uint64) error
+	// VerifyKZGProofs verifies the KZG proofs of the sidecars.
+	VerifyKZGProofs(*types.BlobSidecars) error
 }
 
 // BeaconBlockBody is the body of a beacon block.
@@ -48,3 +91,13 @@ type BeaconBlockBody interface {
 	// GetBlobKzgCommitments returns the KZG commitments for the blob.
 	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
 }
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+	// MeasureSince measures the time since the provided start time,
+	// identified by the provided keys.
+	MeasureSince(key string, start time.Time, args ...string)
+}
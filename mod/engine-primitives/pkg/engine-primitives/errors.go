@@ -55,4 +55,8 @@ var (
 	ErrPayloadBlockHashMismatch = errors.New(
 		"block hash in payload does not match assembled block",
 	)
+
+	// ErrInvalidExecutionRequests indicates that the execution requests
+	// returned by the execution client are malformed.
+	ErrInvalidExecutionRequests = errors.New("invalid execution requests")
 )
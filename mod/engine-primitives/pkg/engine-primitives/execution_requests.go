@@ -0,0 +1,247 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Request types as defined in EIP-7685, used as the leading byte of each
+// encoded request list exchanged over the Engine API.
+const (
+	// DepositRequestType is the EIP-6110 deposit request type.
+	DepositRequestType byte = 0x00
+	// WithdrawalRequestType is the EIP-7002 withdrawal request type.
+	WithdrawalRequestType byte = 0x01
+	// ConsolidationRequestType is the EIP-7251 consolidation request type.
+	ConsolidationRequestType byte = 0x02
+)
+
+// DepositRequest is a deposit surfaced by the execution layer as per EIP-6110.
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen -path execution_requests.go -objs DepositRequest,WithdrawalRequest,ConsolidationRequest,ExecutionRequests -include ../../../primitives/pkg/crypto,../../../primitives/pkg/bytes,../../../primitives/pkg/math,../../../primitives/pkg/common,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output execution_requests.ssz.go
+//nolint:lll
+type DepositRequest struct {
+	// Pubkey is the public key of the validator being deposited to.
+	Pubkey crypto.BLSPubkey `json:"pubkey"                ssz-size:"48"`
+	// WithdrawalCredentials are the withdrawal credentials of the validator.
+	WithdrawalCredentials bytes.B32 `json:"withdrawalCredentials" ssz-size:"32"`
+	// Amount is the amount of Gwei deposited.
+	Amount math.Gwei `json:"amount"`
+	// Signature is the signature over the deposit message.
+	Signature crypto.BLSSignature `json:"signature"             ssz-size:"96"`
+	// Index is the index of the deposit in the deposit contract.
+	Index math.U64 `json:"index"`
+}
+
+// WithdrawalRequest is an execution layer triggered withdrawal as per
+// EIP-7002.
+//
+//nolint:lll
+type WithdrawalRequest struct {
+	// SourceAddress is the execution address that sent the request.
+	SourceAddress common.ExecutionAddress `json:"sourceAddress"   ssz-size:"20"`
+	// ValidatorPubkey is the public key of the validator to withdraw from.
+	ValidatorPubkey crypto.BLSPubkey `json:"validatorPubkey" ssz-size:"48"`
+	// Amount is the amount of Gwei to withdraw, zero requests a full exit.
+	Amount math.Gwei `json:"amount"`
+}
+
+// ConsolidationRequest is an execution layer triggered consolidation as per
+// EIP-7251.
+//
+//nolint:lll
+type ConsolidationRequest struct {
+	// SourceAddress is the execution address that sent the request.
+	SourceAddress common.ExecutionAddress `json:"sourceAddress" ssz-size:"20"`
+	// SourcePubkey is the public key of the validator being consolidated.
+	SourcePubkey crypto.BLSPubkey `json:"sourcePubkey"  ssz-size:"48"`
+	// TargetPubkey is the public key of the validator receiving the balance.
+	TargetPubkey crypto.BLSPubkey `json:"targetPubkey"  ssz-size:"48"`
+}
+
+// ExecutionRequests holds the requests produced by the execution layer for a
+// given payload, as included in the Electra beacon block body.
+//
+//nolint:lll
+type ExecutionRequests struct {
+	// Deposits are the EIP-6110 deposit requests.
+	Deposits []*DepositRequest `json:"deposits"       ssz-max:"8192"`
+	// Withdrawals are the EIP-7002 withdrawal requests.
+	Withdrawals []*WithdrawalRequest `json:"withdrawals"    ssz-max:"16"`
+	// Consolidations are the EIP-7251 consolidation requests.
+	Consolidations []*ConsolidationRequest `json:"consolidations" ssz-max:"2"`
+}
+
+// GetDeposits returns the deposit requests.
+func (r *ExecutionRequests) GetDeposits() []*DepositRequest {
+	if r == nil {
+		return nil
+	}
+	return r.Deposits
+}
+
+// GetWithdrawals returns the withdrawal requests.
+func (r *ExecutionRequests) GetWithdrawals() []*WithdrawalRequest {
+	if r == nil {
+		return nil
+	}
+	return r.Withdrawals
+}
+
+// GetConsolidations returns the consolidation requests.
+func (r *ExecutionRequests) GetConsolidations() []*ConsolidationRequest {
+	if r == nil {
+		return nil
+	}
+	return r.Consolidations
+}
+
+// Encode encodes the execution requests into the EIP-7685 form used by
+// the Engine API, that is one entry per non-empty request type made of the
+// request type byte followed by the concatenated SSZ encoded requests.
+func (r *ExecutionRequests) Encode() ([][]byte, error) {
+	var (
+		encoded = make([][]byte, 0)
+		err     error
+	)
+
+	if encoded, err = appendRequests(
+		encoded, DepositRequestType, r.GetDeposits(),
+	); err != nil {
+		return nil, err
+	}
+	if encoded, err = appendRequests(
+		encoded, WithdrawalRequestType, r.GetWithdrawals(),
+	); err != nil {
+		return nil, err
+	}
+	return appendRequests(
+		encoded, ConsolidationRequestType, r.GetConsolidations(),
+	)
+}
+
+// DecodeExecutionRequests decodes the EIP-7685 form of the execution requests
+// returned by the Engine API.
+func DecodeExecutionRequests(encoded [][]byte) (*ExecutionRequests, error) {
+	var (
+		requests = &ExecutionRequests{}
+		prevType = -1
+		err      error
+	)
+
+	for _, entry := range encoded {
+		// Each entry must hold a type byte and at least one request, and the
+		// types must be strictly increasing.
+		if len(entry) < 2 || int(entry[0]) <= prevType {
+			return nil, errors.Wrapf(
+				ErrInvalidExecutionRequests,
+				"malformed entry for type %d", prevType+1,
+			)
+		}
+		prevType = int(entry[0])
+
+		switch entry[0] {
+		case DepositRequestType:
+			requests.Deposits, err = decodeRequests[*DepositRequest](
+				entry[1:], func() *DepositRequest { return &DepositRequest{} },
+			)
+		case WithdrawalRequestType:
+			requests.Withdrawals, err = decodeRequests[*WithdrawalRequest](
+				entry[1:],
+				func() *WithdrawalRequest { return &WithdrawalRequest{} },
+			)
+		case ConsolidationRequestType:
+			requests.Consolidations, err = decodeRequests[*ConsolidationRequest](
+				entry[1:],
+				func() *ConsolidationRequest { return &ConsolidationRequest{} },
+			)
+		default:
+			return nil, errors.Wrapf(
+				ErrInvalidExecutionRequests,
+				"unknown request type %d", entry[0],
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return requests, nil
+}
+
+// sszRequest is the set of SSZ methods shared by the fixed size request types.
+type sszRequest interface {
+	MarshalSSZ() ([]byte, error)
+	UnmarshalSSZ([]byte) error
+	SizeSSZ() int
+}
+
+// appendRequests appends the encoded form of the given requests to encoded,
+// skipping the request type altogether if there are no requests.
+func appendRequests[RequestT sszRequest](
+	encoded [][]byte,
+	requestType byte,
+	requests []RequestT,
+) ([][]byte, error) {
+	if len(requests) == 0 {
+		return encoded, nil
+	}
+
+	entry := make([]byte, 1, 1+len(requests)*requests[0].SizeSSZ())
+	entry[0] = requestType
+	for _, req := range requests {
+		bz, err := req.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		entry = append(entry, bz...)
+	}
+	return append(encoded, entry), nil
+}
+
+// decodeRequests splits data into fixed size requests and decodes each of
+// them.
+func decodeRequests[RequestT sszRequest](
+	data []byte,
+	newFn func() RequestT,
+) ([]RequestT, error) {
+	size := newFn().SizeSSZ()
+	if len(data)%size != 0 {
+		return nil, errors.Wrapf(
+			ErrInvalidExecutionRequests,
+			"data length %d is not a multiple of %d", len(data), size,
+		)
+	}
+
+	requests := make([]RequestT, 0, len(data)/size)
+	for i := 0; i < len(data); i += size {
+		req := newFn()
+		if err := req.UnmarshalSSZ(data[i : i+size]); err != nil {
+			return nil, err
+		}
+		requests = append(requests, req)
+	}
+	return requests, nil
+}
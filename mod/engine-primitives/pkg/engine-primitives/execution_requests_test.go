@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives_test
+
+import (
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionRequests_EncodeDecode(t *testing.T) {
+	requests := &engineprimitives.ExecutionRequests{
+		Deposits: []*engineprimitives.DepositRequest{
+			{
+				Pubkey:    crypto.BLSPubkey{1},
+				Amount:    math.Gwei(32e9),
+				Signature: crypto.BLSSignature{2},
+				Index:     math.U64(7),
+			},
+			{
+				Pubkey: crypto.BLSPubkey{3},
+				Amount: math.Gwei(1e9),
+				Index:  math.U64(8),
+			},
+		},
+		Consolidations: []*engineprimitives.ConsolidationRequest{
+			{
+				SourceAddress: common.ExecutionAddress{4},
+				SourcePubkey:  crypto.BLSPubkey{5},
+				TargetPubkey:  crypto.BLSPubkey{6},
+			},
+		},
+	}
+
+	encoded, err := requests.Encode()
+	require.NoError(t, err)
+	// Empty request types are omitted.
+	require.Len(t, encoded, 2)
+	require.Equal(t, engineprimitives.DepositRequestType, encoded[0][0])
+	require.Len(t, encoded[0], 1+2*192)
+	require.Equal(t, engineprimitives.ConsolidationRequestType, encoded[1][0])
+
+	decoded, err := engineprimitives.DecodeExecutionRequests(encoded)
+	require.NoError(t, err)
+	require.Equal(t, requests.Deposits, decoded.Deposits)
+	require.Empty(t, decoded.Withdrawals)
+	require.Equal(t, requests.Consolidations, decoded.Consolidations)
+}
+
+func TestDecodeExecutionRequests_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		encoded [][]byte
+	}{
+		{
+			name:    "empty entry",
+			encoded: [][]byte{{engineprimitives.DepositRequestType}},
+		},
+		{
+			name:    "unknown type",
+			encoded: [][]byte{{0x03, 0x01}},
+		},
+		{
+			name:    "bad length",
+			encoded: [][]byte{{engineprimitives.WithdrawalRequestType, 0x01}},
+		},
+		{
+			name: "out of order",
+			encoded: [][]byte{
+				append(
+					[]byte{engineprimitives.WithdrawalRequestType},
+					make([]byte, 76)...,
+				),
+				append(
+					[]byte{engineprimitives.DepositRequestType},
+					make([]byte, 192)...,
+				),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := engineprimitives.DecodeExecutionRequests(tt.encoded)
+			require.ErrorIs(t, err, engineprimitives.ErrInvalidExecutionRequests)
+		})
+	}
+}
@@ -114,6 +114,53 @@ func (_c *BuiltExecutionPayloadEnv_GetExecutionPayload_Call[ExecutionPayloadT])
 	return _c
 }
 
+// GetExecutionRequests provides a mock function with given fields:
+func (_m *BuiltExecutionPayloadEnv[ExecutionPayloadT]) GetExecutionRequests() [][]byte {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExecutionRequests")
+	}
+
+	var r0 [][]byte
+	if rf, ok := ret.Get(0).(func() [][]byte); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]byte)
+		}
+	}
+
+	return r0
+}
+
+// BuiltExecutionPayloadEnv_GetExecutionRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExecutionRequests'
+type BuiltExecutionPayloadEnv_GetExecutionRequests_Call[ExecutionPayloadT interface{}] struct {
+	*mock.Call
+}
+
+// GetExecutionRequests is a helper method to define mock.On call
+func (_e *BuiltExecutionPayloadEnv_Expecter[ExecutionPayloadT]) GetExecutionRequests() *BuiltExecutionPayloadEnv_GetExecutionRequests_Call[ExecutionPayloadT] {
+	return &BuiltExecutionPayloadEnv_GetExecutionRequests_Call[ExecutionPayloadT]{Call: _e.mock.On("GetExecutionRequests")}
+}
+
+func (_c *BuiltExecutionPayloadEnv_GetExecutionRequests_Call[ExecutionPayloadT]) Run(run func()) *BuiltExecutionPayloadEnv_GetExecutionRequests_Call[ExecutionPayloadT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BuiltExecutionPayloadEnv_GetExecutionRequests_Call[ExecutionPayloadT]) Return(_a0 [][]byte) *BuiltExecutionPayloadEnv_GetExecutionRequests_Call[ExecutionPayloadT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BuiltExecutionPayloadEnv_GetExecutionRequests_Call[ExecutionPayloadT]) RunAndReturn(run func() [][]byte) *BuiltExecutionPayloadEnv_GetExecutionRequests_Call[ExecutionPayloadT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetValue provides a mock function with given fields:
 func (_m *BuiltExecutionPayloadEnv[ExecutionPayloadT]) GetValue() math.U256L {
 	ret := _m.Called()
@@ -23,6 +23,7 @@ package engineprimitives
 import (
 	"encoding/json"
 
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -37,6 +38,9 @@ type BuiltExecutionPayloadEnv[ExecutionPayloadT any] interface {
 	GetBlobsBundle() BlobsBundle
 	// ShouldOverrideBuilder indicates if the builder should be overridden.
 	ShouldOverrideBuilder() bool
+	// GetExecutionRequests returns the EIP-7685 encoded execution requests,
+	// which are only returned by the execution client from Electra onwards.
+	GetExecutionRequests() [][]byte
 }
 
 // BlobsBundle is an interface for the blobs bundle.
@@ -64,6 +68,8 @@ type ExecutionPayloadEnvelope[
 	BlockValue       math.Wei          `json:"blockValue"`
 	BlobsBundle      BlobsBundleT      `json:"blobsBundle"`
 	Override         bool              `json:"shouldOverrideBuilder"`
+	// ExecutionRequests is only populated by engine_getPayloadV4 onwards.
+	ExecutionRequests []bytes.Bytes `json:"executionRequests,omitempty"`
 }
 
 // GetExecutionPayload returns the execution payload of the
@@ -94,3 +100,18 @@ func (e *ExecutionPayloadEnvelope[
 ]) ShouldOverrideBuilder() bool {
 	return e.Override
 }
+
+// GetExecutionRequests returns the EIP-7685 encoded execution requests of the
+// ExecutionPayloadEnvelope.
+func (e *ExecutionPayloadEnvelope[
+	ExecutionPayloadT, BlobsBundleT,
+]) GetExecutionRequests() [][]byte {
+	if e.ExecutionRequests == nil {
+		return nil
+	}
+	requests := make([][]byte, len(e.ExecutionRequests))
+	for i, req := range e.ExecutionRequests {
+		requests[i] = req
+	}
+	return requests
+}
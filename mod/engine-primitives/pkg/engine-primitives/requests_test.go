@@ -119,6 +119,7 @@ func TestBuildNewPayloadRequest(t *testing.T) {
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		nil,
 		optimistic,
 	)
 
@@ -156,6 +157,7 @@ func TestHasValidVersionedAndBlockHashesError(t *testing.T) {
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		nil,
 		optimistic,
 	)
 
@@ -38,6 +38,17 @@ type EngineCache struct {
 	headerByHashCache *lru.LRU[
 		common.ExecutionHash, *engineprimitives.Header,
 	]
+	// payloadIDCache is an LRU cache that maps the payload ID handed out
+	// for a payload build to the ID each endpoint assigned to that build.
+	payloadIDCache *lru.LRU[
+		payloadIDKey, engineprimitives.PayloadID,
+	]
+}
+
+// payloadIDKey identifies a payload build on a given endpoint.
+type payloadIDKey struct {
+	endpoint  string
+	payloadID engineprimitives.PayloadID
 }
 
 // NewEngineCacheWithConfig creates a new EngineCache with the given config.
@@ -59,6 +70,13 @@ func NewEngineCache(
 			nil,
 			config.HeaderTTL,
 		),
+		payloadIDCache: lru.NewLRU[
+			payloadIDKey, engineprimitives.PayloadID,
+		](
+			config.PayloadIDSize,
+			nil,
+			config.PayloadIDTTL,
+		),
 	}
 }
 
@@ -92,3 +110,24 @@ func (c *EngineCache) AddHeader(
 	c.headerByNumberCache.Add(number, header)
 	c.headerByHashCache.Add(header.Hash(), header)
 }
+
+// PayloadID returns the ID that the given endpoint assigned to the payload
+// build identified by payloadID.
+func (c *EngineCache) PayloadID(
+	endpoint string,
+	payloadID engineprimitives.PayloadID,
+) (engineprimitives.PayloadID, bool) {
+	return c.payloadIDCache.Get(payloadIDKey{endpoint, payloadID})
+}
+
+// AddPayloadID records the ID that the given endpoint assigned to the
+// payload build identified by payloadID.
+func (c *EngineCache) AddPayloadID(
+	endpoint string,
+	payloadID engineprimitives.PayloadID,
+	endpointPayloadID engineprimitives.PayloadID,
+) {
+	c.payloadIDCache.Add(
+		payloadIDKey{endpoint, payloadID}, endpointPayloadID,
+	)
+}
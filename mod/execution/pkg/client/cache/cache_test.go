@@ -105,3 +105,19 @@ func TestEth1HeaderCache(t *testing.T) {
 		require.False(t, ok)
 	})
 }
+
+func TestPayloadIDCache(t *testing.T) {
+	cacheUnderTest := cache.NewEngineCacheWithDefaultConfig()
+	id := engineprimitives.PayloadID{1}
+
+	_, ok := cacheUnderTest.PayloadID("failover-1", id)
+	require.False(t, ok)
+
+	cacheUnderTest.AddPayloadID("failover-1", id, engineprimitives.PayloadID{2})
+	endpointID, ok := cacheUnderTest.PayloadID("failover-1", id)
+	require.True(t, ok)
+	require.Equal(t, engineprimitives.PayloadID{2}, endpointID)
+
+	_, ok = cacheUnderTest.PayloadID("primary", id)
+	require.False(t, ok)
+}
@@ -23,8 +23,10 @@ package cache
 import "time"
 
 const (
-	defaultHeaderSize = 20
-	defaultHeaderTTL  = 10 * time.Minute
+	defaultHeaderSize    = 20
+	defaultHeaderTTL     = 10 * time.Minute
+	defaultPayloadIDSize = 64
+	defaultPayloadIDTTL  = time.Minute
 )
 
 // Config is the configuration for an EngineCache.
@@ -33,12 +35,18 @@ type Config struct {
 	HeaderSize int `mapstructure:"header-size"`
 	// HeaderTTL is the time-to-live for headers in the cache.
 	HeaderTTL time.Duration `mapstructure:"header-ttl"`
+	// PayloadIDSize is the size of the payload ID cache.
+	PayloadIDSize int `mapstructure:"payload-id-size"`
+	// PayloadIDTTL is the time-to-live for payload IDs in the cache.
+	PayloadIDTTL time.Duration `mapstructure:"payload-id-ttl"`
 }
 
 // DefaultConfig returns the default configuration for an EngineCache.
 func DefaultConfig() Config {
 	return Config{
-		HeaderSize: defaultHeaderSize,
-		HeaderTTL:  defaultHeaderTTL,
+		HeaderSize:    defaultHeaderSize,
+		HeaderTTL:     defaultHeaderTTL,
+		PayloadIDSize: defaultPayloadIDSize,
+		PayloadIDTTL:  defaultPayloadIDTTL,
 	}
 }
@@ -24,7 +24,8 @@ import (
 	"context"
 	"encoding/json"
 	"math/big"
-	"net/http"
+	neturl "net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -32,11 +33,27 @@ import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client/cache"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/recorder"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 )
 
+const (
+	// primaryEndpointName is the name of the configured primary endpoint.
+	primaryEndpointName = "primary"
+	// failoverEndpointPrefix is the prefix of the names of the configured
+	// failover endpoints.
+	failoverEndpointPrefix = "failover-"
+	// inProcScheme is the scheme of the endpoint of an engine client created
+	// from an already connected client.
+	inProcScheme = "inproc"
+	// maxPayloadBodiesRequestSize is the maximum number of payload bodies
+	// that may be requested at once, as per the Engine API specification.
+	maxPayloadBodiesRequestSize = 1024
+)
+
 // EngineClient is a struct that holds a pointer to an Eth1Client.
 type EngineClient[
 	ExecutionPayloadT interface {
@@ -47,34 +64,39 @@ type EngineClient[
 	},
 ] struct {
 	// Eth1Client is a struct that holds the Ethereum 1 client and
-	// its configuration.
+	// its configuration. It always points to the client of the primary
+	// endpoint.
 	*ethclient.Eth1Client[ExecutionPayloadT]
 	// cfg is the supplied configuration for the engine client.
 	cfg *Config
 	// logger is the logger for the engine client.
 	logger log.Logger[any]
-	// jwtSecret is the JWT secret for the execution client.
-	jwtSecret *jwt.Secret
 	// eth1ChainID is the chain ID of the execution client.
 	eth1ChainID *big.Int
 	// clientMetrics is the metrics for the engine client.
 	metrics *clientMetrics
-	// capabilities is a map of capabilities that the execution client has.
-	capabilities map[string]struct{}
 	// engineCache is an all-in-one cache for data
 	// that are retrieved by the EngineClient.
 	engineCache *cache.EngineCache
-	// statusErrCond is a condition variable for the status error.
-	statusErrCond *sync.Cond
-	// statusErrMu is a mutex for the status error.
-	statusErrMu *sync.RWMutex
-	// statusErr is the status error of the engine client.
-	statusErr error
+	// endpoints are the execution clients the engine client talks to, the
+	// configured primary first, followed by the failovers.
+	endpoints []*endpoint[ExecutionPayloadT]
+	// primary is the index of the endpoint that serves getPayload and
+	// eth_* requests.
+	primary int
+	// primaryMu protects primary and the embedded Eth1Client.
+	primaryMu sync.RWMutex
+	// healthyCond is a condition variable signalled when the engine client
+	// becomes healthy.
+	healthyCond *sync.Cond
+	// healthyMu is the mutex of healthyCond.
+	healthyMu *sync.Mutex
 }
 
 // New creates a new engine client EngineClient.
 // It takes an Eth1Client as an argument and returns a pointer  to an
-// EngineClient.
+// EngineClient. failoverJWTSecrets holds the JWT secrets of the failover
+// endpoints, the ones that are missing default to jwtSecret.
 func New[ExecutionPayloadT interface {
 	Empty(uint32) ExecutionPayloadT
 	Version() uint32
@@ -84,67 +106,121 @@ func New[ExecutionPayloadT interface {
 	cfg *Config,
 	logger log.Logger[any],
 	jwtSecret *jwt.Secret,
+	failoverJWTSecrets []*jwt.Secret,
 	telemetrySink TelemetrySink,
 	eth1ChainID *big.Int,
 ) *EngineClient[ExecutionPayloadT] {
-	statusErrMu := new(sync.RWMutex)
+	endpoints := make(
+		[]*endpoint[ExecutionPayloadT], 0, len(cfg.FailoverRPCDialURLs)+1,
+	)
+	endpoints = append(endpoints, newEndpoint[ExecutionPayloadT](
+		primaryEndpointName, cfg.RPCDialURL, jwtSecret,
+	))
+	for i, dialURL := range cfg.FailoverRPCDialURLs {
+		secret := jwtSecret
+		if i < len(failoverJWTSecrets) && failoverJWTSecrets[i] != nil {
+			secret = failoverJWTSecrets[i]
+		}
+		endpoints = append(endpoints, newEndpoint[ExecutionPayloadT](
+			failoverEndpointPrefix+strconv.Itoa(i+1), dialURL, secret,
+		))
+	}
+
+	healthyMu := new(sync.Mutex)
 	return &EngineClient[ExecutionPayloadT]{
-		cfg:           cfg,
-		logger:        logger,
-		jwtSecret:     jwtSecret,
-		Eth1Client:    new(ethclient.Eth1Client[ExecutionPayloadT]),
-		capabilities:  make(map[string]struct{}),
-		statusErrMu:   statusErrMu,
-		statusErrCond: sync.NewCond(statusErrMu),
-		engineCache:   cache.NewEngineCacheWithDefaultConfig(),
-		eth1ChainID:   eth1ChainID,
-		metrics:       newClientMetrics(telemetrySink, logger),
+		cfg:         cfg,
+		logger:      logger,
+		Eth1Client:  new(ethclient.Eth1Client[ExecutionPayloadT]),
+		endpoints:   endpoints,
+		healthyMu:   healthyMu,
+		healthyCond: sync.NewCond(healthyMu),
+		engineCache: cache.NewEngineCacheWithDefaultConfig(),
+		eth1ChainID: eth1ChainID,
+		metrics:     newClientMetrics(telemetrySink, logger),
 	}
 }
 
+// NewFromRPCClient creates a new EngineClient that talks to an
+// already connected execution client, e.g. an in-process one, instead of
+// dialing the endpoints of the configuration.
+func NewFromRPCClient[ExecutionPayloadT interface {
+	Empty(uint32) ExecutionPayloadT
+	Version() uint32
+	json.Marshaler
+	json.Unmarshaler
+}](
+	cfg *Config,
+	logger log.Logger[any],
+	rpcClient *ethrpc.Client,
+	telemetrySink TelemetrySink,
+	eth1ChainID *big.Int,
+) *EngineClient[ExecutionPayloadT] {
+	ec := New[ExecutionPayloadT](
+		cfg, logger, nil, nil, telemetrySink, eth1ChainID,
+	)
+	ep := newEndpoint[ExecutionPayloadT](
+		primaryEndpointName,
+		url.NewDialURL(&neturl.URL{Scheme: inProcScheme}),
+		nil,
+	)
+	ep.rpcClient = rpcClient
+	ec.endpoints = []*endpoint[ExecutionPayloadT]{ep}
+	return ec
+}
+
 // StartWithHTTP starts the engine client.
 func (s *EngineClient[ExecutionPayloadT]) Start(
 	ctx context.Context,
 ) error {
-	if s.cfg.RPCDialURL.IsHTTP() || s.cfg.RPCDialURL.IsHTTPS() {
-		// If we are dialing with HTTP(S), start the JWT refresh loop.
-		defer func() {
-			if s.jwtSecret == nil {
-				s.logger.Warn(
-					"JWT secret not provided for http(s) connection" +
-						" - please verify your configuration settings",
-				)
-				return
-			}
-			go s.jwtRefreshLoop(ctx)
-		}()
+	var refreshJWT bool
+	for _, ep := range s.endpoints {
+		if ep.requiresJWT() && ep.jwtSecret == nil {
+			s.logger.Warn(
+				"JWT secret not provided for http(s) or ws(s) connection"+
+					" - please verify your configuration settings",
+				"endpoint", ep.name,
+			)
+		}
+		refreshJWT = refreshJWT || ep.refreshesJWT()
+	}
+
+	if s.cfg.RecordDir != "" {
+		if err := s.startRecorder(ctx); err != nil {
+			return err
+		}
+	}
+
+	// If we are dialing with HTTP(S), start the JWT refresh loop.
+	if refreshJWT {
+		defer func() { go s.jwtRefreshLoop(ctx) }()
+	}
+	if s.cfg.RPCHealthCheckInterval > 0 {
+		defer func() { go s.healthCheckLoop(ctx) }()
 	}
 	return s.initializeConnection(ctx)
 }
 
-// Status verifies the chain ID via JSON-RPC. By proxy
-// we will also verify the connection to the execution client.
+// Status returns nil as long as at least one of the execution client
+// endpoints is healthy.
 func (s *EngineClient[ExecutionPayloadT]) Status() error {
-	s.statusErrMu.RLock()
-	defer s.statusErrMu.RUnlock()
-	return s.status(context.Background())
+	return s.status()
 }
 
 // WaitForHealthy waits for the engine client to be healthy.
 func (s *EngineClient[ExecutionPayloadT]) WaitForHealthy(
 	ctx context.Context,
 ) {
-	s.statusErrMu.Lock()
-	defer s.statusErrMu.Unlock()
+	s.healthyMu.Lock()
+	defer s.healthyMu.Unlock()
 
-	for s.status(ctx) != nil {
+	for s.status() != nil {
 		go s.refreshUntilHealthy(ctx)
 		select {
 		case <-ctx.Done():
 			return
 		default:
 			// Then we wait until we are blessed tf up.
-			s.statusErrCond.Wait()
+			s.healthyCond.Wait()
 		}
 	}
 }
@@ -154,49 +230,73 @@ func (s *EngineClient[ExecutionPayloadT]) WaitForHealthy(
 func (s *EngineClient[ExecutionPayloadT]) VerifyChainID(
 	ctx context.Context,
 ) error {
-	chainID, err := s.Client.ChainID(ctx)
+	return s.verifyChainID(ctx, s.primaryEndpoint())
+}
+
+// ============================== HELPERS ==============================
+
+// startRecorder starts recording the calls made to every endpoint until the
+// context is cancelled.
+func (s *EngineClient[ExecutionPayloadT]) startRecorder(
+	ctx context.Context,
+) error {
+	rec, err := recorder.New(
+		s.cfg.RecordDir,
+		s.cfg.RecordMaxFileSize,
+		s.cfg.RecordMaxFiles,
+		s.logger,
+	)
 	if err != nil {
 		return err
 	}
-
-	if chainID.Uint64() != s.eth1ChainID.Uint64() {
-		return errors.Newf(
-			"wanted chain ID %d, got %d",
-			s.eth1ChainID,
-			chainID.Uint64(),
-		)
+	for _, ep := range s.endpoints {
+		ep.recorder = rec.ForEndpoint(ep.name)
 	}
 
+	go func() {
+		<-ctx.Done()
+		if cErr := rec.Close(); cErr != nil {
+			s.logger.Error("failed to close engine API recorder", "err", cErr)
+		}
+	}()
+
+	s.logger.Info(
+		"recording engine API calls 📼", "dir", s.cfg.RecordDir,
+	)
 	return nil
 }
 
-// ============================== HELPERS ==============================
-
 func (s *EngineClient[ExecutionPayloadT]) initializeConnection(
 	ctx context.Context,
 ) error {
-	// Initialize the connection to the execution client.
-	var (
-		err     error
-		chainID *big.Int
-	)
+	// Initialize the connection to the execution clients.
 	for {
 		s.logger.Info(
 			"waiting for execution client to start 🍺🕔",
 			"dial_url", s.cfg.RPCDialURL,
+			"failovers", len(s.cfg.FailoverRPCDialURLs),
 		)
-		if err = s.setupExecutionClientConnection(ctx); err != nil {
-			s.statusErrMu.Lock()
-			s.statusErr = err
-			s.statusErrMu.Unlock()
-			time.Sleep(s.cfg.RPCStartupCheckInterval)
-			s.logger.Error("failed to setup execution client", "err", err)
-			continue
+		s.checkEndpoints(ctx)
+		err := s.status()
+		if err == nil {
+			break
+		}
+		s.logger.Error("failed to setup execution client", "err", err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.cfg.RPCStartupCheckInterval):
 		}
-		break
 	}
+
+	// Prefer the configured primary whenever it is reachable at startup.
+	if s.endpoints[0].health() == nil {
+		s.promote(s.endpoints[0])
+	}
+
 	// Get the chain ID from the execution client.
-	chainID, err = s.ChainID(ctx)
+	chainID, err := s.ChainID(ctx)
 	if err != nil {
 		s.logger.Error("failed to get chain ID", "err", err)
 		return err
@@ -206,97 +306,219 @@ func (s *EngineClient[ExecutionPayloadT]) initializeConnection(
 	s.logger.Info(
 		"connected to execution client 🔌",
 		"dial_url",
-		s.cfg.RPCDialURL.String(),
+		s.primaryEndpoint().dialURL.String(),
 		"chain_id",
 		chainID.Uint64(),
 		"required_chain_id",
 		s.eth1ChainID,
 	)
-
-	// Exchange capabilities with the execution client.
-	if _, err = s.ExchangeCapabilities(ctx); err != nil {
-		s.logger.Error("failed to exchange capabilities", "err", err)
-		return err
-	}
 	return nil
 }
 
-// setupExecutionClientConnections dials the execution client and
-// ensures the chain ID is correct.
+// setupExecutionClientConnection dials the execution client of the endpoint,
+// ensures the chain ID is correct and exchanges capabilities with it.
 func (s *EngineClient[ExecutionPayloadT]) setupExecutionClientConnection(
 	ctx context.Context,
+	ep *endpoint[ExecutionPayloadT],
 ) error {
 	// Dial the execution client.
-	if err := s.dialExecutionRPCClient(ctx); err != nil {
+	if err := ep.dial(ctx); err != nil {
 		return err
 	}
 
 	// Ensure the execution client is connected to the correct chain.
-	if err := s.VerifyChainID(ctx); err != nil {
-		s.Client.Close()
+	if err := s.verifyChainID(ctx, ep); err != nil {
 		if strings.Contains(err.Error(), "401 Unauthorized") {
 			// We always log this error as it is a critical error.
-			s.logger.Error(UnauthenticatedConnectionErrorStr)
+			s.logger.Error(
+				UnauthenticatedConnectionErrorStr, "endpoint", ep.name,
+			)
 		}
 		return err
 	}
-	return nil
-}
 
-// ================================ Dialing ================================
+	// Exchange capabilities with the execution client.
+	_, err := s.exchangeCapabilities(ctx, ep)
+	return err
+}
 
-// dialExecutionRPCClient dials the execution client's RPC endpoint.
-func (s *EngineClient[ExecutionPayloadT]) dialExecutionRPCClient(
+// verifyChainID checks that the execution client of the endpoint is on the
+// expected chain.
+func (s *EngineClient[ExecutionPayloadT]) verifyChainID(
 	ctx context.Context,
+	ep *endpoint[ExecutionPayloadT],
 ) error {
-	var (
-		client *ethrpc.Client
-		err    error
-	)
+	client := ep.eth1Client()
+	if client == nil {
+		return ErrNotStarted
+	}
 
-	// Dial the execution client based on the URL scheme.
-	switch {
-	case s.cfg.RPCDialURL.IsHTTP(), s.cfg.RPCDialURL.IsHTTPS():
-		// Build an http.Header with the JWT token attached.
-		if s.jwtSecret != nil {
-			var header http.Header
-			if header, err = s.buildJWTHeader(); err != nil {
-				return err
-			}
-			if client, err = ethrpc.DialOptions(
-				ctx, s.cfg.RPCDialURL.String(), ethrpc.WithHeaders(header),
-			); err != nil {
-				return err
-			}
-		} else {
-			if client, err = ethrpc.DialContext(
-				ctx, s.cfg.RPCDialURL.String()); err != nil {
-				return err
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if chainID.Uint64() != s.eth1ChainID.Uint64() {
+		return errors.Newf(
+			"wanted chain ID %d, got %d",
+			s.eth1ChainID,
+			chainID.Uint64(),
+		)
+	}
+
+	return nil
+}
+
+// ================================ Failover ================================
+
+// primaryEndpoint returns the endpoint that is currently the primary.
+func (
+	s *EngineClient[ExecutionPayloadT],
+) primaryEndpoint() *endpoint[ExecutionPayloadT] {
+	s.primaryMu.RLock()
+	defer s.primaryMu.RUnlock()
+	return s.endpoints[s.primary]
+}
+
+// callTargets returns the endpoints that requests are sent to, the primary
+// first followed by the healthy failovers.
+func (
+	s *EngineClient[ExecutionPayloadT],
+) callTargets() []*endpoint[ExecutionPayloadT] {
+	s.primaryMu.RLock()
+	defer s.primaryMu.RUnlock()
+
+	targets := make([]*endpoint[ExecutionPayloadT], 0, len(s.endpoints))
+	if s.endpoints[s.primary].eth1Client() != nil {
+		targets = append(targets, s.endpoints[s.primary])
+	}
+	for i, ep := range s.endpoints {
+		if i != s.primary && ep.health() == nil {
+			targets = append(targets, ep)
+		}
+	}
+	return targets
+}
+
+// checkEndpoints checks the health of every endpoint, re-dialing the ones
+// that are unhealthy.
+func (s *EngineClient[ExecutionPayloadT]) checkEndpoints(
+	ctx context.Context,
+) {
+	var wg sync.WaitGroup
+	for _, ep := range s.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if ep.health() != nil {
+				s.setEndpointHealth(
+					ep, s.setupExecutionClientConnection(ctx, ep),
+				)
+				return
 			}
+			s.setEndpointHealth(ep, s.verifyChainID(ctx, ep))
+		}()
+	}
+	wg.Wait()
+}
+
+// recordEndpointError marks the endpoint as unhealthy if err shows that it
+// could not be reached.
+func (s *EngineClient[ExecutionPayloadT]) recordEndpointError(
+	ep *endpoint[ExecutionPayloadT],
+	err error,
+) {
+	if isEndpointFailure(err) {
+		s.setEndpointHealth(ep, err)
+	}
+}
+
+// setEndpointHealth records the health of the endpoint, promoting another
+// endpoint if the primary became unhealthy.
+func (s *EngineClient[ExecutionPayloadT]) setEndpointHealth(
+	ep *endpoint[ExecutionPayloadT],
+	err error,
+) {
+	if !ep.setHealth(err) {
+		if err == nil && ep == s.primaryEndpoint() {
+			// The client of the primary may have been re-dialed.
+			s.promote(ep)
 		}
-	case s.cfg.RPCDialURL.IsIPC():
-		if client, err = ethrpc.DialIPC(
-			ctx, s.cfg.RPCDialURL.Path); err != nil {
-			s.logger.Error("failed to dial IPC", "err", err)
-			return err
+		return
+	}
+
+	s.metrics.setEndpointHealth(ep.name, err == nil)
+	if err != nil {
+		s.logger.Warn(
+			"execution client endpoint is unhealthy 🚑",
+			"endpoint", ep.name,
+			"err", err,
+		)
+		s.failover(ep)
+		return
+	}
+
+	s.logger.Info("execution client endpoint is healthy 🩺", "endpoint", ep.name)
+	if s.primaryEndpoint().health() != nil {
+		// Nobody was serving requests, so take over from the primary.
+		s.failover(s.primaryEndpoint())
+	} else if ep == s.primaryEndpoint() {
+		s.promote(ep)
+	}
+	s.healthyMu.Lock()
+	s.healthyCond.Broadcast()
+	s.healthyMu.Unlock()
+}
+
+// failover promotes the first healthy endpoint after the given one if the
+// given one is the primary.
+func (s *EngineClient[ExecutionPayloadT]) failover(
+	ep *endpoint[ExecutionPayloadT],
+) {
+	s.primaryMu.RLock()
+	current := s.primary
+	s.primaryMu.RUnlock()
+	if s.endpoints[current] != ep {
+		return
+	}
+
+	for i := 1; i < len(s.endpoints); i++ {
+		next := s.endpoints[(current+i)%len(s.endpoints)]
+		if next.health() != nil {
+			continue
 		}
-	default:
-		return errors.Newf(
-			"no known transport for URL scheme %q",
-			s.cfg.RPCDialURL.Scheme,
+		s.logger.Warn(
+			"failing over to another execution client 🔀",
+			"from", ep.name,
+			"to", next.name,
 		)
+		s.metrics.incrementFailover(ep.name, next.name)
+		s.promote(next)
+		return
 	}
+}
 
-	// Refresh the execution client with the new client.
-	s.Eth1Client, err = ethclient.NewFromRPCClient[ExecutionPayloadT](
-		client,
-	)
-	return err
+// promote makes the endpoint the primary.
+func (s *EngineClient[ExecutionPayloadT]) promote(
+	ep *endpoint[ExecutionPayloadT],
+) {
+	s.primaryMu.Lock()
+	defer s.primaryMu.Unlock()
+	for i, candidate := range s.endpoints {
+		if candidate != ep {
+			continue
+		}
+		s.primary = i
+		if client := ep.eth1Client(); client != nil {
+			s.Eth1Client = client
+		}
+		return
+	}
 }
 
 // ================================ JWT ================================
 
-// jwtRefreshLoop refreshes the JWT token for the execution client.
+// jwtRefreshLoop refreshes the JWT token for the execution clients.
 func (s *EngineClient[ExecutionPayloadT]) jwtRefreshLoop(
 	ctx context.Context,
 ) {
@@ -308,41 +530,30 @@ func (s *EngineClient[ExecutionPayloadT]) jwtRefreshLoop(
 			ticker.Stop()
 			return
 		case <-ticker.C:
-			s.statusErrMu.Lock()
-			if err := s.dialExecutionRPCClient(ctx); err != nil {
-				s.logger.Error("failed to refresh JWT token", "err", err)
-				//#nosec:G703 wtf is even this problem here.
-				s.statusErr = errors.Newf(
-					"%w: failed to refresh JWT token",
-					err,
-				)
-			} else {
-				s.statusErr = nil
+			for _, ep := range s.endpoints {
+				if !ep.refreshesJWT() {
+					continue
+				}
+				if err := ep.dial(ctx); err != nil {
+					s.logger.Error(
+						"failed to refresh JWT token",
+						"endpoint", ep.name,
+						"err", err,
+					)
+					s.setEndpointHealth(ep, errors.Newf(
+						"%w: failed to refresh JWT token",
+						err,
+					))
+					continue
+				}
+				if ep == s.primaryEndpoint() {
+					s.promote(ep)
+				}
 			}
-			s.statusErrMu.Unlock()
 		}
 	}
 }
 
-// buildJWTHeader builds an http.Header that has the JWT token
-// attached for authorization.
-//
-//nolint:lll
-func (s *EngineClient[ExecutionPayloadT]) buildJWTHeader() (http.Header, error) {
-	header := make(http.Header)
-
-	// Build the JWT token.
-	token, err := buildSignedJWT(s.jwtSecret)
-	if err != nil {
-		s.logger.Error("failed to build JWT token", "err", err)
-		return header, err
-	}
-
-	// Add the JWT token to the headers.
-	header.Set("Authorization", "Bearer "+token)
-	return header, nil
-}
-
 // Name returns the name of the engine client.
 func (s *EngineClient[ExecutionPayloadT]) Name() string {
 	return "engine-client"
@@ -350,31 +561,35 @@ func (s *EngineClient[ExecutionPayloadT]) Name() string {
 
 // ================================ Info ================================
 
-// status returns the status of the engine client.
-func (s *EngineClient[ExecutionPayloadT]) status(
-	ctx context.Context,
-) error {
-	// If the client is not started, we return an error.
-	if s.Eth1Client.Client == nil {
-		return ErrNotStarted
+// status returns the status of the engine client, which is healthy as long
+// as one of its endpoints is.
+func (s *EngineClient[ExecutionPayloadT]) status() error {
+	for _, ep := range s.endpoints {
+		if ep.health() == nil {
+			return nil
+		}
 	}
+	return s.endpoints[0].health()
+}
 
-	if s.statusErr == nil {
-		// If we have an error, we will attempt
-		// to verify the chain ID again.
-		//#nosec:G703 wtf is even this problem here.
-		s.statusErr = s.VerifyChainID(ctx)
-	}
+// healthCheckLoop periodically checks the health of every endpoint.
+func (s *EngineClient[ExecutionPayloadT]) healthCheckLoop(
+	ctx context.Context,
+) {
+	ticker := time.NewTicker(s.cfg.RPCHealthCheckInterval)
+	defer ticker.Stop()
 
-	if s.statusErr == nil {
-		s.statusErrCond.Broadcast()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.checkEndpoints(ctx)
+		}
 	}
-
-	return s.statusErr
 }
 
 // refreshUntilHealthy refreshes the engine client until it is healthy.
-// TODO: remove after hack testing done.
 func (s *EngineClient[ExecutionPayloadT]) refreshUntilHealthy(
 	ctx context.Context,
 ) {
@@ -386,7 +601,8 @@ func (s *EngineClient[ExecutionPayloadT]) refreshUntilHealthy(
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			if err := s.status(ctx); err == nil {
+			s.checkEndpoints(ctx)
+			if err := s.status(); err == nil {
 				return
 			}
 		}
@@ -32,8 +32,14 @@ const (
 	defaultRPCTimeout              = 2 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
 	defaultRPCJWTRefreshInterval   = 30 * time.Second
+	defaultRPCHealthCheckInterval  = 5 * time.Second
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
+	// defaultRecordMaxFileSize is the default size in bytes after which
+	// a recording file is rotated.
+	defaultRecordMaxFileSize = 100 << 20
+	// defaultRecordMaxFiles is the default number of recording files kept.
+	defaultRecordMaxFiles = 10
 )
 
 // DefaultConfig is the default configuration for the engine client.
@@ -46,7 +52,13 @@ func DefaultConfig() Config {
 		RPCTimeout:              defaultRPCTimeout,
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
+		RPCHealthCheckInterval:  defaultRPCHealthCheckInterval,
 		JWTSecretPath:           defaultJWTSecretPath,
+		FailoverRPCDialURLs:     []*url.ConnectionURL{},
+		FailoverJWTSecretPaths:  []string{},
+		RecordDir:               "",
+		RecordMaxFileSize:       defaultRecordMaxFileSize,
+		RecordMaxFiles:          defaultRecordMaxFiles,
 	}
 }
 
@@ -54,7 +66,8 @@ func DefaultConfig() Config {
 //
 //nolint:lll // struct tags.
 type Config struct {
-	// RPCDialURL is the HTTP url of the execution client JSON-RPC endpoint.
+	// RPCDialURL is the url of the execution client JSON-RPC endpoint. The
+	// http(s), ws(s) and ipc schemes are supported.
 	RPCDialURL *url.ConnectionURL `mapstructure:"rpc-dial-url"`
 	// RPCRetries is the number of retries before shutting down consensus
 	// client.
@@ -65,6 +78,26 @@ type Config struct {
 	RPCStartupCheckInterval time.Duration `mapstructure:"rpc-startup-check-interval"`
 	// JWTRefreshInterval is the Interval for the JWT refresh.
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
+	// RPCHealthCheckInterval is the Interval at which the health of every
+	// execution client endpoint is checked.
+	RPCHealthCheckInterval time.Duration `mapstructure:"rpc-health-check-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// FailoverRPCDialURLs are the urls of the secondary execution clients.
+	// They receive every forkchoice update and new payload alongside the
+	// primary and take over from it when it becomes unhealthy.
+	FailoverRPCDialURLs []*url.ConnectionURL `mapstructure:"failover-rpc-dial-urls"`
+	// FailoverJWTSecretPaths are the paths to the JWT secrets of the
+	// secondary execution clients, in the same order as FailoverRPCDialURLs.
+	// Endpoints without an entry use the JWT secret of the primary.
+	FailoverJWTSecretPaths []string `mapstructure:"failover-jwt-secret-paths"`
+	// RecordDir is the directory to which every Engine API request and
+	// response is recorded. Recording is disabled if it is empty.
+	RecordDir string `mapstructure:"record-dir"`
+	// RecordMaxFileSize is the size in bytes after which a recording file
+	// is rotated.
+	RecordMaxFileSize uint64 `mapstructure:"record-max-file-size"`
+	// RecordMaxFiles is the number of recording files that are kept, zero
+	// keeps all of them.
+	RecordMaxFiles int `mapstructure:"record-max-files"`
 }
@@ -0,0 +1,373 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	jsonrpc "github.com/berachain/beacon-kit/mod/primitives/pkg/net/json-rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// endpoint is a single execution client that the EngineClient talks to,
+// together with the JWT secret used to authenticate against it and its
+// health.
+type endpoint[
+	ExecutionPayloadT interface {
+		Empty(uint32) ExecutionPayloadT
+		Version() uint32
+		json.Marshaler
+		json.Unmarshaler
+	},
+] struct {
+	// name is the name under which the endpoint is logged and measured.
+	name string
+	// dialURL is the url of the execution client JSON-RPC endpoint.
+	dialURL *url.ConnectionURL
+	// jwtSecret is the JWT secret for the execution client.
+	jwtSecret *jwt.Secret
+	// rpcClient is the already connected client of an endpoint that is
+	// never dialed, nil for endpoints that are dialed.
+	rpcClient *ethrpc.Client
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// client is the client of the execution client, nil until dialed.
+	client *ethclient.Eth1Client[ExecutionPayloadT]
+	// err is the reason the endpoint is unhealthy, nil when healthy.
+	err error
+	// capabilities is a map of capabilities that the execution client has.
+	capabilities map[string]struct{}
+	// recorder records the calls made to the endpoint, nil if they are not
+	// recorded.
+	recorder ethclient.Recorder
+}
+
+// newEndpoint creates a new endpoint that is unhealthy until dialed.
+func newEndpoint[
+	ExecutionPayloadT interface {
+		Empty(uint32) ExecutionPayloadT
+		Version() uint32
+		json.Marshaler
+		json.Unmarshaler
+	},
+](
+	name string,
+	dialURL *url.ConnectionURL,
+	jwtSecret *jwt.Secret,
+) *endpoint[ExecutionPayloadT] {
+	return &endpoint[ExecutionPayloadT]{
+		name:         name,
+		dialURL:      dialURL,
+		jwtSecret:    jwtSecret,
+		err:          ErrNotStarted,
+		capabilities: make(map[string]struct{}),
+	}
+}
+
+// requiresJWT returns true if the transport of the endpoint must be
+// authenticated with a JWT token, which is the case for everything but IPC.
+func (e *endpoint[ExecutionPayloadT]) requiresJWT() bool {
+	return e.dialURL.IsHTTP() || e.dialURL.IsHTTPS() ||
+		e.dialURL.IsWS() || e.dialURL.IsWSS()
+}
+
+// refreshesJWT returns true if the endpoint must be re-dialed periodically
+// to refresh its JWT token. HTTP connections carry the token built when
+// they were dialed, whereas websockets build a new one on every
+// (re)connection.
+func (e *endpoint[ExecutionPayloadT]) refreshesJWT() bool {
+	return e.jwtSecret != nil &&
+		(e.dialURL.IsHTTP() || e.dialURL.IsHTTPS())
+}
+
+// dial dials the execution client's RPC endpoint and replaces the client of
+// the endpoint with the new connection. The transport is selected by the
+// scheme of the dial URL.
+func (e *endpoint[ExecutionPayloadT]) dial(ctx context.Context) error {
+	var (
+		client *ethrpc.Client
+		err    error
+	)
+
+	// An already connected client is wrapped once and never re-dialed.
+	if e.rpcClient != nil {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		if e.client == nil {
+			e.client, err = ethclient.NewFromRPCClient[ExecutionPayloadT](
+				e.rpcClient,
+			)
+			if err == nil && e.recorder != nil {
+				e.client.WithRecorder(e.recorder)
+			}
+		}
+		return err
+	}
+
+	// Dial the execution client based on the URL scheme.
+	switch {
+	case e.dialURL.IsHTTP(), e.dialURL.IsHTTPS():
+		// Build an http.Header with the JWT token attached.
+		if e.jwtSecret != nil {
+			var header http.Header
+			if header, err = e.buildJWTHeader(); err != nil {
+				return err
+			}
+			if client, err = ethrpc.DialOptions(
+				ctx, e.dialURL.String(), ethrpc.WithHeaders(header),
+			); err != nil {
+				return err
+			}
+		} else {
+			if client, err = ethrpc.DialContext(
+				ctx, e.dialURL.String()); err != nil {
+				return err
+			}
+		}
+	case e.dialURL.IsWS(), e.dialURL.IsWSS():
+		// The JWT token is built on connect and again whenever the
+		// websocket reconnects.
+		var opts []ethrpc.ClientOption
+		if e.jwtSecret != nil {
+			opts = append(opts, ethrpc.WithHTTPAuth(e.setJWTHeader))
+		}
+		if client, err = ethrpc.DialOptions(
+			ctx, e.dialURL.String(), opts...,
+		); err != nil {
+			return err
+		}
+	case e.dialURL.IsIPC():
+		if client, err = ethrpc.DialIPC(ctx, e.dialURL.Path); err != nil {
+			return err
+		}
+	default:
+		return errors.Newf(
+			"no known transport for URL scheme %q",
+			e.dialURL.Scheme,
+		)
+	}
+
+	eth1Client, err := ethclient.NewFromRPCClient[ExecutionPayloadT](client)
+	if err != nil {
+		return err
+	} else if e.recorder != nil {
+		eth1Client.WithRecorder(e.recorder)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.client != nil {
+		e.client.Close()
+	}
+	e.client = eth1Client
+	return nil
+}
+
+// buildJWTHeader builds an http.Header that has the JWT token
+// attached for authorization.
+func (e *endpoint[ExecutionPayloadT]) buildJWTHeader() (http.Header, error) {
+	header := make(http.Header)
+	return header, e.setJWTHeader(header)
+}
+
+// setJWTHeader attaches a freshly signed JWT token to the header.
+func (e *endpoint[ExecutionPayloadT]) setJWTHeader(header http.Header) error {
+	// Build the JWT token.
+	token, err := buildSignedJWT(e.jwtSecret)
+	if err != nil {
+		return err
+	}
+
+	// Add the JWT token to the headers.
+	header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// eth1Client returns the client of the endpoint, nil if it was never dialed.
+func (
+	e *endpoint[ExecutionPayloadT],
+) eth1Client() *ethclient.Eth1Client[ExecutionPayloadT] {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.client
+}
+
+// health returns the reason the endpoint is unhealthy, nil when healthy.
+func (e *endpoint[ExecutionPayloadT]) health() error {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.err
+}
+
+// setHealth records the health of the endpoint and returns true if it
+// changed.
+func (e *endpoint[ExecutionPayloadT]) setHealth(err error) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	changed := (e.err == nil) != (err == nil)
+	e.err = err
+	return changed
+}
+
+// setCapabilities records the capabilities of the execution client.
+func (e *endpoint[ExecutionPayloadT]) setCapabilities(capabilities []string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.capabilities = make(map[string]struct{}, len(capabilities))
+	for _, capability := range capabilities {
+		e.capabilities[capability] = struct{}{}
+	}
+}
+
+// hasCapability returns true if the execution client supports the given
+// capability.
+func (e *endpoint[ExecutionPayloadT]) hasCapability(capability string) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	_, ok := e.capabilities[capability]
+	return ok
+}
+
+// endpointResult is the outcome of a request to a single endpoint.
+type endpointResult[
+	ExecutionPayloadT interface {
+		Empty(uint32) ExecutionPayloadT
+		Version() uint32
+		json.Marshaler
+		json.Unmarshaler
+	},
+	ResultT any,
+] struct {
+	// endpoint is the endpoint the request was sent to.
+	endpoint *endpoint[ExecutionPayloadT]
+	// result is the result of the request.
+	result ResultT
+	// err is the error of the request.
+	err error
+}
+
+// fanOut sends a request to the primary and every healthy failover
+// concurrently. It returns the outcome of the primary as soon as it is
+// available, or the outcome of the first failover that could be reached if
+// the primary could not. The returned function blocks until every endpoint
+// answered and returns all of their outcomes.
+func fanOut[
+	ExecutionPayloadT interface {
+		Empty(uint32) ExecutionPayloadT
+		Version() uint32
+		json.Marshaler
+		json.Unmarshaler
+	},
+	ResultT any,
+](
+	ctx context.Context,
+	s *EngineClient[ExecutionPayloadT],
+	call func(
+		context.Context, *ethclient.Eth1Client[ExecutionPayloadT],
+		*endpoint[ExecutionPayloadT],
+	) (ResultT, error),
+) (
+	endpointResult[ExecutionPayloadT, ResultT],
+	func() []endpointResult[ExecutionPayloadT, ResultT],
+) {
+	var (
+		wg      sync.WaitGroup
+		targets = s.callTargets()
+		results = make(
+			[]endpointResult[ExecutionPayloadT, ResultT], len(targets),
+		)
+		done = make([]chan struct{}, len(targets))
+		all  = func() []endpointResult[ExecutionPayloadT, ResultT] {
+			wg.Wait()
+			return results
+		}
+	)
+	if len(targets) == 0 {
+		return endpointResult[ExecutionPayloadT, ResultT]{
+			endpoint: s.primaryEndpoint(),
+			err:      ErrNotStarted,
+		}, all
+	}
+
+	for i, ep := range targets {
+		wg.Add(1)
+		done[i] = make(chan struct{})
+		go func() {
+			defer wg.Done()
+			defer close(done[i])
+
+			// The failovers are not cut short once the primary answered,
+			// otherwise they would fall behind it.
+			callCtx := ctx
+			if i > 0 {
+				var cancel context.CancelFunc
+				callCtx, cancel = context.WithTimeoutCause(
+					context.WithoutCancel(ctx), s.cfg.RPCTimeout,
+					engineerrors.ErrEngineAPITimeout,
+				)
+				defer cancel()
+			}
+
+			result, err := call(callCtx, ep.eth1Client(), ep)
+			s.recordEndpointError(ep, err)
+			results[i] = endpointResult[ExecutionPayloadT, ResultT]{
+				endpoint: ep,
+				result:   result,
+				err:      err,
+			}
+		}()
+	}
+
+	// Serve the outcome of the first endpoint that could be reached.
+	for i := range targets {
+		<-done[i]
+		if !isEndpointFailure(results[i].err) {
+			return results[i], all
+		}
+	}
+	return results[0], all
+}
+
+// isEndpointFailure returns true if err shows that the endpoint could not be
+// reached, as opposed to the execution client rejecting the request.
+func isEndpointFailure(err error) bool {
+	switch {
+	case err == nil,
+		errors.Is(err, context.Canceled),
+		errors.Is(err, ErrNotStarted),
+		errors.Is(err, engineerrors.ErrInvalidPayloadType),
+		errors.Is(err, engineerrors.ErrInvalidPayloadAttributes),
+		errors.Is(err, engineerrors.ErrInvalidGetPayloadVersion):
+		return false
+	case errors.Is(err, engineerrors.ErrEngineAPITimeout):
+		return true
+	}
+	var rpcErr jsonrpc.Error
+	return !errors.As(err, &rpcErr)
+}
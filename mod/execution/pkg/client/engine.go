@@ -30,85 +30,109 @@ import (
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	jsonrpc "github.com/berachain/beacon-kit/mod/primitives/pkg/net/json-rpc"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
-// NewPayload calls the engine_newPayloadVX method via JSON-RPC.
+// NewPayload calls the engine_newPayloadVX method via JSON-RPC. The payload
+// is sent to every healthy endpoint so that the failovers stay in sync.
 func (s *EngineClient[ExecutionPayloadT]) NewPayload(
 	ctx context.Context,
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *primitives.Root,
+	executionRequests [][]byte,
 ) (*common.ExecutionHash, error) {
-	startTime := time.Now()
-	defer s.metrics.measureNewPayloadDuration(startTime)
 	dctx, cancel := context.WithTimeoutCause(
-		ctx, s.cfg.RPCTimeout, engineerrors.ErrEngineAPITimeout,
+		ethclient.WithForkVersion(ctx, payload.Version()),
+		s.cfg.RPCTimeout,
+		engineerrors.ErrEngineAPITimeout,
 	)
 	defer cancel()
 
 	// Call the appropriate RPC method based on the payload version.
-	result, err := s.callNewPayloadRPC(
-		dctx,
-		payload,
-		versionedHashes,
-		parentBeaconBlockRoot,
-	)
-	if err != nil {
+	res, _ := fanOut(dctx, s, func(
+		ctx context.Context,
+		client *ethclient.Eth1Client[ExecutionPayloadT],
+		ep *endpoint[ExecutionPayloadT],
+	) (*engineprimitives.PayloadStatusV1, error) {
+		defer s.metrics.measureNewPayloadDuration(time.Now(), ep.name)
+		result, err := s.callNewPayloadRPC(
+			ctx,
+			client,
+			payload,
+			versionedHashes,
+			parentBeaconBlockRoot,
+			executionRequests,
+		)
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
-			s.metrics.incrementNewPayloadTimeout()
+			s.metrics.incrementNewPayloadTimeout(ep.name)
 		}
-		return nil, err
-	} else if result == nil {
+		return result, err
+	})
+	if res.err != nil {
+		return nil, res.err
+	} else if res.result == nil {
 		return nil, engineerrors.ErrNilPayloadStatus
 	}
 
 	// This case is only true when the payload is invalid, so
 	// `processPayloadStatusResult` below will return an error.
-	if validationErr := result.ValidationError; validationErr != nil {
+	if validationErr := res.result.ValidationError; validationErr != nil {
 		s.logger.Error(
 			"Got a validation error in newPayload",
-			"err",
-			errors.New(*validationErr),
+			"endpoint", res.endpoint.name,
+			"err", errors.New(*validationErr),
 		)
 	}
 
-	return processPayloadStatusResult(result)
+	return processPayloadStatusResult(res.result)
 }
 
 // callNewPayloadRPC calls the engine_newPayloadVX method via JSON-RPC.
 func (s *EngineClient[ExecutionPayloadT]) callNewPayloadRPC(
 	ctx context.Context,
+	client *ethclient.Eth1Client[ExecutionPayloadT],
 	payload ExecutionPayload,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *primitives.Root,
+	executionRequests [][]byte,
 ) (*engineprimitives.PayloadStatusV1, error) {
 	switch payload.Version() {
 	case version.Deneb:
-		return s.NewPayloadV3(
+		return client.NewPayloadV3(
 			ctx,
 			payload,
 			versionedHashes,
 			parentBeaconBlockRoot,
 		)
 	case version.Electra:
-		return nil, errors.New("TODO: implement Electra payload")
+		return client.NewPayloadV4(
+			ctx,
+			payload,
+			versionedHashes,
+			parentBeaconBlockRoot,
+			executionRequests,
+		)
 	default:
 		return nil, engineerrors.ErrInvalidPayloadType
 	}
 }
 
 // ForkchoiceUpdated calls the engine_forkchoiceUpdatedV1 method via JSON-RPC.
+// The update is sent to every healthy endpoint so that the failovers follow
+// the same head and build the same payloads as the primary.
 func (s *EngineClient[ExecutionPayloadT]) ForkchoiceUpdated(
 	ctx context.Context,
 	state *engineprimitives.ForkchoiceStateV1,
 	attrs engineprimitives.PayloadAttributer,
 	forkVersion uint32,
 ) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
-	startTime := time.Now()
-	defer s.metrics.measureForkchoiceUpdateDuration(startTime)
 	dctx, cancel := context.WithTimeoutCause(
-		ctx, s.cfg.RPCTimeout, engineerrors.ErrEngineAPITimeout,
+		ethclient.WithForkVersion(ctx, forkVersion),
+		s.cfg.RPCTimeout,
+		engineerrors.ErrEngineAPITimeout,
 	)
 	defer cancel()
 
@@ -122,118 +146,280 @@ func (s *EngineClient[ExecutionPayloadT]) ForkchoiceUpdated(
 		)
 	}
 
-	result, err := s.callUpdatedForkchoiceRPC(dctx, state, attrs, forkVersion)
-
-	if err != nil {
+	res, all := fanOut(dctx, s, func(
+		ctx context.Context,
+		client *ethclient.Eth1Client[ExecutionPayloadT],
+		ep *endpoint[ExecutionPayloadT],
+	) (*engineprimitives.ForkchoiceResponseV1, error) {
+		defer s.metrics.measureForkchoiceUpdateDuration(time.Now(), ep.name)
+		result, err := s.callUpdatedForkchoiceRPC(
+			ctx, client, state, attrs, forkVersion,
+		)
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
-			s.metrics.incrementForkchoiceUpdateTimeout()
+			s.metrics.incrementForkchoiceUpdateTimeout(ep.name)
 		}
-		return nil, nil, s.handleRPCError(err)
-	} else if result == nil {
+		return result, err
+	})
+	if res.err != nil {
+		return nil, nil, s.handleRPCError(res.err)
+	} else if res.result == nil {
 		return nil, nil, engineerrors.ErrNilForkchoiceResponse
 	}
 
-	latestValidHash, err := processPayloadStatusResult((&result.PayloadStatus))
+	// Remember the ID under which every endpoint builds the payload, so
+	// that it can be retrieved from whichever endpoint is the primary.
+	if payloadID := res.result.PayloadID; payloadID != nil {
+		go func() {
+			for _, r := range all() {
+				if r.err != nil || r.result == nil ||
+					r.result.PayloadID == nil {
+					continue
+				}
+				s.engineCache.AddPayloadID(
+					r.endpoint.name, *payloadID, *r.result.PayloadID,
+				)
+			}
+		}()
+	}
+
+	latestValidHash, err := processPayloadStatusResult(
+		&res.result.PayloadStatus,
+	)
 	if err != nil {
 		return nil, latestValidHash, err
 	}
-	return result.PayloadID, latestValidHash, nil
+	return res.result.PayloadID, latestValidHash, nil
 }
 
 // updateForkChoiceByVersion calls the engine_forkchoiceUpdatedVX method via
 // JSON-RPC.
 func (s *EngineClient[ExecutionPayloadT]) callUpdatedForkchoiceRPC(
 	ctx context.Context,
+	client *ethclient.Eth1Client[ExecutionPayloadT],
 	state *engineprimitives.ForkchoiceStateV1,
 	attrs engineprimitives.PayloadAttributer,
 	forkVersion uint32,
 ) (*engineprimitives.ForkchoiceResponseV1, error) {
 	switch forkVersion {
-	case version.Deneb:
-		return s.ForkchoiceUpdatedV3(ctx, state, attrs)
-	case version.Electra:
-		return nil, errors.New("TODO: implement Electra hardfork")
+	case version.Deneb, version.Electra:
+		return client.ForkchoiceUpdatedV3(ctx, state, attrs)
 	default:
 		return nil, engineerrors.ErrInvalidPayloadAttributes
 	}
 }
 
 // GetPayload calls the engine_getPayloadVX method via JSON-RPC. It returns
-// the execution data as well as the blobs bundle.
+// the execution data as well as the blobs bundle. The payload is served by
+// the primary, if it cannot be reached the request is retried on the
+// endpoint that takes over from it.
 func (s *EngineClient[ExecutionPayloadT]) GetPayload(
 	ctx context.Context,
 	payloadID engineprimitives.PayloadID,
 	forkVersion uint32,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
-	startTime := time.Now()
-	defer s.metrics.measureGetPayloadDuration(startTime)
 	dctx, cancel := context.WithTimeoutCause(
-		ctx, s.cfg.RPCTimeout, engineerrors.ErrEngineAPITimeout,
+		ethclient.WithForkVersion(ctx, forkVersion),
+		s.cfg.RPCTimeout,
+		engineerrors.ErrEngineAPITimeout,
 	)
 	defer cancel()
 
+	ep := s.primaryEndpoint()
+	result, err := s.getPayload(dctx, ep, payloadID, forkVersion)
+	if isEndpointFailure(err) {
+		s.recordEndpointError(ep, err)
+		if next := s.primaryEndpoint(); next != ep {
+			result, err = s.getPayload(dctx, next, payloadID, forkVersion)
+			s.recordEndpointError(next, err)
+		}
+	}
+
+	// Check for errors.
+	switch {
+	case err != nil:
+		return result, s.handleRPCError(err)
+	case result == nil:
+		return result, engineerrors.ErrNilExecutionPayloadEnvelope
+	case result.GetBlobsBundle() == nil && forkVersion >= version.Deneb:
+		return result, engineerrors.ErrNilBlobsBundle
+	}
+
+	return result, nil
+}
+
+// getPayload calls the engine_getPayloadVX method of the given endpoint.
+func (s *EngineClient[ExecutionPayloadT]) getPayload(
+	ctx context.Context,
+	ep *endpoint[ExecutionPayloadT],
+	payloadID engineprimitives.PayloadID,
+	forkVersion uint32,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	defer s.metrics.measureGetPayloadDuration(time.Now(), ep.name)
+
+	client := ep.eth1Client()
+	if client == nil {
+		return nil, ErrNotStarted
+	}
+
 	// Determine what version we want to call.
 	var fn func(
 		context.Context, engineprimitives.PayloadID,
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 	switch forkVersion {
 	case version.Deneb:
-		fn = s.GetPayloadV3
+		fn = client.GetPayloadV3
 	case version.Electra:
-		return nil, errors.New("TODO: implement Electra getPayload")
+		fn = client.GetPayloadV4
 	default:
 		return nil, engineerrors.ErrInvalidGetPayloadVersion
 	}
 
-	// Call and check for errors.
-	result, err := fn(dctx, payloadID)
+	// The endpoint may have assigned its own ID to the payload build.
+	if id, ok := s.engineCache.PayloadID(ep.name, payloadID); ok {
+		payloadID = id
+	}
+
+	result, err := fn(ctx, payloadID)
+	if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
+		s.metrics.incrementGetPayloadTimeout(ep.name)
+	}
+	return result, err
+}
+
+// GetPayloadBodiesByHash calls the engine_getPayloadBodiesByHashV1 method of
+// the primary via JSON-RPC. The bodies are returned in the order of the
+// given hashes, the body of a payload the execution client does not have is
+// nil.
+func (s *EngineClient[ExecutionPayloadT]) GetPayloadBodiesByHash(
+	ctx context.Context,
+	hashes []common.ExecutionHash,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	if len(hashes) > maxPayloadBodiesRequestSize {
+		return nil, engineerrors.ErrRequestTooLarge
+	}
+
+	return s.getPayloadBodies(
+		ctx,
+		ethclient.GetPayloadBodiesByHashV1,
+		func(
+			ctx context.Context,
+			client *ethclient.Eth1Client[ExecutionPayloadT],
+		) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+			return client.GetPayloadBodiesByHashV1(ctx, hashes)
+		},
+	)
+}
+
+// GetPayloadBodiesByRange calls the engine_getPayloadBodiesByRangeV1 method
+// of the primary via JSON-RPC. The bodies of the count canonical payloads
+// starting at the given block number are returned, truncated after the
+// latest payload known to the execution client.
+func (s *EngineClient[ExecutionPayloadT]) GetPayloadBodiesByRange(
+	ctx context.Context,
+	start, count math.U64,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
 	switch {
-	case err != nil:
-		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
-			s.metrics.incrementGetPayloadTimeout()
-		}
-		return result, s.handleRPCError(err)
-	case result == nil:
-		return result, engineerrors.ErrNilExecutionPayloadEnvelope
-	case result.GetBlobsBundle() == nil && forkVersion >= version.Deneb:
-		return result, engineerrors.ErrNilBlobsBundle
+	case start == 0 || count == 0:
+		return nil, jsonrpc.ErrInvalidParams
+	case count > maxPayloadBodiesRequestSize:
+		return nil, engineerrors.ErrRequestTooLarge
+	}
+
+	return s.getPayloadBodies(
+		ctx,
+		ethclient.GetPayloadBodiesByRangeV1,
+		func(
+			ctx context.Context,
+			client *ethclient.Eth1Client[ExecutionPayloadT],
+		) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+			return client.GetPayloadBodiesByRangeV1(ctx, start, count)
+		},
+	)
+}
+
+// getPayloadBodies calls the given engine_getPayloadBodiesBy* method of the
+// primary, if the execution client supports it.
+func (s *EngineClient[ExecutionPayloadT]) getPayloadBodies(
+	ctx context.Context,
+	method string,
+	fn func(
+		context.Context, *ethclient.Eth1Client[ExecutionPayloadT],
+	) ([]*engineprimitives.ExecutionPayloadBodyV1, error),
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	dctx, cancel := context.WithTimeoutCause(
+		ctx, s.cfg.RPCTimeout, engineerrors.ErrEngineAPITimeout,
+	)
+	defer cancel()
+
+	ep := s.primaryEndpoint()
+	client := ep.eth1Client()
+	if client == nil {
+		return nil, ErrNotStarted
+	} else if !ep.hasCapability(method) {
+		return nil, errors.Wrap(ErrUnsupportedCapability, method)
 	}
 
+	result, err := fn(dctx, client)
+	if err != nil {
+		return nil, s.handleRPCError(err)
+	}
 	return result, nil
 }
 
-// ExchangeCapabilities calls the engine_exchangeCapabilities method via
-// JSON-RPC.
+// HasCapability returns true if the primary execution client advertised
+// the given Engine API method when capabilities were exchanged with it.
+func (s *EngineClient[ExecutionPayloadT]) HasCapability(
+	capability string,
+) bool {
+	return s.primaryEndpoint().hasCapability(capability)
+}
+
+// ExchangeCapabilities calls the engine_exchangeCapabilities method of the
+// primary via JSON-RPC.
 func (s *EngineClient[ExecutionPayloadT]) ExchangeCapabilities(
 	ctx context.Context,
 ) ([]string, error) {
-	result, err := s.Eth1Client.ExchangeCapabilities(
+	return s.exchangeCapabilities(ctx, s.primaryEndpoint())
+}
+
+// exchangeCapabilities calls the engine_exchangeCapabilities method of the
+// given endpoint via JSON-RPC.
+func (s *EngineClient[ExecutionPayloadT]) exchangeCapabilities(
+	ctx context.Context,
+	ep *endpoint[ExecutionPayloadT],
+) ([]string, error) {
+	client := ep.eth1Client()
+	if client == nil {
+		return nil, ErrNotStarted
+	}
+
+	result, err := client.ExchangeCapabilities(
 		ctx, ethclient.BeaconKitSupportedCapabilities(),
 	)
 	if err != nil {
-		s.statusErrMu.Lock()
-		defer s.statusErrMu.Unlock()
-		//#nosec:G703 wtf is even this problem here.
-		s.statusErr = s.handleRPCError(err)
-		return nil, s.statusErr
+		return nil, s.handleRPCError(err)
 	}
 
 	// Capture and log the capabilities that the execution client has.
+	ep.setCapabilities(result)
 	for _, capability := range result {
-		s.logger.Info("exchanged capability", "capability", capability)
-		s.capabilities[capability] = struct{}{}
+		s.logger.Info(
+			"exchanged capability",
+			"endpoint", ep.name,
+			"capability", capability,
+		)
 	}
 
 	// Log the capabilities that the execution client does not have.
 	for _, capability := range ethclient.BeaconKitSupportedCapabilities() {
-		if _, exists := s.capabilities[capability]; !exists {
+		if !ep.hasCapability(capability) {
 			s.logger.Warn(
 				"your execution client may require an update 🚸",
+				"endpoint", ep.name,
 				"unsupported_capability", capability,
 			)
 		}
 	}
 
-	s.statusErr = nil
 	return result, nil
 }
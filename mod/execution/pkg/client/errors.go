@@ -47,6 +47,12 @@ const (
 var (
 	// ErrNotStarted indicates that the execution client is not started.
 	ErrNotStarted = errors.New("engine client is not started")
+
+	// ErrUnsupportedCapability indicates that the execution client does not
+	// support the requested Engine API method.
+	ErrUnsupportedCapability = errors.New(
+		"execution client does not support the method",
+	)
 )
 
 // Handles errors received from the RPC server according to the specification.
@@ -23,12 +23,17 @@ package ethclient
 import (
 	"context"
 	"encoding/json"
+	"math/big"
+	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 )
@@ -43,6 +48,9 @@ type Eth1Client[
 	},
 ] struct {
 	*ethclient.Client
+	// recorder records the calls made through the client, nil if they are
+	// not recorded.
+	recorder Recorder
 }
 
 // NewEth1Client creates a new Ethereum 1 client with the provided
@@ -71,6 +79,36 @@ func NewFromRPCClient[
 	return NewEth1Client[ExecutionPayloadT](ethclient.NewClient(rpcClient))
 }
 
+// WithRecorder makes the client record every call it makes with the given
+// recorder, and returns the client.
+func (s *Eth1Client[ExecutionPayloadT]) WithRecorder(
+	recorder Recorder,
+) *Eth1Client[ExecutionPayloadT] {
+	s.recorder = recorder
+	return s
+}
+
+// call calls the given JSON-RPC method and decodes its result into result,
+// recording the call if a recorder is set.
+func (s *Eth1Client[ExecutionPayloadT]) call(
+	ctx context.Context, result any, method string, params ...any,
+) error {
+	if s.recorder == nil {
+		return s.Client.Client().CallContext(ctx, result, method, params...)
+	}
+
+	var (
+		raw   json.RawMessage
+		start = time.Now()
+	)
+	err := s.Client.Client().CallContext(ctx, &raw, method, params...)
+	s.recorder.Record(ctx, method, params, raw, err, start)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, result)
+}
+
 // NewPayloadV3 calls the engine_newPayloadV3 method via JSON-RPC.
 func (s *Eth1Client[ExecutionPayloadT]) NewPayloadV3(
 	ctx context.Context,
@@ -79,7 +117,7 @@ func (s *Eth1Client[ExecutionPayloadT]) NewPayloadV3(
 	parentBlockRoot *primitives.Root,
 ) (*engineprimitives.PayloadStatusV1, error) {
 	result := &engineprimitives.PayloadStatusV1{}
-	if err := s.Client.Client().CallContext(
+	if err := s.call(
 		ctx, result, NewPayloadMethodV3, payload, versionedHashes,
 		(*common.ExecutionHash)(parentBlockRoot),
 	); err != nil {
@@ -88,6 +126,29 @@ func (s *Eth1Client[ExecutionPayloadT]) NewPayloadV3(
 	return result, nil
 }
 
+// NewPayloadV4 calls the engine_newPayloadV4 method via JSON-RPC.
+func (s *Eth1Client[ExecutionPayloadT]) NewPayloadV4(
+	ctx context.Context,
+	payload any,
+	versionedHashes []common.ExecutionHash,
+	parentBlockRoot *primitives.Root,
+	executionRequests [][]byte,
+) (*engineprimitives.PayloadStatusV1, error) {
+	requests := make([]bytes.Bytes, len(executionRequests))
+	for i, req := range executionRequests {
+		requests[i] = req
+	}
+
+	result := &engineprimitives.PayloadStatusV1{}
+	if err := s.call(
+		ctx, result, NewPayloadMethodV4, payload, versionedHashes,
+		(*common.ExecutionHash)(parentBlockRoot), requests,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // ForkchoiceUpdatedV3 calls the engine_forkchoiceUpdatedV3 method via JSON-RPC.
 func (s *Eth1Client[ExecutionPayloadT]) ForkchoiceUpdatedV3(
 	ctx context.Context,
@@ -107,7 +168,7 @@ func (s *Eth1Client[ExecutionPayloadT]) forkchoiceUpdateCall(
 ) (*engineprimitives.ForkchoiceResponseV1, error) {
 	result := &engineprimitives.ForkchoiceResponseV1{}
 
-	if err := s.Client.Client().CallContext(
+	if err := s.call(
 		ctx, result, method, state, attrs,
 	); err != nil {
 		return nil, err
@@ -134,7 +195,7 @@ func (s *Eth1Client[ExecutionPayloadT]) GetPayloadV3(
 		ExecutionPayload: t.Empty(version.Deneb),
 	}
 
-	if err := s.Client.Client().CallContext(
+	if err := s.call(
 		ctx, result, GetPayloadMethodV3, payloadID,
 	); err != nil {
 		return nil, err
@@ -143,13 +204,78 @@ func (s *Eth1Client[ExecutionPayloadT]) GetPayloadV3(
 	return result, nil
 }
 
+// GetPayloadV4 calls the engine_getPayloadV4 method via JSON-RPC.
+func (s *Eth1Client[ExecutionPayloadT]) GetPayloadV4(
+	ctx context.Context, payloadID engineprimitives.PayloadID,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	var t ExecutionPayloadT
+	result := &engineprimitives.ExecutionPayloadEnvelope[
+		ExecutionPayloadT,
+		*engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		],
+	]{
+		ExecutionPayload: t.Empty(version.Electra),
+	}
+
+	if err := s.call(
+		ctx, result, GetPayloadMethodV4, payloadID,
+	); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetPayloadBodiesByHashV1 calls the engine_getPayloadBodiesByHashV1 method
+// via JSON-RPC. The body of a payload that is unknown to the execution client
+// is nil.
+func (s *Eth1Client[ExecutionPayloadT]) GetPayloadBodiesByHashV1(
+	ctx context.Context, hashes []common.ExecutionHash,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	result := make([]*engineprimitives.ExecutionPayloadBodyV1, 0)
+	if err := s.call(
+		ctx, &result, GetPayloadBodiesByHashV1, hashes,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetPayloadBodiesByRangeV1 calls the engine_getPayloadBodiesByRangeV1
+// method via JSON-RPC. The result is truncated after the latest payload
+// known to the execution client, and the body of a payload it is missing is
+// nil.
+func (s *Eth1Client[ExecutionPayloadT]) GetPayloadBodiesByRangeV1(
+	ctx context.Context, start, count math.U64,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	result := make([]*engineprimitives.ExecutionPayloadBodyV1, 0)
+	if err := s.call(
+		ctx, &result, GetPayloadBodiesByRangeV1, start, count,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ChainID calls the eth_chainId method via JSON-RPC.
+func (s *Eth1Client[ExecutionPayloadT]) ChainID(
+	ctx context.Context,
+) (*big.Int, error) {
+	var result hexutil.Big
+	if err := s.call(ctx, &result, ChainIDMethod); err != nil {
+		return nil, err
+	}
+	return (*big.Int)(&result), nil
+}
+
 // ExecutionBlockByHash fetches an execution engine block by hash by calling
 // eth_blockByHash via JSON-RPC.
 func (s *Eth1Client[ExecutionPayloadT]) ExecutionBlockByHash(
 	ctx context.Context, hash common.ExecutionHash, withTxs bool,
 ) (*engineprimitives.Block, error) {
 	result := &engineprimitives.Block{}
-	err := s.Client.Client().CallContext(
+	err := s.call(
 		ctx, result, BlockByHashMethod, hash, withTxs)
 	return result, err
 }
@@ -160,7 +286,7 @@ func (s *Eth1Client[ExecutionPayloadT]) ExecutionBlockByNumber(
 	ctx context.Context, num rpc.BlockNumber, withTxs bool,
 ) (*engineprimitives.Block, error) {
 	result := &engineprimitives.Block{}
-	err := s.Client.Client().CallContext(
+	err := s.call(
 		ctx, result, BlockByNumberMethod, num, withTxs)
 	return result, err
 }
@@ -170,7 +296,7 @@ func (s *Eth1Client[ExecutionPayloadT]) GetClientVersionV1(
 	ctx context.Context,
 ) ([]engineprimitives.ClientVersionV1, error) {
 	result := make([]engineprimitives.ClientVersionV1, 0)
-	if err := s.Client.Client().CallContext(
+	if err := s.call(
 		ctx, &result, GetClientVersionV1, nil,
 	); err != nil {
 		return nil, err
@@ -185,7 +311,7 @@ func (s *Eth1Client[ExecutionPayloadT]) ExchangeCapabilities(
 	capabilities []string,
 ) ([]string, error) {
 	result := make([]string, 0)
-	if err := s.Client.Client().CallContext(
+	if err := s.call(
 		ctx, &result, ExchangeCapabilities, &capabilities,
 	); err != nil {
 		return nil, err
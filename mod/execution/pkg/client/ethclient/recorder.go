@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ethclient
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Recorder records the JSON-RPC calls made to the execution client.
+type Recorder interface {
+	// Record records a call of the given method with the given params. The
+	// result is the raw JSON result of the call, nil if it failed with err.
+	Record(
+		ctx context.Context,
+		method string,
+		params []any,
+		result json.RawMessage,
+		err error,
+		start time.Time,
+	)
+}
+
+// forkVersionKey is the context key of the fork version of a call.
+type forkVersionKey struct{}
+
+// WithForkVersion returns a copy of ctx that carries the fork version for
+// which the calls made with it are made, so that it is recorded alongside
+// them.
+func WithForkVersion(ctx context.Context, forkVersion uint32) context.Context {
+	return context.WithValue(ctx, forkVersionKey{}, forkVersion)
+}
+
+// ForkVersionFromContext returns the fork version carried by ctx, if any.
+func ForkVersionFromContext(ctx context.Context) (uint32, bool) {
+	forkVersion, ok := ctx.Value(forkVersionKey{}).(uint32)
+	return forkVersion, ok
+}
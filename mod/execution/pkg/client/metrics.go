@@ -47,51 +47,66 @@ func newClientMetrics(
 
 // measureForkchoiceUpdateDuration measures the duration of the forkchoice
 // update.
-func (cm *clientMetrics) measureForkchoiceUpdateDuration(startTime time.Time) {
-	// TODO: Add Labels.
+func (cm *clientMetrics) measureForkchoiceUpdateDuration(
+	startTime time.Time,
+	endpoint string,
+) {
 	cm.sink.MeasureSince(
 		"beacon_kit.execution.client.forkchoice_update_duration",
 		startTime,
+		"endpoint", endpoint,
 	)
 }
 
 // measureNewPayloadDuration measures the duration of the new payload.
-func (cm *clientMetrics) measureNewPayloadDuration(startTime time.Time) {
-	// TODO: Add Labels.
+func (cm *clientMetrics) measureNewPayloadDuration(
+	startTime time.Time,
+	endpoint string,
+) {
 	cm.sink.MeasureSince(
 		"beacon_kit.execution.client.new_payload_duration",
 		startTime,
+		"endpoint", endpoint,
 	)
 }
 
 // measureGetPayloadDuration measures the duration of the get payload.
-func (cm *clientMetrics) measureGetPayloadDuration(startTime time.Time) {
-	// TODO: Add Labels.
+func (cm *clientMetrics) measureGetPayloadDuration(
+	startTime time.Time,
+	endpoint string,
+) {
 	cm.sink.MeasureSince(
 		"beacon_kit.execution.client.get_payload_duration",
 		startTime,
+		"endpoint", endpoint,
 	)
 }
 
 // incrementForkchoiceUpdateTimeout increments the timeout counter
 // for forkchoice update.
-func (cm *clientMetrics) incrementForkchoiceUpdateTimeout() {
+func (cm *clientMetrics) incrementForkchoiceUpdateTimeout(endpoint string) {
 	cm.incrementTimeoutCounter(
-		"beacon_kit.execution.client.forkchoice_update_duration")
+		"beacon_kit.execution.client.forkchoice_update_duration",
+		"endpoint", endpoint,
+	)
 }
 
 // incrementNewPayloadTimeout increments the timeout counter for
 // new payload.
-func (cm *clientMetrics) incrementNewPayloadTimeout() {
+func (cm *clientMetrics) incrementNewPayloadTimeout(endpoint string) {
 	cm.incrementTimeoutCounter(
-		"beacon_kit.execution.client.new_payload_duration")
+		"beacon_kit.execution.client.new_payload_duration",
+		"endpoint", endpoint,
+	)
 }
 
 // incrementGetPayloadTimeout increments the timeout counter for
 // get payload.
-func (cm *clientMetrics) incrementGetPayloadTimeout() {
+func (cm *clientMetrics) incrementGetPayloadTimeout(endpoint string) {
 	cm.incrementTimeoutCounter(
-		"beacon_kit.execution.client.get_payload_duration")
+		"beacon_kit.execution.client.get_payload_duration",
+		"endpoint", endpoint,
+	)
 }
 
 // incrementHTTPTimeout increments the timeout counter for HTTP.
@@ -101,8 +116,34 @@ func (cm *clientMetrics) incrementHTTPTimeoutCounter() {
 
 // incrementTimeoutCounter increments the timeout counter for
 // the given metric.
-func (cm *clientMetrics) incrementTimeoutCounter(metricName string) {
-	cm.sink.IncrementCounter(metricName + "_timeout")
+func (cm *clientMetrics) incrementTimeoutCounter(
+	metricName string,
+	args ...string,
+) {
+	cm.sink.IncrementCounter(metricName+"_timeout", args...)
+}
+
+// setEndpointHealth records whether the given endpoint is healthy.
+func (cm *clientMetrics) setEndpointHealth(endpoint string, healthy bool) {
+	var value int64
+	if healthy {
+		value = 1
+	}
+	cm.sink.SetGauge(
+		"beacon_kit.execution.client.endpoint_healthy",
+		value,
+		"endpoint", endpoint,
+	)
+}
+
+// incrementFailover increments the counter of failovers from one endpoint
+// to another.
+func (cm *clientMetrics) incrementFailover(from, to string) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.execution.client.failover",
+		"from", from,
+		"to", to,
+	)
 }
 
 // incrementParseErrorCounter increments the parse error counter
@@ -0,0 +1,292 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package recorder
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/log"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// filePrefix is the prefix of the names of the recording files.
+	filePrefix = "engine-"
+	// fileSuffix is the suffix of the names of the recording files.
+	fileSuffix = ".jsonl"
+)
+
+// Entry is a single recorded JSON-RPC call to the execution client.
+type Entry struct {
+	// Time is the time at which the call was made.
+	Time time.Time `json:"time"`
+	// Duration is the time it took the execution client to answer.
+	Duration time.Duration `json:"duration"`
+	// Endpoint is the name of the endpoint the call was made to.
+	Endpoint string `json:"endpoint"`
+	// ForkVersion is the fork version the call was made for, if known.
+	ForkVersion *uint32 `json:"forkVersion,omitempty"`
+	// Method is the JSON-RPC method of the call.
+	Method string `json:"method"`
+	// Params are the JSON encoded params of the call.
+	Params json.RawMessage `json:"params"`
+	// Result is the JSON encoded result of the call, empty if it failed.
+	Result json.RawMessage `json:"result,omitempty"`
+	// Error is the error the call failed with, nil if it succeeded.
+	Error *Error `json:"error,omitempty"`
+}
+
+// Error is a recorded JSON-RPC error.
+type Error struct {
+	// Code is the JSON-RPC error code, zero for errors that did not come
+	// from the execution client, e.g. timeouts.
+	Code int `json:"code,omitempty"`
+	// Message is the error message.
+	Message string `json:"message"`
+	// Data is the data attached to the error, if any.
+	Data any `json:"data,omitempty"`
+}
+
+// Recorder writes every call made to the execution client as a JSON line
+// to a log in a directory. The log is rotated once its current file
+// exceeds the maximum size, and only the most recent files are kept.
+type Recorder struct {
+	// dir is the directory of the log.
+	dir string
+	// maxFileSize is the size in bytes after which a file is rotated.
+	maxFileSize uint64
+	// maxFiles is the number of files that are kept, zero keeps all.
+	maxFiles int
+	// logger is the logger of the recorder.
+	logger log.Logger[any]
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// file is the file that is currently written to, nil once closed.
+	file *os.File
+	// w buffers the writes to file.
+	w *bufio.Writer
+	// size is the number of bytes written to file.
+	size uint64
+}
+
+// New creates a new recorder that writes to the given directory, creating
+// it if needed.
+func New(
+	dir string,
+	maxFileSize uint64,
+	maxFiles int,
+	logger log.Logger[any],
+) (*Recorder, error) {
+	//#nosec:G301 // the recordings are not secret.
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	r := &Recorder{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+		logger:      logger,
+	}
+	return r, r.rotate()
+}
+
+// ForEndpoint returns a recorder for the calls made to the endpoint with the
+// given name.
+func (r *Recorder) ForEndpoint(name string) ethclient.Recorder {
+	return &endpointRecorder{Recorder: r, endpoint: name}
+}
+
+// Close flushes and closes the log. Calls recorded afterwards are dropped.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.closeFile()
+}
+
+// write appends the given entry to the log.
+func (r *Recorder) write(entry *Entry) {
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		r.logger.Error(
+			"failed to encode engine API call", "method", entry.Method,
+			"error", err,
+		)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		return
+	}
+
+	// Every entry is flushed, so that the log is complete even if the node
+	// crashes right after a faulty call.
+	if _, err = r.w.Write(append(bz, '\n')); err == nil {
+		err = r.w.Flush()
+	}
+	if err != nil {
+		r.logger.Error(
+			"failed to record engine API call", "method", entry.Method,
+			"error", err,
+		)
+		return
+	}
+
+	r.size += uint64(len(bz) + 1)
+	if r.maxFileSize > 0 && r.size >= r.maxFileSize {
+		if err = r.rotate(); err != nil {
+			r.logger.Error("failed to rotate engine API recording",
+				"error", err,
+			)
+		}
+	}
+}
+
+// rotate closes the current file, starts a new one and removes the oldest
+// files that exceed the number of files to keep.
+func (r *Recorder) rotate() error {
+	if err := r.closeFile(); err != nil {
+		return err
+	}
+
+	// The names sort in the order the files were created in.
+	name := filepath.Join(r.dir, fmt.Sprintf(
+		"%s%020d%s", filePrefix, time.Now().UnixNano(), fileSuffix,
+	))
+	//#nosec:G302,G304 // the recordings are not secret.
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	r.file, r.w, r.size = f, bufio.NewWriter(f), 0
+
+	if r.maxFiles <= 0 {
+		return nil
+	}
+	files, err := Files(r.dir)
+	if err != nil {
+		return err
+	}
+	for len(files) > r.maxFiles {
+		if err = os.Remove(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+// closeFile flushes and closes the current file, if any.
+func (r *Recorder) closeFile() error {
+	if r.file == nil {
+		return nil
+	}
+	err := r.w.Flush()
+	if cErr := r.file.Close(); err == nil {
+		err = cErr
+	}
+	r.file, r.w = nil, nil
+	return err
+}
+
+// Files returns the paths of the recording files in the given directory,
+// oldest first.
+func Files(dir string) ([]string, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0, len(dirEntries))
+	for _, e := range dirEntries {
+		if !e.IsDir() && strings.HasPrefix(e.Name(), filePrefix) &&
+			strings.HasSuffix(e.Name(), fileSuffix) {
+			files = append(files, filepath.Join(dir, e.Name()))
+		}
+	}
+	return files, nil
+}
+
+// endpointRecorder records the calls made to a single endpoint.
+type endpointRecorder struct {
+	*Recorder
+	// endpoint is the name of the endpoint.
+	endpoint string
+}
+
+// Record records a call made to the endpoint.
+func (r *endpointRecorder) Record(
+	ctx context.Context,
+	method string,
+	params []any,
+	result json.RawMessage,
+	err error,
+	start time.Time,
+) {
+	entry := &Entry{
+		Time:     start,
+		Duration: time.Since(start),
+		Endpoint: r.endpoint,
+		Method:   method,
+		Result:   result,
+	}
+	if forkVersion, ok := ethclient.ForkVersionFromContext(ctx); ok {
+		entry.ForkVersion = &forkVersion
+	}
+
+	if params == nil {
+		params = []any{}
+	}
+	var mErr error
+	if entry.Params, mErr = json.Marshal(params); mErr != nil {
+		r.logger.Error(
+			"failed to encode engine API call params", "method", method,
+			"error", mErr,
+		)
+		return
+	}
+
+	if err != nil {
+		entry.Result = nil
+		entry.Error = &Error{Message: err.Error()}
+		//nolint:errorlint // the rpc errors are not wrapped.
+		if rpcErr, ok := err.(ethrpc.Error); ok {
+			entry.Error.Code = rpcErr.ErrorCode()
+		}
+		//nolint:errorlint // the rpc errors are not wrapped.
+		if dataErr, ok := err.(ethrpc.DataError); ok {
+			entry.Error.Data = dataErr.ErrorData()
+		}
+	}
+	r.write(entry)
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package recorder_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/recorder"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder_Rotate(t *testing.T) {
+	dir := t.TempDir()
+	r, err := recorder.New(dir, 256, 2, noop.NewLogger())
+	require.NoError(t, err)
+
+	rec := r.ForEndpoint("primary")
+	for i := range 10 {
+		rec.Record(
+			context.Background(), "engine_test",
+			[]any{i}, json.RawMessage(`"0x1"`), nil, time.Now(),
+		)
+	}
+	rec.Record(
+		context.Background(), "engine_fail",
+		nil, nil, errors.New("timeout"), time.Now(),
+	)
+	require.NoError(t, r.Close())
+
+	files, err := recorder.Files(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	entries, err := recorder.Load(dir)
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	require.Less(t, len(entries), 11)
+
+	last := entries[len(entries)-1]
+	require.Equal(t, "primary", last.Endpoint)
+	require.Equal(t, "engine_fail", last.Method)
+	require.NotNil(t, last.Error)
+	require.Equal(t, "timeout", last.Error.Message)
+	require.Nil(t, last.ForkVersion)
+
+}
+
+func TestReplayer(t *testing.T) {
+	ctx := context.Background()
+	replayer := recorder.NewReplayer([]*recorder.Entry{
+		{
+			Endpoint: "primary",
+			Method:   "engine_test",
+			Params:   json.RawMessage(`[1]`),
+			Result:   json.RawMessage(`"0x1"`),
+		},
+		{
+			Endpoint: "primary",
+			Method:   "engine_test",
+			Params:   json.RawMessage(`[2]`),
+			Result:   json.RawMessage(`"0x2"`),
+		},
+		{
+			Endpoint: "primary",
+			Method:   "engine_fail",
+			Error:    &recorder.Error{Message: "timeout"},
+		},
+		{
+			Endpoint: "failover-1",
+			Method:   "engine_other",
+			Result:   json.RawMessage(`"0x3"`),
+		},
+	}, "primary")
+	c, err := replayer.RPCClient(ctx)
+	require.NoError(t, err)
+
+	// Calls are answered by the response recorded for the same params.
+	var result string
+	require.NoError(t, c.CallContext(ctx, &result, "engine_test", 2))
+	require.Equal(t, "0x2", result)
+	require.NoError(t, c.CallContext(ctx, &result, "engine_test", 1))
+	require.Equal(t, "0x1", result)
+	// Once replayed, the last response is replayed again.
+	require.NoError(t, c.CallContext(ctx, &result, "engine_test", 3))
+	require.Equal(t, "0x1", result)
+
+	err = c.CallContext(ctx, &result, "engine_fail")
+	require.ErrorContains(t, err, "timeout")
+
+	// Calls made to other endpoints are not replayed.
+	err = c.CallContext(ctx, &result, "engine_other")
+	require.ErrorContains(t, err, "no recorded response")
+	require.Empty(t, replayer.Remaining())
+}
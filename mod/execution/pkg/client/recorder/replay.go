@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package recorder
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// maxEntrySize is the maximum size of a recorded entry, large enough for
+	// payloads with a full set of blobs.
+	maxEntrySize = 64 << 20
+	// replayURL is the URL under which the in-process replayer is dialed.
+	replayURL = "http://replay.invalid"
+)
+
+const (
+	// methodNotFoundCode is the JSON-RPC error code of calls that have no
+	// recorded response left.
+	methodNotFoundCode = -32601
+	// internalErrorCode is the JSON-RPC error code of recorded calls that
+	// failed without a code, e.g. because they timed out.
+	internalErrorCode = -32603
+)
+
+// Load reads the entries of every recording file in the given directory,
+// oldest first.
+func Load(dir string) ([]*Entry, error) {
+	files, err := Files(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*Entry
+	for _, file := range files {
+		fileEntries, fErr := ReadFile(file)
+		if fErr != nil {
+			return nil, fErr
+		}
+		entries = append(entries, fileEntries...)
+	}
+	return entries, nil
+}
+
+// ReadFile reads the entries of the given recording file.
+func ReadFile(path string) ([]*Entry, error) {
+	//#nosec:G304 // reading the given recording is intended.
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		entries []*Entry
+		scanner = bufio.NewScanner(f)
+	)
+	scanner.Buffer(nil, maxEntrySize)
+	for scanner.Scan() {
+		entry := new(Entry)
+		if err = json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+// Replayer is a fake execution client that answers JSON-RPC calls with the
+// responses of a recording, so that the behaviour of an execution client
+// can be reproduced offline. A call is answered with the first response
+// not replayed yet that was recorded for the same method and params, or
+// else for the same method. Once every response of a method was replayed,
+// the last one is replayed again, which keeps periodic calls such as the
+// health checks answered.
+type Replayer struct {
+	// mu protects the fields below.
+	mu sync.Mutex
+	// entries are the recorded entries that were not replayed yet.
+	entries []*Entry
+	// last are the last replayed entries, by method.
+	last map[string]*Entry
+}
+
+// NewReplayer creates a new replayer of the given entries. If an endpoint
+// is given, only the calls that were made to it are replayed.
+func NewReplayer(entries []*Entry, endpoint string) *Replayer {
+	r := &Replayer{
+		entries: make([]*Entry, 0, len(entries)),
+		last:    make(map[string]*Entry),
+	}
+	for _, entry := range entries {
+		if endpoint == "" || entry.Endpoint == endpoint {
+			r.entries = append(r.entries, entry)
+		}
+	}
+	return r
+}
+
+// Remaining returns the recorded entries that were not replayed yet.
+func (r *Replayer) Remaining() []*Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*Entry(nil), r.entries...)
+}
+
+// RPCClient returns a new JSON-RPC client that is connected to the replayer
+// in-process.
+func (r *Replayer) RPCClient(ctx context.Context) (*ethrpc.Client, error) {
+	return ethrpc.DialOptions(ctx, replayURL, ethrpc.WithHTTPClient(
+		&http.Client{Transport: handlerTransport{r}},
+	))
+}
+
+// ServeHTTP answers a JSON-RPC call with its recorded response.
+func (r *Replayer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var call struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(req.Body).Decode(&call); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp := map[string]any{"jsonrpc": "2.0", "id": call.ID}
+	switch entry := r.next(call.Method, call.Params); {
+	case entry == nil:
+		resp["error"] = &Error{
+			Code:    methodNotFoundCode,
+			Message: "no recorded response for " + call.Method,
+		}
+	case entry.Error != nil:
+		rpcErr := *entry.Error
+		if rpcErr.Code == 0 {
+			rpcErr.Code = internalErrorCode
+		}
+		resp["error"] = &rpcErr
+	default:
+		resp["result"] = entry.Result
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// next removes and returns the entry that answers a call of the given
+// method with the given params, nil if none was recorded.
+func (r *Replayer) next(method string, params json.RawMessage) *Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	match := -1
+	for i, entry := range r.entries {
+		if entry.Method != method {
+			continue
+		} else if equalJSON(entry.Params, params) {
+			match = i
+			break
+		} else if match < 0 {
+			match = i
+		}
+	}
+	if match < 0 {
+		return r.last[method]
+	}
+
+	entry := r.entries[match]
+	r.entries = append(r.entries[:match], r.entries[match+1:]...)
+	r.last[method] = entry
+	return entry
+}
+
+// equalJSON returns true if a and b are equal JSON encodings, ignoring
+// insignificant whitespace.
+func equalJSON(a, b json.RawMessage) bool {
+	var ca, cb bytes.Buffer
+	if json.Compact(&ca, a) != nil || json.Compact(&cb, b) != nil {
+		return false
+	}
+	return bytes.Equal(ca.Bytes(), cb.Bytes())
+}
+
+// handlerTransport is an http.RoundTripper that serves the requests with a
+// handler in-process.
+type handlerTransport struct {
+	http.Handler
+}
+
+// RoundTrip serves the request with the handler.
+func (t handlerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	w := httptest.NewRecorder()
+	t.ServeHTTP(w, req)
+	return w.Result(), nil
+}
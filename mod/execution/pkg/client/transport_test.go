@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// testChainID is the chain ID served by the stand-in execution client.
+	testChainID = 80087
+	// testPayloadTxs is the number of transactions in the test payload.
+	testPayloadTxs = 128
+	// testTxSize is the size of every transaction in the test payload.
+	testTxSize = 256
+)
+
+// transports maps the name of every transport to a function that serves
+// the stand-in execution client over it and returns its dial URL.
+//
+//nolint:gochecknoglobals // test table.
+var transports = []struct {
+	name  string
+	serve func(testing.TB, *ethrpc.Server) string
+}{
+	{"http", serveHTTP},
+	{"ws", serveWS},
+	{"ipc", serveIPC},
+}
+
+func TestEngineClient_Transports(t *testing.T) {
+	for _, tr := range transports {
+		t.Run(tr.name, func(t *testing.T) {
+			ec := newTestEngineClient(t, tr.serve(t, newStandInServer(t)))
+
+			chainID, err := ec.ChainID(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, uint64(testChainID), chainID.Uint64())
+
+			hash, err := ec.NewPayload(
+				context.Background(), newTestPayload(),
+				nil, &primitives.Root{}, nil,
+			)
+			require.NoError(t, err)
+			require.Equal(t, common.ExecutionHash{1}, *hash)
+		})
+	}
+}
+
+func BenchmarkNewPayload(b *testing.B) {
+	for _, tr := range transports {
+		b.Run(tr.name, func(b *testing.B) {
+			ec := newTestEngineClient(b, tr.serve(b, newStandInServer(b)))
+			payload := newTestPayload()
+			b.ResetTimer()
+			for range b.N {
+				_, err := ec.NewPayload(
+					context.Background(), payload,
+					nil, &primitives.Root{}, nil,
+				)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+// newTestEngineClient starts an engine client connected to the given URL.
+func newTestEngineClient(
+	tb testing.TB,
+	dialURL string,
+) *client.EngineClient[*testPayload] {
+	tb.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	tb.Cleanup(cancel)
+
+	connectionURL, err := url.NewFromRaw(dialURL)
+	require.NoError(tb, err)
+	secret, err := jwt.NewRandom()
+	require.NoError(tb, err)
+
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL = connectionURL
+	cfg.RPCStartupCheckInterval = 10 * time.Millisecond
+	ec := client.New[*testPayload](
+		&cfg,
+		noop.NewLogger(),
+		secret,
+		nil,
+		noopTelemetrySink{},
+		big.NewInt(testChainID),
+	)
+	require.NoError(tb, ec.Start(ctx))
+	return ec
+}
+
+// serveHTTP serves the server over HTTP.
+func serveHTTP(tb testing.TB, srv *ethrpc.Server) string {
+	tb.Helper()
+	ts := httptest.NewServer(srv)
+	tb.Cleanup(ts.Close)
+	return ts.URL
+}
+
+// serveWS serves the server over a websocket.
+func serveWS(tb testing.TB, srv *ethrpc.Server) string {
+	tb.Helper()
+	ts := httptest.NewServer(srv.WebsocketHandler([]string{"*"}))
+	tb.Cleanup(ts.Close)
+	return "ws://" + strings.TrimPrefix(ts.URL, "http://")
+}
+
+// serveIPC serves the server over a unix socket.
+func serveIPC(tb testing.TB, srv *ethrpc.Server) string {
+	tb.Helper()
+	path := filepath.Join(tb.TempDir(), "engine.ipc")
+	listener, err := net.Listen("unix", path)
+	require.NoError(tb, err)
+	tb.Cleanup(func() { listener.Close() })
+	go func() { _ = srv.ServeListener(listener) }()
+	return "ipc://" + path
+}
+
+// newStandInServer creates a JSON-RPC server that stands in for an
+// execution client.
+func newStandInServer(tb testing.TB) *ethrpc.Server {
+	tb.Helper()
+	srv := ethrpc.NewServer()
+	tb.Cleanup(srv.Stop)
+	require.NoError(tb, srv.RegisterName("eth", new(standInEthAPI)))
+	require.NoError(tb, srv.RegisterName("engine", new(standInEngineAPI)))
+	return srv
+}
+
+// standInEthAPI is the eth namespace of the stand-in execution client.
+type standInEthAPI struct{}
+
+// ChainId returns the chain ID of the stand-in execution client.
+//
+//nolint:revive,stylecheck // must match the JSON-RPC method name.
+func (standInEthAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(testChainID))
+}
+
+// standInEngineAPI is the engine namespace of the stand-in execution client.
+type standInEngineAPI struct{}
+
+// ExchangeCapabilities returns every capability supported by BeaconKit.
+func (standInEngineAPI) ExchangeCapabilities(json.RawMessage) []string {
+	return ethclient.BeaconKitSupportedCapabilities()
+}
+
+// NewPayloadV3 accepts every payload as valid.
+func (standInEngineAPI) NewPayloadV3(
+	json.RawMessage, json.RawMessage, json.RawMessage,
+) *engineprimitives.PayloadStatusV1 {
+	return &engineprimitives.PayloadStatusV1{
+		Status:          engineprimitives.PayloadStatusValid,
+		LatestValidHash: &common.ExecutionHash{1},
+	}
+}
+
+// testPayload is an execution payload of a realistic size whose contents
+// are irrelevant to the stand-in execution client.
+type testPayload struct {
+	raw json.RawMessage
+}
+
+// newTestPayload creates a new test payload.
+func newTestPayload() *testPayload {
+	txs := make([]hexutil.Bytes, testPayloadTxs)
+	for i := range txs {
+		txs[i] = make(hexutil.Bytes, testTxSize)
+	}
+	raw, err := json.Marshal(map[string]any{
+		"blockNumber":  hexutil.Uint64(1),
+		"transactions": txs,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &testPayload{raw: raw}
+}
+
+// Empty returns an empty test payload.
+func (*testPayload) Empty(uint32) *testPayload {
+	return &testPayload{}
+}
+
+// Version returns the version of the test payload.
+func (*testPayload) Version() uint32 {
+	return version.Deneb
+}
+
+// MarshalJSON returns the JSON encoding of the test payload.
+func (p *testPayload) MarshalJSON() ([]byte, error) {
+	return p.raw, nil
+}
+
+// UnmarshalJSON sets the JSON encoding of the test payload.
+func (p *testPayload) UnmarshalJSON(raw []byte) error {
+	p.raw = append(p.raw[:0], raw...)
+	return nil
+}
+
+// noopTelemetrySink is a telemetry sink that discards every metric.
+type noopTelemetrySink struct{}
+
+func (noopTelemetrySink) IncrementCounter(string, ...string)        {}
+func (noopTelemetrySink) SetGauge(string, int64, ...string)         {}
+func (noopTelemetrySink) MeasureSince(string, time.Time, ...string) {}
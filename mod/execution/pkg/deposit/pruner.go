@@ -21,10 +21,11 @@
 package deposit
 
 import (
-	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// BuildPruneRangeFn builds a function that returns the [start, end) range of
+// slots whose deposits to prune for a finalized block. See PruneRange.
 func BuildPruneRangeFn[
 	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
 	BeaconBlockT BeaconBlock[DepositT, BeaconBlockBodyT, ExecutionPayloadT],
@@ -36,20 +37,22 @@ func BuildPruneRangeFn[
 		GetNumber() math.U64
 	},
 	WithdrawalCredentialsT any,
-
-](cs primitives.ChainSpec) func(BlockEventT) (uint64, uint64) {
+](
+	retentionSlots uint64,
+) func(BlockEventT) (uint64, uint64) {
 	return func(event BlockEventT) (uint64, uint64) {
-		deposits := event.Data().GetBody().GetDeposits()
-		if len(deposits) == 0 {
-			return 0, 0
-		}
-		index := deposits[len(deposits)-1].GetIndex()
-
-		end := min(index, cs.MaxDepositsPerBlock())
-		if index < cs.MaxDepositsPerBlock() {
-			return 0, end
-		}
+		return PruneRange(retentionSlots, event.Data().GetSlot())
+	}
+}
 
-		return index - cs.MaxDepositsPerBlock(), end
+// PruneRange returns the [start, end) range of slots whose deposits to prune
+// given the current head slot. The deposits processed by the blocks of the
+// last retentionSlots slots are retained, or of the head block only if it
+// is zero.
+func PruneRange(retentionSlots uint64, head math.Slot) (uint64, uint64) {
+	retentionSlots = max(retentionSlots, 1)
+	if head.Unwrap()+1 < retentionSlots {
+		return 0, 0
 	}
+	return 0, head.Unwrap() + 1 - retentionSlots
 }
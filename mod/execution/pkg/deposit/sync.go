@@ -22,8 +22,10 @@ package deposit
 
 import (
 	"context"
+	"math/big"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -97,11 +99,37 @@ func (s *Service[
 		)
 	}
 
+	if len(deposits) == 0 {
+		delete(s.failedBlocks, blockNum)
+		return
+	}
+
+	block, err := s.ethclient.BlockByNumber(
+		ctx, new(big.Int).SetUint64(blockNum.Unwrap()),
+	)
+	if err != nil {
+		s.logger.Error("Failed to get block", "block", blockNum, "error", err)
+		s.failedBlocks[blockNum] = struct{}{}
+		return
+	}
+
 	if err = s.ds.EnqueueDeposits(deposits); err != nil {
 		s.logger.Error("Failed to store deposits", "error", err)
 		s.failedBlocks[blockNum] = struct{}{}
 		return
 	}
 
+	// Record the block the deposits were included in, so that the deposit
+	// tree snapshot can be tied to it.
+	if err = s.ds.SetExecutionBlock(
+		deposits[len(deposits)-1].GetIndex(),
+		common.ExecutionHash(block.Hash()),
+		blockNum,
+	); err != nil {
+		s.logger.Error("Failed to store deposit block", "error", err)
+		s.failedBlocks[blockNum] = struct{}{}
+		return
+	}
+
 	delete(s.failedBlocks, blockNum)
 }
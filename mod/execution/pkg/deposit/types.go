@@ -25,6 +25,7 @@ import (
 	"math/big"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -109,9 +110,14 @@ type EthClient interface {
 // Store defines the interface for managing deposit operations.
 type Store[DepositT any] interface {
 	// Prune prunes the deposit store of [start, end)
-	Prune(index uint64, numPrune uint64) error
+	Prune(start uint64, end uint64) error
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
+	// SetExecutionBlock records the execution block whose deposits end with
+	// the deposit of the given index.
+	SetExecutionBlock(
+		index uint64, hash common.ExecutionHash, height math.U64,
+	) error
 }
 
 type StorageBackend[
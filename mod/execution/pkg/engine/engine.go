@@ -22,6 +22,7 @@ package engine
 
 import (
 	"context"
+	"math/big"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
@@ -94,6 +95,24 @@ func (ee *Engine[ExecutionPayloadT]) GetPayload(
 	)
 }
 
+// HeaderByHash returns the header of the execution block with the given
+// hash.
+func (ee *Engine[ExecutionPayloadT]) HeaderByHash(
+	ctx context.Context,
+	hash common.ExecutionHash,
+) (*engineprimitives.Header, error) {
+	return ee.ec.HeaderByHash(ctx, hash)
+}
+
+// HeaderByNumber returns the header of the execution block with the given
+// number, or of the latest execution block if the number is nil.
+func (ee *Engine[ExecutionPayloadT]) HeaderByNumber(
+	ctx context.Context,
+	number *big.Int,
+) (*engineprimitives.Header, error) {
+	return ee.ec.HeaderByNumber(ctx, number)
+}
+
 // NotifyForkchoiceUpdate notifies the execution client of a forkchoice update.
 func (ee *Engine[ExecutionPayloadT]) NotifyForkchoiceUpdate(
 	ctx context.Context,
@@ -192,6 +211,7 @@ func (ee *Engine[ExecutionPayloadT]) VerifyAndNotifyNewPayload(
 		req.ExecutionPayload,
 		req.VersionedHashes,
 		req.ParentBeaconBlockRoot,
+		req.ExecutionRequests,
 	)
 
 	// We abstract away some of the complexity and categorize status codes
@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mock
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+const (
+	// genesisGasLimit is the gas limit of every block of the chain.
+	genesisGasLimit = 30_000_000
+	// depositEventName is the name of the deposit event of the deposit
+	// contract.
+	depositEventName = "Deposit"
+)
+
+// Chain is an in-memory execution chain that can be shared by the execution
+// clients of several nodes. It holds every block that was imported by any of
+// them, together with the logs of the deposit contract.
+type Chain struct {
+	// chainID is the chain ID of the chain.
+	chainID *big.Int
+	// depositContract is the address of the deposit contract.
+	depositContract common.ExecutionAddress
+	// depositEvent is the deposit event of the deposit contract ABI.
+	depositEvent abi.Event
+	// genesis is the genesis block of the chain.
+	genesis *types.Block
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// blocks maps the hash of every imported block to the block.
+	blocks map[common.ExecutionHash]*types.Block
+	// logs maps the hash of every imported block to its logs.
+	logs map[common.ExecutionHash][]*types.Log
+	// pendingDeposits are the deposits that are emitted by the next block
+	// that is imported.
+	pendingDeposits [][]byte
+	// depositCount is the number of deposits made so far.
+	depositCount uint64
+}
+
+// NewChain creates a new chain with a genesis block at the given time.
+func NewChain(
+	chainID uint64,
+	depositContract common.ExecutionAddress,
+	genesisTime uint64,
+) (*Chain, error) {
+	contractABI, err := deposit.BeaconDepositContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		zero       uint64
+		zeroHash   common.ExecutionHash
+		withdrawal = types.EmptyWithdrawalsHash
+	)
+	genesis := types.NewBlockWithHeader(&types.Header{
+		UncleHash:        types.EmptyUncleHash,
+		Root:             types.EmptyRootHash,
+		TxHash:           types.EmptyTxsHash,
+		ReceiptHash:      types.EmptyReceiptsHash,
+		Difficulty:       new(big.Int),
+		Number:           new(big.Int),
+		GasLimit:         genesisGasLimit,
+		Time:             genesisTime,
+		BaseFee:          big.NewInt(params.InitialBaseFee),
+		WithdrawalsHash:  &withdrawal,
+		BlobGasUsed:      &zero,
+		ExcessBlobGas:    &zero,
+		ParentBeaconRoot: &zeroHash,
+	}).WithBody(types.Body{Withdrawals: types.Withdrawals{}})
+
+	return &Chain{
+		chainID:         new(big.Int).SetUint64(chainID),
+		depositContract: depositContract,
+		depositEvent:    contractABI.Events[depositEventName],
+		genesis:         genesis,
+		blocks: map[common.ExecutionHash]*types.Block{
+			genesis.Hash(): genesis,
+		},
+		logs: make(map[common.ExecutionHash][]*types.Log),
+	}, nil
+}
+
+// Genesis returns the genesis block of the chain.
+func (c *Chain) Genesis() *types.Block {
+	return c.genesis
+}
+
+// AddDeposit makes the deposit contract emit a deposit event in the next
+// block that is imported.
+func (c *Chain) AddDeposit(
+	pubkey crypto.BLSPubkey,
+	credentials [32]byte,
+	amount math.Gwei,
+	signature crypto.BLSSignature,
+) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := c.depositEvent.Inputs.NonIndexed().Pack(
+		pubkey[:], credentials[:], uint64(amount), signature[:],
+		c.depositCount,
+	)
+	if err != nil {
+		return err
+	}
+	c.pendingDeposits = append(c.pendingDeposits, data)
+	c.depositCount++
+	return nil
+}
+
+// Block returns the block with the given hash.
+func (c *Chain) Block(hash common.ExecutionHash) (*types.Block, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	block, ok := c.blocks[hash]
+	return block, ok
+}
+
+// insert imports the block into the chain. The pending deposits are emitted
+// by the block if it was not imported before.
+func (c *Chain) insert(block *types.Block) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.blocks[block.Hash()]; ok {
+		return
+	}
+	c.blocks[block.Hash()] = block
+
+	logs := make([]*types.Log, len(c.pendingDeposits))
+	for i, data := range c.pendingDeposits {
+		logs[i] = &types.Log{
+			Address:     c.depositContract,
+			Topics:      []common.ExecutionHash{c.depositEvent.ID},
+			Data:        data,
+			BlockNumber: block.NumberU64(),
+			BlockHash:   block.Hash(),
+			Index:       uint(i),
+		}
+	}
+	c.logs[block.Hash()] = logs
+	c.pendingDeposits = nil
+}
+
+// blockLogs returns the logs emitted by the block with the given hash.
+func (c *Chain) blockLogs(hash common.ExecutionHash) []*types.Log {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.logs[hash]
+}
+
+// buildBlock builds an empty child block of the parent.
+func (c *Chain) buildBlock(
+	parent *types.Block,
+	attrs *engine.PayloadAttributes,
+) *types.Block {
+	var (
+		zero            uint64
+		withdrawalsHash = types.DeriveSha(
+			types.Withdrawals(attrs.Withdrawals), trie.NewStackTrie(nil),
+		)
+		beaconRoot common.ExecutionHash
+	)
+	if attrs.BeaconRoot != nil {
+		beaconRoot = *attrs.BeaconRoot
+	}
+	return types.NewBlockWithHeader(&types.Header{
+		ParentHash:       parent.Hash(),
+		UncleHash:        types.EmptyUncleHash,
+		Coinbase:         attrs.SuggestedFeeRecipient,
+		Root:             parent.Root(),
+		TxHash:           types.EmptyTxsHash,
+		ReceiptHash:      types.EmptyReceiptsHash,
+		Difficulty:       new(big.Int),
+		Number:           new(big.Int).Add(parent.Number(), big.NewInt(1)),
+		GasLimit:         parent.GasLimit(),
+		Time:             attrs.Timestamp,
+		BaseFee:          parent.BaseFee(),
+		MixDigest:        attrs.Random,
+		WithdrawalsHash:  &withdrawalsHash,
+		BlobGasUsed:      &zero,
+		ExcessBlobGas:    &zero,
+		ParentBeaconRoot: &beaconRoot,
+	}).WithBody(types.Body{Withdrawals: attrs.Withdrawals})
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mock
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// Fault describes how the execution client misbehaves when a method is
+// called.
+type Fault struct {
+	// Status, if set, is returned as the payload status of
+	// engine_newPayloadVX and engine_forkchoiceUpdatedVX instead of
+	// processing the call.
+	Status string
+	// Delay is the time the execution client stalls before it answers.
+	Delay time.Duration
+	// Err, if set, is returned as the JSON-RPC error of the call.
+	Err error
+}
+
+// fault is a fault that is injected for a number of calls.
+type fault struct {
+	Fault
+	// remaining is the number of calls the fault still applies to, a
+	// negative number applies it to every call.
+	remaining int
+}
+
+// ExecutionClient is an in-process execution client that implements the
+// subset of the Engine API and the eth namespace that BeaconKit relies on.
+// Payloads are empty blocks built on top of a Chain that may be shared by
+// the execution clients of several nodes, which makes the behaviour of the
+// execution layer fully deterministic in tests.
+type ExecutionClient struct {
+	// chain is the chain the execution client builds on.
+	chain *Chain
+	// server is the JSON-RPC server of the execution client.
+	server *ethrpc.Server
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// head is the hash of the head block of the forkchoice.
+	head common.ExecutionHash
+	// safe is the hash of the safe block of the forkchoice.
+	safe common.ExecutionHash
+	// finalized is the hash of the finalized block of the forkchoice.
+	finalized common.ExecutionHash
+	// payloads are the payloads that were built, by their ID.
+	payloads map[engine.PayloadID]*types.Block
+	// faults are the faults that are injected, by JSON-RPC method name.
+	faults map[string]*fault
+}
+
+// New creates a new execution client that builds on the given chain. Its
+// forkchoice starts at the genesis block of the chain.
+func New(chain *Chain) (*ExecutionClient, error) {
+	genesis := chain.Genesis().Hash()
+	c := &ExecutionClient{
+		chain:     chain,
+		server:    ethrpc.NewServer(),
+		head:      genesis,
+		safe:      genesis,
+		finalized: genesis,
+		payloads:  make(map[engine.PayloadID]*types.Block),
+		faults:    make(map[string]*fault),
+	}
+	if err := c.server.RegisterName(
+		engineNamespace, &engineAPI{c},
+	); err != nil {
+		return nil, err
+	}
+	if err := c.server.RegisterName(ethNamespace, &ethAPI{c}); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// RPCClient returns a new JSON-RPC client that is connected to the
+// execution client in-process.
+func (c *ExecutionClient) RPCClient() *ethrpc.Client {
+	return ethrpc.DialInProc(c.server)
+}
+
+// Close stops the JSON-RPC server of the execution client.
+func (c *ExecutionClient) Close() {
+	c.server.Stop()
+}
+
+// Head returns the hash of the head block of the forkchoice.
+func (c *ExecutionClient) Head() common.ExecutionHash {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.head
+}
+
+// InjectFault makes the next n calls of the given JSON-RPC method, e.g.
+// engine_newPayloadV3, misbehave as described by the fault. If n is
+// negative, every call misbehaves until the faults are cleared.
+func (c *ExecutionClient) InjectFault(method string, f Fault, n int) {
+	if n == 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.faults[method] = &fault{Fault: f, remaining: n}
+}
+
+// ClearFaults removes every injected fault.
+func (c *ExecutionClient) ClearFaults() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.faults)
+}
+
+// applyFault applies the fault injected for the given method, if any. It
+// returns the status the call must answer with, which is empty if the call
+// must be processed normally.
+func (c *ExecutionClient) applyFault(
+	ctx context.Context,
+	method string,
+) (string, error) {
+	c.mu.Lock()
+	f, ok := c.faults[method]
+	if ok {
+		if f.remaining > 0 {
+			f.remaining--
+		}
+		if f.remaining == 0 {
+			delete(c.faults, method)
+		}
+	}
+	c.mu.Unlock()
+	if !ok {
+		return "", nil
+	}
+
+	if f.Delay > 0 {
+		timer := time.NewTimer(f.Delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return f.Status, f.Err
+}
+
+// canonical returns the block of the canonical chain, as defined by the
+// head of the forkchoice, at the given number.
+func (c *ExecutionClient) canonical(number uint64) (*types.Block, bool) {
+	block, ok := c.chain.Block(c.Head())
+	for ok && block.NumberU64() > number {
+		block, ok = c.chain.Block(block.ParentHash())
+	}
+	if !ok || block.NumberU64() != number {
+		return nil, false
+	}
+	return block, true
+}
@@ -0,0 +1,278 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mock
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// engineNamespace is the JSON-RPC namespace of the Engine API.
+	engineNamespace = "engine"
+	// maxPayloadBodiesRequestSize is the maximum number of payload bodies
+	// that may be requested at once.
+	maxPayloadBodiesRequestSize = 1024
+)
+
+// engineAPI is the engine namespace of the execution client.
+type engineAPI struct {
+	*ExecutionClient
+}
+
+// ExchangeCapabilities returns the Engine API methods that are supported by
+// the execution client.
+func (api *engineAPI) ExchangeCapabilities([]string) []string {
+	return []string{
+		ethclient.NewPayloadMethodV3,
+		ethclient.ForkchoiceUpdatedMethodV3,
+		ethclient.GetPayloadMethodV3,
+		ethclient.GetPayloadBodiesByHashV1,
+		ethclient.GetPayloadBodiesByRangeV1,
+	}
+}
+
+// ForkchoiceUpdatedV3 updates the forkchoice of the execution client and,
+// if payload attributes are given, starts building a payload on top of the
+// new head.
+func (api *engineAPI) ForkchoiceUpdatedV3(
+	ctx context.Context,
+	state engine.ForkchoiceStateV1,
+	attrs *engine.PayloadAttributes,
+) (*engine.ForkChoiceResponse, error) {
+	status, err := api.applyFault(ctx, ethclient.ForkchoiceUpdatedMethodV3)
+	if err != nil {
+		return nil, err
+	} else if status != "" {
+		return &engine.ForkChoiceResponse{
+			PayloadStatus: engine.PayloadStatusV1{Status: status},
+		}, nil
+	}
+
+	head, ok := api.chain.Block(state.HeadBlockHash)
+	if !ok {
+		res := engine.STATUS_SYNCING
+		return &res, nil
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.head = state.HeadBlockHash
+	if state.SafeBlockHash != (common.ExecutionHash{}) {
+		api.safe = state.SafeBlockHash
+	}
+	if state.FinalizedBlockHash != (common.ExecutionHash{}) {
+		api.finalized = state.FinalizedBlockHash
+	}
+
+	res := &engine.ForkChoiceResponse{
+		PayloadStatus: engine.PayloadStatusV1{
+			Status:          engine.VALID,
+			LatestValidHash: &state.HeadBlockHash,
+		},
+	}
+	if attrs == nil {
+		return res, nil
+	}
+	if attrs.Timestamp <= head.Time() {
+		return nil, engine.InvalidPayloadAttributes.With(
+			errTimestampNotAfterParent,
+		)
+	}
+
+	id, err := payloadID(state.HeadBlockHash, attrs)
+	if err != nil {
+		return nil, err
+	}
+	api.payloads[id] = api.chain.buildBlock(head, attrs)
+	res.PayloadID = &id
+	return res, nil
+}
+
+// GetPayloadV3 returns the payload that was built under the given ID.
+func (api *engineAPI) GetPayloadV3(
+	ctx context.Context,
+	id engine.PayloadID,
+) (*engine.ExecutionPayloadEnvelope, error) {
+	if _, err := api.applyFault(
+		ctx, ethclient.GetPayloadMethodV3,
+	); err != nil {
+		return nil, err
+	}
+
+	api.mu.Lock()
+	block, ok := api.payloads[id]
+	api.mu.Unlock()
+	if !ok {
+		return nil, engine.UnknownPayload
+	}
+	return engine.BlockToExecutableData(block, new(big.Int), nil), nil
+}
+
+// NewPayloadV3 validates the payload and imports it into the chain.
+func (api *engineAPI) NewPayloadV3(
+	ctx context.Context,
+	params engine.ExecutableData,
+	versionedHashes []common.ExecutionHash,
+	beaconRoot *common.ExecutionHash,
+) (*engine.PayloadStatusV1, error) {
+	status, err := api.applyFault(ctx, ethclient.NewPayloadMethodV3)
+	if err != nil {
+		return nil, err
+	} else if status != "" {
+		return &engine.PayloadStatusV1{Status: status}, nil
+	}
+
+	block, err := engine.ExecutableDataToBlock(
+		params, versionedHashes, beaconRoot,
+	)
+	if err != nil {
+		return invalidStatus(err), nil
+	}
+
+	parent, ok := api.chain.Block(block.ParentHash())
+	switch {
+	case !ok:
+		return &engine.PayloadStatusV1{Status: engine.SYNCING}, nil
+	case block.Time() <= parent.Time():
+		return invalidStatus(errTimestampNotAfterParent), nil
+	case block.NumberU64() != parent.NumberU64()+1:
+		return invalidStatus(errInvalidNumber), nil
+	}
+
+	api.chain.insert(block)
+	hash := block.Hash()
+	return &engine.PayloadStatusV1{
+		Status:          engine.VALID,
+		LatestValidHash: &hash,
+	}, nil
+}
+
+// GetPayloadBodiesByHashV1 returns the bodies of the blocks with the given
+// hashes, nil for the blocks that are unknown.
+func (api *engineAPI) GetPayloadBodiesByHashV1(
+	ctx context.Context,
+	hashes []common.ExecutionHash,
+) ([]*engine.ExecutionPayloadBodyV1, error) {
+	if _, err := api.applyFault(
+		ctx, ethclient.GetPayloadBodiesByHashV1,
+	); err != nil {
+		return nil, err
+	} else if len(hashes) > maxPayloadBodiesRequestSize {
+		return nil, engine.TooLargeRequest
+	}
+
+	bodies := make([]*engine.ExecutionPayloadBodyV1, len(hashes))
+	for i, hash := range hashes {
+		if block, ok := api.chain.Block(hash); ok {
+			bodies[i] = payloadBody(block)
+		}
+	}
+	return bodies, nil
+}
+
+// GetPayloadBodiesByRangeV1 returns the bodies of the count canonical blocks
+// starting at the given number, up to the head of the forkchoice.
+func (api *engineAPI) GetPayloadBodiesByRangeV1(
+	ctx context.Context,
+	start, count hexutil.Uint64,
+) ([]*engine.ExecutionPayloadBodyV1, error) {
+	if _, err := api.applyFault(
+		ctx, ethclient.GetPayloadBodiesByRangeV1,
+	); err != nil {
+		return nil, err
+	}
+	switch {
+	case start == 0 || count == 0:
+		return nil, engine.InvalidParams
+	case count > maxPayloadBodiesRequestSize:
+		return nil, engine.TooLargeRequest
+	}
+
+	bodies := make([]*engine.ExecutionPayloadBodyV1, 0, count)
+	for number := uint64(start); number < uint64(start+count); number++ {
+		block, ok := api.canonical(number)
+		if !ok {
+			break
+		}
+		bodies = append(bodies, payloadBody(block))
+	}
+	return bodies, nil
+}
+
+// payloadBody returns the body of the payload of the given block.
+func payloadBody(block *types.Block) *engine.ExecutionPayloadBodyV1 {
+	body := &engine.ExecutionPayloadBodyV1{
+		TransactionData: make([]hexutil.Bytes, 0, len(block.Transactions())),
+		Withdrawals:     block.Withdrawals(),
+	}
+	for _, tx := range block.Transactions() {
+		bz, _ := tx.MarshalBinary()
+		body.TransactionData = append(body.TransactionData, bz)
+	}
+	if body.Withdrawals == nil {
+		body.Withdrawals = make([]*types.Withdrawal, 0)
+	}
+	return body
+}
+
+// invalidStatus returns an INVALID payload status for the given error.
+func invalidStatus(err error) *engine.PayloadStatusV1 {
+	msg := err.Error()
+	return &engine.PayloadStatusV1{
+		Status:          engine.INVALID,
+		ValidationError: &msg,
+	}
+}
+
+// payloadID derives the ID of the payload that is built with the given
+// attributes on top of the given parent.
+func payloadID(
+	parent common.ExecutionHash,
+	attrs *engine.PayloadAttributes,
+) (engine.PayloadID, error) {
+	var id engine.PayloadID
+	withdrawals, err := rlp.EncodeToBytes(attrs.Withdrawals)
+	if err != nil {
+		return id, err
+	}
+
+	hasher := sha256.New()
+	hasher.Write(parent[:])
+	_ = binary.Write(hasher, binary.BigEndian, attrs.Timestamp)
+	hasher.Write(attrs.Random[:])
+	hasher.Write(attrs.SuggestedFeeRecipient[:])
+	hasher.Write(withdrawals)
+	if attrs.BeaconRoot != nil {
+		hasher.Write(attrs.BeaconRoot[:])
+	}
+	copy(id[:], hasher.Sum(nil))
+	return id, nil
+}
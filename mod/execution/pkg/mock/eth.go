@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mock
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// ethNamespace is the JSON-RPC namespace of the eth API.
+const ethNamespace = "eth"
+
+// ethAPI is the eth namespace of the execution client.
+type ethAPI struct {
+	*ExecutionClient
+}
+
+// ChainId returns the chain ID of the chain.
+//
+//nolint:revive,stylecheck // must match the JSON-RPC method name.
+func (api *ethAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(api.chain.chainID)
+}
+
+// BlockNumber returns the number of the head block of the forkchoice.
+func (api *ethAPI) BlockNumber() (hexutil.Uint64, error) {
+	block, ok := api.chain.Block(api.Head())
+	if !ok {
+		return 0, errUnknownBlock
+	}
+	return hexutil.Uint64(block.NumberU64()), nil
+}
+
+// GetBlockByNumber returns the block of the canonical chain at the given
+// number, or null if there is none.
+func (api *ethAPI) GetBlockByNumber(
+	number ethrpc.BlockNumber,
+	_ bool,
+) (map[string]any, error) {
+	var (
+		block *types.Block
+		ok    bool
+	)
+	switch number {
+	case ethrpc.LatestBlockNumber, ethrpc.PendingBlockNumber:
+		block, ok = api.chain.Block(api.Head())
+	case ethrpc.SafeBlockNumber:
+		api.mu.Lock()
+		block, ok = api.chain.Block(api.safe)
+		api.mu.Unlock()
+	case ethrpc.FinalizedBlockNumber:
+		api.mu.Lock()
+		block, ok = api.chain.Block(api.finalized)
+		api.mu.Unlock()
+	default:
+		block, ok = api.canonical(uint64(number.Int64()))
+	}
+	if !ok {
+		//nolint:nilnil // null is the JSON-RPC result of an unknown block.
+		return nil, nil
+	}
+	return marshalBlock(block)
+}
+
+// GetBlockByHash returns the block with the given hash, or null if it is
+// unknown.
+func (api *ethAPI) GetBlockByHash(
+	hash common.ExecutionHash,
+	_ bool,
+) (map[string]any, error) {
+	block, ok := api.chain.Block(hash)
+	if !ok {
+		//nolint:nilnil // null is the JSON-RPC result of an unknown block.
+		return nil, nil
+	}
+	return marshalBlock(block)
+}
+
+// logsFilter is the filter of eth_getLogs.
+type logsFilter struct {
+	BlockHash *common.ExecutionHash     `json:"blockHash"`
+	FromBlock *ethrpc.BlockNumber       `json:"fromBlock"`
+	ToBlock   *ethrpc.BlockNumber       `json:"toBlock"`
+	Addresses []common.ExecutionAddress `json:"address"`
+	Topics    [][]common.ExecutionHash  `json:"topics"`
+}
+
+// GetLogs returns the logs of the canonical chain that match the filter.
+func (api *ethAPI) GetLogs(
+	_ context.Context,
+	filter logsFilter,
+) ([]*types.Log, error) {
+	blocks, err := api.filteredBlocks(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]*types.Log, 0)
+	for _, block := range blocks {
+		for _, log := range api.chain.blockLogs(block.Hash()) {
+			if filter.matches(log) {
+				logs = append(logs, log)
+			}
+		}
+	}
+	return logs, nil
+}
+
+// filteredBlocks returns the blocks that are covered by the filter.
+func (api *ethAPI) filteredBlocks(
+	filter logsFilter,
+) ([]*types.Block, error) {
+	if filter.BlockHash != nil {
+		block, ok := api.chain.Block(*filter.BlockHash)
+		if !ok {
+			return nil, errUnknownBlock
+		}
+		return []*types.Block{block}, nil
+	}
+
+	head, err := api.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	from, to := uint64(head), uint64(head)
+	if filter.FromBlock != nil && filter.FromBlock.Int64() >= 0 {
+		from = uint64(filter.FromBlock.Int64())
+	}
+	if filter.ToBlock != nil && filter.ToBlock.Int64() >= 0 {
+		to = uint64(filter.ToBlock.Int64())
+	}
+
+	blocks := make([]*types.Block, 0)
+	for number := from; number <= to; number++ {
+		block, ok := api.canonical(number)
+		if !ok {
+			break
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+// matches returns whether the log matches the addresses and topics of the
+// filter.
+func (f logsFilter) matches(log *types.Log) bool {
+	if len(f.Addresses) > 0 && !contains(f.Addresses, log.Address) {
+		return false
+	}
+	if len(f.Topics) > len(log.Topics) {
+		return false
+	}
+	for i, topics := range f.Topics {
+		if len(topics) > 0 && !contains(topics, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// contains returns whether the slice contains the value.
+func contains[T comparable](values []T, value T) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// marshalBlock returns the JSON-RPC representation of the block.
+func marshalBlock(block *types.Block) (map[string]any, error) {
+	bz, err := json.Marshal(block.Header())
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]any)
+	if err = json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	fields["size"] = hexutil.Uint64(block.Size())
+	fields["totalDifficulty"] = (*hexutil.Big)(new(big.Int))
+	fields["transactions"] = []common.ExecutionHash{}
+	fields["uncles"] = []common.ExecutionHash{}
+	fields["withdrawals"] = block.Withdrawals()
+	return fields, nil
+}
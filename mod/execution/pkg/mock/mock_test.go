@@ -0,0 +1,403 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mock_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/recorder"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/mock"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	jsonrpc "github.com/berachain/beacon-kit/mod/primitives/pkg/net/json-rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	gethclient "github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// testChainID is the chain ID of the test chain.
+	testChainID = 80087
+	// testGenesisTime is the genesis time of the test chain.
+	testGenesisTime = 1_700_000_000
+)
+
+//nolint:gochecknoglobals // test fixture.
+var (
+	// testDepositContract is the address of the deposit contract.
+	testDepositContract = common.ExecutionAddress{0xde}
+	// testFeeRecipient is the fee recipient of the built payloads.
+	testFeeRecipient = common.ExecutionAddress{0xfe}
+)
+
+func TestExecutionClient_BuildAndImport(t *testing.T) {
+	chain := newTestChain(t)
+	el, ec := newTestEngineClient(t, chain, client.DefaultConfig())
+	ctx := context.Background()
+
+	genesis := chain.Genesis().Hash()
+	payload := buildPayload(t, ec, genesis, testGenesisTime+1)
+	require.Equal(t, uint64(1), payload.Number)
+	require.Equal(t, testFeeRecipient, payload.FeeRecipient)
+	require.Len(t, payload.Withdrawals, 1)
+	require.Equal(t, genesis, payload.ParentHash)
+
+	// Another node shares the chain and imports the payload.
+	other, otherEC := newTestEngineClient(t, chain, client.DefaultConfig())
+	hash, err := otherEC.NewPayload(
+		ctx, payload, []common.ExecutionHash{}, &primitives.Root{}, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, payload.BlockHash, *hash)
+
+	_, _, err = otherEC.ForkchoiceUpdated(
+		ctx,
+		&engineprimitives.ForkchoiceStateV1{
+			HeadBlockHash:      payload.BlockHash,
+			SafeBlockHash:      payload.BlockHash,
+			FinalizedBlockHash: payload.BlockHash,
+		},
+		nil,
+		version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(t, payload.BlockHash, other.Head())
+	require.Equal(t, genesis, el.Head())
+
+	header, err := otherEC.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, payload.BlockHash, header.Hash())
+}
+
+func TestExecutionClient_RejectsInvalidPayload(t *testing.T) {
+	chain := newTestChain(t)
+	_, ec := newTestEngineClient(t, chain, client.DefaultConfig())
+
+	payload := buildPayload(t, ec, chain.Genesis().Hash(), testGenesisTime+1)
+	payload.GasUsed++
+	_, err := ec.NewPayload(
+		context.Background(), payload,
+		[]common.ExecutionHash{}, &primitives.Root{}, nil,
+	)
+	require.ErrorIs(t, err, engineerrors.ErrInvalidPayloadStatus)
+}
+
+func TestExecutionClient_Faults(t *testing.T) {
+	chain := newTestChain(t)
+	cfg := client.DefaultConfig()
+	cfg.RPCTimeout = 50 * time.Millisecond
+	el, ec := newTestEngineClient(t, chain, cfg)
+	ctx := context.Background()
+
+	payload := buildPayload(t, ec, chain.Genesis().Hash(), testGenesisTime+1)
+
+	// The next call answers with SYNCING, the one after is processed.
+	el.InjectFault(
+		ethclient.NewPayloadMethodV3,
+		mock.Fault{Status: engine.SYNCING},
+		1,
+	)
+	_, err := ec.NewPayload(
+		ctx, payload, []common.ExecutionHash{}, &primitives.Root{}, nil,
+	)
+	require.ErrorIs(t, err, engineerrors.ErrSyncingPayloadStatus)
+
+	// A stalling execution client makes the call time out.
+	el.InjectFault(
+		ethclient.NewPayloadMethodV3,
+		mock.Fault{Delay: 10 * cfg.RPCTimeout},
+		1,
+	)
+	_, err = ec.NewPayload(
+		ctx, payload, []common.ExecutionHash{}, &primitives.Root{}, nil,
+	)
+	require.Error(t, err)
+
+	el.ClearFaults()
+	hash, err := ec.NewPayload(
+		ctx, payload, []common.ExecutionHash{}, &primitives.Root{}, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, payload.BlockHash, *hash)
+}
+
+func TestExecutionClient_PayloadBodies(t *testing.T) {
+	chain := newTestChain(t)
+	_, ec := newTestEngineClient(t, chain, client.DefaultConfig())
+	ctx := context.Background()
+	require.True(t, ec.HasCapability(ethclient.GetPayloadBodiesByHashV1))
+	require.True(t, ec.HasCapability(ethclient.GetPayloadBodiesByRangeV1))
+
+	payload := buildPayload(t, ec, chain.Genesis().Hash(), testGenesisTime+1)
+	_, err := ec.NewPayload(
+		ctx, payload, []common.ExecutionHash{}, &primitives.Root{}, nil,
+	)
+	require.NoError(t, err)
+	_, _, err = ec.ForkchoiceUpdated(
+		ctx,
+		&engineprimitives.ForkchoiceStateV1{HeadBlockHash: payload.BlockHash},
+		nil,
+		version.Deneb,
+	)
+	require.NoError(t, err)
+
+	// Unknown payloads have no body.
+	bodies, err := ec.GetPayloadBodiesByHash(
+		ctx, []common.ExecutionHash{payload.BlockHash, {0xff}},
+	)
+	require.NoError(t, err)
+	require.Len(t, bodies, 2)
+	require.NotNil(t, bodies[0])
+	require.Empty(t, bodies[0].Transactions)
+	require.Len(t, bodies[0].Withdrawals, 1)
+	require.Equal(
+		t, payload.Withdrawals[0].Address, bodies[0].Withdrawals[0].Address,
+	)
+	require.Nil(t, bodies[1])
+
+	// The range is truncated after the head.
+	bodies, err = ec.GetPayloadBodiesByRange(ctx, 1, 8)
+	require.NoError(t, err)
+	require.Len(t, bodies, 1)
+	require.Len(t, bodies[0].Withdrawals, 1)
+
+	_, err = ec.GetPayloadBodiesByRange(ctx, 0, 1)
+	require.ErrorIs(t, err, jsonrpc.ErrInvalidParams)
+	_, err = ec.GetPayloadBodiesByRange(ctx, 1, 1025)
+	require.ErrorIs(t, err, engineerrors.ErrRequestTooLarge)
+}
+
+func TestExecutionClient_RecordAndReplay(t *testing.T) {
+	ctx := context.Background()
+	chain := newTestChain(t)
+	cfg := client.DefaultConfig()
+	cfg.RecordDir = t.TempDir()
+	_, ec := newTestEngineClient(t, chain, cfg)
+
+	genesis := chain.Genesis().Hash()
+	payload := buildPayload(t, ec, genesis, testGenesisTime+1)
+	hash, err := ec.NewPayload(
+		ctx, payload, []common.ExecutionHash{}, &primitives.Root{}, nil,
+	)
+	require.NoError(t, err)
+
+	entries, err := recorder.Load(cfg.RecordDir)
+	require.NoError(t, err)
+	methods := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		methods = append(methods, entry.Method)
+		switch entry.Method {
+		case ethclient.ForkchoiceUpdatedMethodV3,
+			ethclient.GetPayloadMethodV3,
+			ethclient.NewPayloadMethodV3:
+			require.NotNil(t, entry.ForkVersion, entry.Method)
+			require.Equal(t, version.Deneb, *entry.ForkVersion)
+		}
+	}
+	require.Contains(t, methods, ethclient.ChainIDMethod)
+	require.Contains(t, methods, ethclient.ForkchoiceUpdatedMethodV3)
+	require.Contains(t, methods, ethclient.GetPayloadMethodV3)
+	require.Contains(t, methods, ethclient.NewPayloadMethodV3)
+
+	// The recording answers the same calls without an execution client.
+	replayer := recorder.NewReplayer(entries, "")
+	rpcClient, err := replayer.RPCClient(ctx)
+	require.NoError(t, err)
+	replayCfg := client.DefaultConfig()
+	replayCfg.RPCStartupCheckInterval = 10 * time.Millisecond
+	replayEC := client.NewFromRPCClient[*testPayload](
+		&replayCfg,
+		noop.NewLogger(),
+		rpcClient,
+		noopTelemetrySink{},
+		big.NewInt(testChainID),
+	)
+	replayCtx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+	require.NoError(t, replayEC.Start(replayCtx))
+
+	replayed := buildPayload(t, replayEC, genesis, testGenesisTime+1)
+	require.Equal(t, payload, replayed)
+	replayedHash, err := replayEC.NewPayload(
+		ctx, replayed, []common.ExecutionHash{}, &primitives.Root{}, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, hash, replayedHash)
+	require.Empty(t, replayer.Remaining())
+}
+
+func TestChain_Deposits(t *testing.T) {
+	chain := newTestChain(t)
+	el, ec := newTestEngineClient(t, chain, client.DefaultConfig())
+
+	pubkey := crypto.BLSPubkey{1}
+	require.NoError(t, chain.AddDeposit(
+		pubkey, [32]byte{2}, 32e9, crypto.BLSSignature{3},
+	))
+	payload := buildPayload(t, ec, chain.Genesis().Hash(), testGenesisTime+1)
+	_, err := ec.NewPayload(
+		context.Background(), payload,
+		[]common.ExecutionHash{}, &primitives.Root{}, nil,
+	)
+	require.NoError(t, err)
+	_, _, err = ec.ForkchoiceUpdated(
+		context.Background(),
+		&engineprimitives.ForkchoiceStateV1{HeadBlockHash: payload.BlockHash},
+		nil,
+		version.Deneb,
+	)
+	require.NoError(t, err)
+
+	contract, err := deposit.NewBeaconDepositContract(
+		testDepositContract, gethclient.NewClient(el.RPCClient()),
+	)
+	require.NoError(t, err)
+	end := uint64(1)
+	logs, err := contract.FilterDeposit(
+		&bind.FilterOpts{Start: 1, End: &end},
+	)
+	require.NoError(t, err)
+	require.True(t, logs.Next())
+	require.Equal(t, pubkey[:], logs.Event.Pubkey)
+	require.Equal(t, uint64(32e9), logs.Event.Amount)
+	require.Equal(t, uint64(0), logs.Event.Index)
+	require.False(t, logs.Next())
+}
+
+// newTestChain creates a new test chain.
+func newTestChain(t *testing.T) *mock.Chain {
+	t.Helper()
+	chain, err := mock.NewChain(
+		testChainID, testDepositContract, testGenesisTime,
+	)
+	require.NoError(t, err)
+	return chain
+}
+
+// newTestEngineClient starts an engine client connected to a new execution
+// client that builds on the given chain.
+func newTestEngineClient(
+	t *testing.T,
+	chain *mock.Chain,
+	cfg client.Config,
+) (*mock.ExecutionClient, *client.EngineClient[*testPayload]) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	el, err := mock.New(chain)
+	require.NoError(t, err)
+	t.Cleanup(el.Close)
+
+	cfg.RPCStartupCheckInterval = 10 * time.Millisecond
+	ec := client.NewFromRPCClient[*testPayload](
+		&cfg,
+		noop.NewLogger(),
+		el.RPCClient(),
+		noopTelemetrySink{},
+		big.NewInt(testChainID),
+	)
+	require.NoError(t, ec.Start(ctx))
+	return el, ec
+}
+
+// buildPayload builds a payload with the given timestamp on top of the
+// given parent.
+func buildPayload(
+	t *testing.T,
+	ec *client.EngineClient[*testPayload],
+	parent common.ExecutionHash,
+	timestamp uint64,
+) *testPayload {
+	t.Helper()
+	attrs, err := engineprimitives.NewPayloadAttributes(
+		version.Deneb,
+		timestamp,
+		primitives.Bytes32{1},
+		testFeeRecipient,
+		[]*engineprimitives.Withdrawal{
+			{Index: 0, Validator: 1, Address: common.ExecutionAddress{1}},
+		},
+		primitives.Root{},
+	)
+	require.NoError(t, err)
+
+	payloadID, _, err := ec.ForkchoiceUpdated(
+		context.Background(),
+		&engineprimitives.ForkchoiceStateV1{HeadBlockHash: parent},
+		attrs,
+		version.Deneb,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, payloadID)
+
+	envelope, err := ec.GetPayload(
+		context.Background(), *payloadID, version.Deneb,
+	)
+	require.NoError(t, err)
+	return envelope.GetExecutionPayload()
+}
+
+// testPayload is a Deneb execution payload in the representation of the
+// execution client.
+type testPayload struct {
+	engine.ExecutableData
+}
+
+// Empty returns an empty test payload.
+func (*testPayload) Empty(uint32) *testPayload {
+	return &testPayload{}
+}
+
+// Version returns the version of the test payload.
+func (*testPayload) Version() uint32 {
+	return version.Deneb
+}
+
+// MarshalJSON returns the JSON encoding of the test payload.
+func (p *testPayload) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.ExecutableData)
+}
+
+// UnmarshalJSON decodes the JSON encoding of the test payload.
+func (p *testPayload) UnmarshalJSON(bz []byte) error {
+	return json.Unmarshal(bz, &p.ExecutableData)
+}
+
+// noopTelemetrySink is a telemetry sink that discards every metric.
+type noopTelemetrySink struct{}
+
+func (noopTelemetrySink) IncrementCounter(string, ...string)        {}
+func (noopTelemetrySink) SetGauge(string, int64, ...string)         {}
+func (noopTelemetrySink) MeasureSince(string, time.Time, ...string) {}
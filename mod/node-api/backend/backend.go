@@ -25,25 +25,70 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmttypes "github.com/cometbft/cometbft/types"
 )
 
 type Backend struct {
-	getNewStateDB func(context.Context, string) StateDB
+	getNewStateDB      func(context.Context, string) (StateDB, error)
+	getDepositSnapshot func(context.Context) (*DepositSnapshot, error)
+	registerValidators func(
+		context.Context, []*types.SignedValidatorRegistration,
+	) error
+	prepareBeaconProposer func(
+		context.Context, math.ValidatorIndex, common.ExecutionAddress,
+	)
+	chainSpec             primitives.ChainSpec
+	getCometBFTValidators func(
+		context.Context, math.Slot,
+	) (math.Slot, []*cmttypes.Validator, error)
 }
 
 // TODO: need to add state_id resolver; possible values are: "head" (canonical
 // head in node's view), "genesis", "finalized", "justified", <slot>, <hex
 // encoded stateRoot with 0x prefix>.
 func New(
-	getNewStateDB func(ctx context.Context, stateId string) StateDB,
+	getNewStateDB func(ctx context.Context, stateId string) (StateDB, error),
+	getDepositSnapshot func(ctx context.Context) (*DepositSnapshot, error),
+	registerValidators func(
+		ctx context.Context,
+		registrations []*types.SignedValidatorRegistration,
+	) error,
+	prepareBeaconProposer func(
+		ctx context.Context,
+		index math.ValidatorIndex,
+		feeRecipient common.ExecutionAddress,
+	),
+	chainSpec primitives.ChainSpec,
+	// getCometBFTValidators returns the CometBFT validator set, with its
+	// proposer priorities, at the given height, or at the latest height if
+	// the given one is ahead of it, along with the height returned.
+	getCometBFTValidators func(
+		ctx context.Context,
+		height math.Slot,
+	) (math.Slot, []*cmttypes.Validator, error),
 ) *Backend {
 	return &Backend{
-		getNewStateDB: getNewStateDB,
+		getNewStateDB:         getNewStateDB,
+		getDepositSnapshot:    getDepositSnapshot,
+		registerValidators:    registerValidators,
+		prepareBeaconProposer: prepareBeaconProposer,
+		chainSpec:             chainSpec,
+		getCometBFTValidators: getCometBFTValidators,
 	}
 }
 
+// DepositSnapshot is an EIP-4881 deposit tree snapshot.
+type DepositSnapshot struct {
+	Finalized            []primitives.Root
+	DepositRoot          primitives.Root
+	DepositCount         uint64
+	ExecutionBlockHash   common.ExecutionHash
+	ExecutionBlockHeight math.U64
+}
+
 type StateDB interface {
 	GetGenesisValidatorsRoot() (primitives.Root, error)
 	GetSlot() (math.Slot, error)
@@ -91,6 +136,9 @@ type StateDB interface {
 		validator *types.Validator,
 	) error
 	ValidatorIndexByPubkey(pubkey crypto.BLSPubkey) (math.ValidatorIndex, error)
+	ValidatorIndexByCometBFTAddress(
+		cometBFTAddress []byte,
+	) (math.ValidatorIndex, error)
 	AddValidator(
 		val *types.Validator,
 	) error
@@ -26,17 +26,57 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/node-api/backend"
 	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGetGenesisValidatorsRoot(t *testing.T) {
 	sdb := &mocks.StateDB{}
-	b := backend.New(func(context.Context, string) backend.StateDB {
-		return sdb
-	})
+	b := backend.New(
+		func(context.Context, string) (backend.StateDB, error) {
+			return sdb, nil
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
 	sdb.EXPECT().GetGenesisValidatorsRoot().Return(primitives.Root{0x01}, nil)
 	root, err := b.GetGenesis(context.Background())
 	require.NoError(t, err)
 	require.Equal(t, primitives.Root{0x01}, root)
 }
+
+func TestGetDepositSnapshot(t *testing.T) {
+	b := backend.New(
+		nil,
+		func(context.Context) (*backend.DepositSnapshot, error) {
+			return &backend.DepositSnapshot{
+				Finalized:            []primitives.Root{{0x01}, {0x02}},
+				DepositRoot:          primitives.Root{0x03},
+				DepositCount:         3,
+				ExecutionBlockHeight: 10,
+			}, nil
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	snapshot, err := b.GetDepositSnapshot(context.Background())
+	require.NoError(t, err)
+	require.Equal(
+		t, []primitives.Root{{0x01}, {0x02}}, snapshot.Finalized,
+	)
+	require.Equal(t, primitives.Root{0x03}, snapshot.DepositRoot)
+	require.Equal(t, uint64(3), snapshot.DepositCount)
+	require.Equal(t, uint64(10), snapshot.ExecutionBlockHeight)
+}
+
+func TestGetDepositSnapshotNotSupported(t *testing.T) {
+	b := backend.New(nil, nil, nil, nil, nil, nil)
+	_, err := b.GetDepositSnapshot(context.Background())
+	require.ErrorIs(t, err, serverType.ErrNotSupported)
+}
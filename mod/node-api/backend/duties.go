@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// GetProposerDuties returns the proposers of the slots of the given epoch,
+// which is at most the next epoch, along with the root of the block they
+// depend on. CometBFT chooses the proposers by a weighted round robin over
+// its validator set, so the duties are computed by advancing the proposer
+// priorities of the set slot by slot. Duties ahead of the head are a
+// prediction: validator set updates and rounds beyond the first shift them.
+func (h Backend) GetProposerDuties(
+	ctx context.Context,
+	epoch math.Epoch,
+) (primitives.Root, []*serverType.ProposerDutyData, error) {
+	if h.getCometBFTValidators == nil {
+		return primitives.Root{}, nil, serverType.ErrNotSupported
+	}
+	stateDB, err := h.getNewStateDB(ctx, "head")
+	if err != nil {
+		return primitives.Root{}, nil, err
+	}
+	head, err := stateDB.GetSlot()
+	if err != nil {
+		return primitives.Root{}, nil, err
+	}
+	if epoch > h.chainSpec.SlotToEpoch(head)+1 {
+		return primitives.Root{}, nil, serverType.ErrEpochOutOfRange
+	}
+
+	// The genesis slot has no proposer.
+	startSlot := math.Slot(epoch.Unwrap() * h.chainSpec.SlotsPerEpoch())
+	endSlot := startSlot + math.Slot(h.chainSpec.SlotsPerEpoch())
+	startSlot = max(startSlot, 1)
+
+	height, vals, err := h.getCometBFTValidators(ctx, startSlot)
+	if err != nil {
+		return primitives.Root{}, nil, err
+	} else if height > startSlot {
+		return primitives.Root{}, nil, serverType.ErrValidatorSetHeight
+	}
+	valSet, err := cmttypes.ValidatorSetFromExistingValidators(vals)
+	if err != nil {
+		return primitives.Root{}, nil, err
+	}
+
+	// Catch the priorities up to the start of the epoch if the validator set
+	// is from an earlier height.
+	if height < startSlot {
+		//#nosec:G701 // at most two epochs apart.
+		valSet.IncrementProposerPriority(int32(startSlot - height))
+	}
+
+	duties := make(
+		[]*serverType.ProposerDutyData, 0, h.chainSpec.SlotsPerEpoch(),
+	)
+	for slot := startSlot; slot < endSlot; slot++ {
+		if slot > startSlot {
+			valSet.IncrementProposerPriority(1)
+		}
+		index, indexErr := stateDB.ValidatorIndexByCometBFTAddress(
+			valSet.GetProposer().Address,
+		)
+		if indexErr != nil {
+			return primitives.Root{}, nil, indexErr
+		}
+		validator, validatorErr := stateDB.ValidatorByIndex(index)
+		if validatorErr != nil {
+			return primitives.Root{}, nil, validatorErr
+		}
+		duties = append(duties, &serverType.ProposerDutyData{
+			Pubkey:         validator.Pubkey,
+			ValidatorIndex: index.Unwrap(),
+			Slot:           slot.Unwrap(),
+		})
+	}
+
+	// The duties depend on the last block before the epoch, or on the head if
+	// the epoch is ahead of it.
+	dependentSlot := min(startSlot-1, head)
+	dependentRoot, err := stateDB.GetBlockRootAtIndex(
+		dependentSlot.Unwrap() % h.chainSpec.SlotsPerHistoricalRoot(),
+	)
+	if err != nil {
+		return primitives.Root{}, nil, err
+	}
+	return dependentRoot, duties, nil
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const slotsPerEpoch = 8
+
+// newDutiesBackend returns a backend whose head is at the given slot, with
+// CometBFT validators of the given voting powers at that height, the index
+// of each validator being the position of its voting power.
+func newDutiesBackend(
+	t *testing.T, head math.Slot, powers ...int64,
+) (*backend.Backend, []*cmttypes.Validator, *cmttypes.ValidatorSet) {
+	t.Helper()
+	vals := make([]*cmttypes.Validator, len(powers))
+	for i, power := range powers {
+		vals[i] = cmttypes.NewValidator(
+			ed25519.GenPrivKeyFromSecret([]byte{byte(i)}).PubKey(), power,
+		)
+	}
+	valSet := cmttypes.NewValidatorSet(vals)
+
+	sdb := &mocks.StateDB{}
+	sdb.EXPECT().GetSlot().Return(head, nil)
+	sdb.EXPECT().
+		GetBlockRootAtIndex(mock.Anything).
+		Return(primitives.Root{0x01}, nil)
+	sdb.EXPECT().
+		ValidatorIndexByCometBFTAddress(mock.Anything).
+		RunAndReturn(func(address []byte) (math.ValidatorIndex, error) {
+			return indexOf(vals, address), nil
+		})
+	sdb.EXPECT().
+		ValidatorByIndex(mock.Anything).
+		RunAndReturn(func(index math.ValidatorIndex) (*types.Validator, error) {
+			return &types.Validator{
+				Pubkey: crypto.BLSPubkey{byte(index)},
+			}, nil
+		})
+
+	return backend.New(
+		func(context.Context, string) (backend.StateDB, error) {
+			return sdb, nil
+		},
+		nil,
+		nil,
+		nil,
+		chain.NewChainSpec(chain.SpecData[
+			primitives.DomainType,
+			math.Epoch,
+			common.ExecutionAddress,
+			math.Slot,
+			any,
+		]{
+			SlotsPerEpoch:          slotsPerEpoch,
+			SlotsPerHistoricalRoot: 8,
+		}),
+		func(context.Context, math.Slot) (
+			math.Slot, []*cmttypes.Validator, error,
+		) {
+			return head, valSet.Copy().Validators, nil
+		},
+	), vals, valSet
+}
+
+// indexOf returns the position of the validator of the given address.
+func indexOf(vals []*cmttypes.Validator, address []byte) math.ValidatorIndex {
+	for i, val := range vals {
+		if bytes.Equal(val.Address, address) {
+			return math.ValidatorIndex(i)
+		}
+	}
+	panic("unknown validator")
+}
+
+func TestGetProposerDuties(t *testing.T) {
+	var (
+		head            = math.Slot(10)
+		b, vals, valSet = newDutiesBackend(t, head, 10, 20, 30, 40)
+	)
+
+	// The duties of the next epoch follow the round robin of CometBFT.
+	dependentRoot, duties, err := b.GetProposerDuties(
+		context.Background(), 2,
+	)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Root{0x01}, dependentRoot)
+	require.Len(t, duties, slotsPerEpoch)
+
+	valSet.IncrementProposerPriority(int32(2*slotsPerEpoch - head))
+	for i, duty := range duties {
+		if i > 0 {
+			valSet.IncrementProposerPriority(1)
+		}
+		index := indexOf(vals, valSet.GetProposer().Address)
+		require.Equal(t, uint64(2*slotsPerEpoch+i), duty.Slot)
+		require.Equal(t, index.Unwrap(), duty.ValidatorIndex)
+		require.Equal(t, crypto.BLSPubkey{byte(index)}, duty.Pubkey)
+	}
+}
+
+func TestGetProposerDuties_Weighted(t *testing.T) {
+	b, _, _ := newDutiesBackend(t, 1, 1, 3)
+
+	// Over the epoch, proposals are split by voting power.
+	_, duties, err := b.GetProposerDuties(context.Background(), 1)
+	require.NoError(t, err)
+	proposals := make(map[uint64]int)
+	for _, duty := range duties {
+		proposals[duty.ValidatorIndex]++
+	}
+	require.Equal(t, map[uint64]int{0: 2, 1: 6}, proposals)
+}
+
+func TestGetProposerDuties_Genesis(t *testing.T) {
+	b, _, _ := newDutiesBackend(t, 1, 1)
+
+	// The genesis slot has no proposer.
+	_, duties, err := b.GetProposerDuties(context.Background(), 0)
+	require.NoError(t, err)
+	require.Len(t, duties, slotsPerEpoch-1)
+	require.Equal(t, uint64(1), duties[0].Slot)
+}
+
+func TestGetProposerDuties_OutOfRange(t *testing.T) {
+	b, _, _ := newDutiesBackend(t, 1, 1)
+
+	_, _, err := b.GetProposerDuties(context.Background(), 2)
+	require.ErrorIs(t, err, serverType.ErrEpochOutOfRange)
+}
@@ -26,15 +26,54 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/node-api/backend/mocks"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/stretchr/testify/mock"
 )
 
 func NewMockBackend() *Backend {
 	sdb := &mocks.StateDB{}
-	b := New(func(context.Context, string) StateDB {
-		return sdb
-	})
+	b := New(
+		func(context.Context, string) (StateDB, error) {
+			return sdb, nil
+		},
+		func(context.Context) (*DepositSnapshot, error) {
+			return &DepositSnapshot{
+				Finalized:            []primitives.Root{{0x01}},
+				DepositRoot:          primitives.Root{0x01},
+				DepositCount:         1,
+				ExecutionBlockHash:   common.ExecutionHash{0x01},
+				ExecutionBlockHeight: 1,
+			}, nil
+		},
+		func(context.Context, []*types.SignedValidatorRegistration) error {
+			return nil
+		},
+		func(context.Context, math.ValidatorIndex, common.ExecutionAddress) {},
+		chain.NewChainSpec(chain.SpecData[
+			primitives.DomainType,
+			math.Epoch,
+			common.ExecutionAddress,
+			math.Slot,
+			any,
+		]{
+			SlotsPerEpoch:          2,
+			SlotsPerHistoricalRoot: 8,
+		}),
+		func(context.Context, math.Slot) (
+			math.Slot, []*cmttypes.Validator, error,
+		) {
+			return 1, []*cmttypes.Validator{
+				cmttypes.NewValidator(
+					ed25519.GenPrivKeyFromSecret([]byte{0x01}).PubKey(), 1,
+				),
+			}, nil
+		},
+	)
 	setReturnValues(sdb)
 	return b
 }
@@ -100,6 +139,9 @@ func setReturnValues(sdb *mocks.StateDB) {
 		UpdateValidatorAtIndex(mock.Anything, mock.Anything).
 		Return(nil)
 	sdb.EXPECT().ValidatorIndexByPubkey(mock.Anything).Return(0, nil)
+	sdb.EXPECT().
+		ValidatorIndexByCometBFTAddress(mock.Anything).
+		Return(0, nil)
 	sdb.EXPECT().AddValidator(mock.Anything).Return(nil)
 	sdb.EXPECT().GetValidatorsByEffectiveBalance().Return(nil, nil)
 }
@@ -2009,6 +2009,62 @@ func (_c *StateDB_ValidatorByIndex_Call) RunAndReturn(run func(math.U64) (*types
 	return _c
 }
 
+// ValidatorIndexByCometBFTAddress provides a mock function with given fields: cometBFTAddress
+func (_m *StateDB) ValidatorIndexByCometBFTAddress(cometBFTAddress []byte) (math.U64, error) {
+	ret := _m.Called(cometBFTAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidatorIndexByCometBFTAddress")
+	}
+
+	var r0 math.U64
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) (math.U64, error)); ok {
+		return rf(cometBFTAddress)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) math.U64); ok {
+		r0 = rf(cometBFTAddress)
+	} else {
+		r0 = ret.Get(0).(math.U64)
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(cometBFTAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateDB_ValidatorIndexByCometBFTAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidatorIndexByCometBFTAddress'
+type StateDB_ValidatorIndexByCometBFTAddress_Call struct {
+	*mock.Call
+}
+
+// ValidatorIndexByCometBFTAddress is a helper method to define mock.On call
+//   - cometBFTAddress []byte
+func (_e *StateDB_Expecter) ValidatorIndexByCometBFTAddress(cometBFTAddress interface{}) *StateDB_ValidatorIndexByCometBFTAddress_Call {
+	return &StateDB_ValidatorIndexByCometBFTAddress_Call{Call: _e.mock.On("ValidatorIndexByCometBFTAddress", cometBFTAddress)}
+}
+
+func (_c *StateDB_ValidatorIndexByCometBFTAddress_Call) Run(run func(cometBFTAddress []byte)) *StateDB_ValidatorIndexByCometBFTAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *StateDB_ValidatorIndexByCometBFTAddress_Call) Return(_a0 math.U64, _a1 error) *StateDB_ValidatorIndexByCometBFTAddress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateDB_ValidatorIndexByCometBFTAddress_Call) RunAndReturn(run func([]byte) (math.U64, error)) *StateDB_ValidatorIndexByCometBFTAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidatorIndexByPubkey provides a mock function with given fields: pubkey
 func (_m *StateDB) ValidatorIndexByPubkey(pubkey bytes.B48) (math.U64, error) {
 	ret := _m.Called(pubkey)
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	serverType "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// PrepareBeaconProposer sets the fee recipients of the payloads proposed by
+// the given validators.
+func (h Backend) PrepareBeaconProposer(
+	ctx context.Context,
+	preparations []*serverType.ProposerPreparationRequest,
+) error {
+	if h.prepareBeaconProposer == nil {
+		return serverType.ErrNotSupported
+	}
+	for _, preparation := range preparations {
+		h.prepareBeaconProposer(
+			ctx,
+			math.ValidatorIndex(preparation.ValidatorIndex),
+			preparation.FeeRecipient,
+		)
+	}
+	return nil
+}
+
+// RegisterValidators forwards the signed validator registrations to the
+// relays of the external block builders.
+func (h Backend) RegisterValidators(
+	ctx context.Context,
+	registrations []*types.SignedValidatorRegistration,
+) error {
+	if h.registerValidators == nil {
+		return serverType.ErrNotSupported
+	}
+	return h.registerValidators(ctx, registrations)
+}
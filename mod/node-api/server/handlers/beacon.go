@@ -194,3 +194,17 @@ func (rh RouteHandlers) GetBlockRewards(c echo.Context) error {
 		Data:                rewards,
 	})
 }
+
+func (rh RouteHandlers) GetDepositSnapshot(c echo.Context) error {
+	snapshot, err := rh.Backend.GetDepositSnapshot(context.TODO())
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return echo.NewHTTPError(
+			http.StatusNotFound,
+			"No finalized snapshot available",
+		)
+	}
+	return c.JSON(http.StatusOK, WrapData(snapshot))
+}
@@ -57,6 +57,9 @@ func CustomHTTPErrorHandler(err error, c echo.Context) {
 	if errors.As(err, &httpError) {
 		code = httpError.Code
 		message = httpError.Message
+	} else if errors.Is(err, types.ErrNotSupported) {
+		code = http.StatusNotImplemented
+		message = err.Error()
 	}
 	c.Logger().Error(err)
 	response := &types.ErrorResponse{
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	consensustypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	types "github.com/berachain/beacon-kit/mod/node-api/server/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	echo "github.com/labstack/echo/v4"
+)
+
+func (rh RouteHandlers) GetProposerDuties(c echo.Context) error {
+	params, err := BindAndValidate[types.EpochRequest](c)
+	if err != nil {
+		return err
+	}
+	epoch, err := strconv.ParseUint(params.Epoch, 10, 64)
+	if err != nil {
+		return echo.ErrBadRequest
+	}
+	dependentRoot, duties, err := rh.Backend.GetProposerDuties(
+		context.TODO(), math.Epoch(epoch),
+	)
+	if errors.Is(err, types.ErrEpochOutOfRange) ||
+		errors.Is(err, types.ErrValidatorSetHeight) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	} else if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, types.ProposerDutiesResponse{
+		DependentRoot:       dependentRoot,
+		ExecutionOptimistic: false, // stubbed
+		Data:                duties,
+	})
+}
+
+func (rh RouteHandlers) PostPrepareBeaconProposer(c echo.Context) error {
+	var params []*types.ProposerPreparationRequest
+	if err := c.Bind(&params); err != nil {
+		return echo.ErrBadRequest
+	}
+	if err := rh.Backend.PrepareBeaconProposer(
+		context.TODO(), params,
+	); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func (rh RouteHandlers) PostRegisterValidator(c echo.Context) error {
+	var params []*types.SignedValidatorRegistrationRequest
+	if err := c.Bind(&params); err != nil {
+		return echo.ErrBadRequest
+	}
+	registrations := make(
+		[]*consensustypes.SignedValidatorRegistration, 0, len(params),
+	)
+	for _, param := range params {
+		if err := c.Validate(param); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		registrations = append(
+			registrations, &consensustypes.SignedValidatorRegistration{
+				Message: &consensustypes.ValidatorRegistration{
+					FeeRecipient: param.Message.FeeRecipient,
+					GasLimit:     math.U64(param.Message.GasLimit),
+					Timestamp:    math.U64(param.Message.Timestamp),
+					Pubkey:       param.Message.Pubkey,
+				},
+				Signature: param.Signature,
+			},
+		)
+	}
+	if err := rh.Backend.RegisterValidators(
+		context.TODO(), registrations,
+	); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusOK)
+}
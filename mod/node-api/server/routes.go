@@ -33,6 +33,10 @@ type Handlers interface {
 	GetStateValidatorBalances(c echo.Context) error
 	PostStateValidatorBalances(c echo.Context) error
 	GetBlockRewards(c echo.Context) error
+	GetDepositSnapshot(c echo.Context) error
+	PostPrepareBeaconProposer(c echo.Context) error
+	PostRegisterValidator(c echo.Context) error
+	GetProposerDuties(c echo.Context) error
 }
 
 func UseMiddlewares(e *echo.Echo, middlewares ...echo.MiddlewareFunc) {
@@ -100,7 +104,7 @@ func assignBeaconRoutes(e *echo.Echo, h Handlers) {
 	e.POST("/eth/v1/beacon/rewards/sync_committee/:block_id",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/deposit_snapshot",
-		h.NotImplemented)
+		h.GetDepositSnapshot)
 	e.POST("/eth/v1/beacon/rewards/attestation/:epoch",
 		h.NotImplemented)
 	e.GET("/eth/v1/beacon/blinded_blocks/:block_id",
@@ -186,7 +190,7 @@ func assignValidatorRoutes(e *echo.Echo, h Handlers) {
 	e.POST("/eth/v1/validator/duties/attester/:epoch",
 		h.NotImplemented)
 	e.GET("/eth/v1/validator/duties/proposer/:epoch",
-		h.NotImplemented)
+		h.GetProposerDuties)
 	e.POST("/eth/v1/validator/duties/sync/:epoch",
 		h.NotImplemented)
 	e.GET("/eth/v3/validator/blocks/:slot",
@@ -210,9 +214,9 @@ func assignValidatorRoutes(e *echo.Echo, h Handlers) {
 	e.POST("/eth/v1/validator/contribution_and_proofs",
 		h.NotImplemented)
 	e.POST("/eth/v1/validator/prepare_beacon_proposer",
-		h.NotImplemented)
+		h.PostPrepareBeaconProposer)
 	e.POST("/eth/v1/validator/register_validator",
-		h.NotImplemented)
+		h.PostRegisterValidator)
 	e.POST("/eth/v1/validator/liveness/:epoch",
 		h.NotImplemented)
 }
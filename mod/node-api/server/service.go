@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/server/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// Service serves the beacon node API over HTTP.
+type Service struct {
+	// cfg is the configuration of the node API.
+	cfg Config
+	// logger is used for logging information and errors.
+	logger log.Logger[any]
+	// e is the HTTP server of the node API.
+	e *echo.Echo
+}
+
+// NewService creates a new node API service serving the given handlers.
+func NewService(
+	cfg Config,
+	logger log.Logger[any],
+	h Handlers,
+) *Service {
+	e := echo.New()
+	e.HideBanner = true
+	e.HidePort = true
+	e.HTTPErrorHandler = handlers.CustomHTTPErrorHandler
+	e.Validator = &handlers.CustomValidator{
+		Validator: ConstructValidator(),
+	}
+	UseMiddlewares(e, middleware.CORSWithConfig(middleware.DefaultCORSConfig))
+	AssignRoutes(e, h)
+	return &Service{
+		cfg:    cfg,
+		logger: logger,
+		e:      e,
+	}
+}
+
+// Name returns the name of the service.
+func (*Service) Name() string {
+	return "node-api"
+}
+
+// Start serves the node API until the given context is done, if enabled.
+func (s *Service) Start(ctx context.Context) error {
+	if !s.cfg.Enabled {
+		return nil
+	}
+
+	// Bind the address before serving, so that the node fails to start
+	// rather than running without the node API.
+	ln, err := net.Listen("tcp", s.cfg.Address)
+	if err != nil {
+		return err
+	}
+	s.e.Listener = ln
+
+	go func() {
+		s.logger.Info("serving node api 🌐", "address", s.cfg.Address)
+		if serveErr := s.e.Start(s.cfg.Address); serveErr != nil &&
+			!errors.Is(serveErr, http.ErrServerClosed) {
+			s.logger.Error("node api server failed", "error", serveErr)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		if err := s.e.Shutdown(context.Background()); err != nil {
+			s.logger.Error("failed to shut down node api", "error", err)
+		}
+	}()
+	return nil
+}
+
+// Status returns nil, as the node API has no health checks.
+func (*Service) Status() error {
+	return nil
+}
+
+// WaitForHealthy returns immediately, as the node API has no health checks.
+func (*Service) WaitForHealthy(context.Context) {}
@@ -23,7 +23,9 @@ package types
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 type BackendHandlers interface {
@@ -52,4 +54,17 @@ type BackendHandlers interface {
 		ctx context.Context,
 		blockID string,
 	) (*BlockRewardsData, error)
+	GetDepositSnapshot(ctx context.Context) (*DepositSnapshotData, error)
+	PrepareBeaconProposer(
+		ctx context.Context,
+		preparations []*ProposerPreparationRequest,
+	) error
+	RegisterValidators(
+		ctx context.Context,
+		registrations []*types.SignedValidatorRegistration,
+	) error
+	GetProposerDuties(
+		ctx context.Context,
+		epoch math.Epoch,
+	) (primitives.Root, []*ProposerDutyData, error)
 }
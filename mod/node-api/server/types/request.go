@@ -20,6 +20,11 @@
 
 package types
 
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
 type StateIDRequest struct {
 	StateID string `param:"state_id" validate:"required,state_id"`
 }
@@ -92,3 +97,20 @@ type BlobSidecarRequest struct {
 	BlockIDRequest
 	Indices []string `query:"indices" validate:"dive,uint64"`
 }
+
+type ValidatorRegistration struct {
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	GasLimit     uint64                  `json:"gas_limit,string"`
+	Timestamp    uint64                  `json:"timestamp,string"`
+	Pubkey       crypto.BLSPubkey        `json:"pubkey"`
+}
+
+type SignedValidatorRegistrationRequest struct {
+	Message   *ValidatorRegistration `json:"message"   validate:"required"`
+	Signature crypto.BLSSignature    `json:"signature"`
+}
+
+type ProposerPreparationRequest struct {
+	ValidatorIndex uint64                  `json:"validator_index,string"`
+	FeeRecipient   common.ExecutionAddress `json:"fee_recipient"`
+}
@@ -23,6 +23,8 @@ package types
 import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 )
 
 type ErrorResponse struct {
@@ -50,6 +52,12 @@ type ValidatorResponse struct {
 	Data                any  `json:"data"`
 }
 
+type ProposerDutiesResponse struct {
+	DependentRoot       primitives.Root `json:"dependent_root"`
+	ExecutionOptimistic bool            `json:"execution_optimistic"`
+	Data                any             `json:"data"`
+}
+
 type ValidatorData struct {
 	Index     uint64           `json:"index,string"`
 	Balance   uint64           `json:"balance,string"`
@@ -76,3 +84,18 @@ type BlockRewardsData struct {
 	ProposerSlashings uint64 `json:"proposer_slashings,string"`
 	AttesterSlashings uint64 `json:"attester_slashings,string"`
 }
+
+//nolint:lll // struct tags.
+type DepositSnapshotData struct {
+	Finalized            []primitives.Root    `json:"finalized"`
+	DepositRoot          primitives.Root      `json:"deposit_root"`
+	DepositCount         uint64               `json:"deposit_count,string"`
+	ExecutionBlockHash   common.ExecutionHash `json:"execution_block_hash"`
+	ExecutionBlockHeight uint64               `json:"execution_block_height,string"`
+}
+
+type ProposerDutyData struct {
+	Pubkey         crypto.BLSPubkey `json:"pubkey"`
+	ValidatorIndex uint64           `json:"validator_index,string"`
+	Slot           uint64           `json:"slot,string"`
+}
@@ -21,14 +21,22 @@
 package main
 
 import (
+	"context"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/node-api/server/handlers"
 	middleware "github.com/labstack/echo/v4/middleware"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type testcase struct {
@@ -59,6 +67,46 @@ func TestEndpoints(t *testing.T) {
 	}
 }
 
+func TestServiceStartBindError(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	// The address is already in use, so the service fails to start.
+	svc := server.NewService(
+		server.Config{Enabled: true, Address: ln.Addr().String()},
+		noop.NewLogger(),
+		handlers.RouteHandlers{Backend: backend.NewMockBackend()},
+	)
+	require.Error(t, svc.Start(context.Background()))
+}
+
+func TestServiceShutdown(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	svc := server.NewService(
+		server.Config{Enabled: true, Address: address},
+		noop.NewLogger(),
+		handlers.RouteHandlers{Backend: backend.NewMockBackend()},
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, svc.Start(ctx))
+
+	// The listener is closed once the context is done.
+	cancel()
+	require.Eventually(t, func() bool {
+		conn, dialErr := net.Dial("tcp", address)
+		if dialErr != nil {
+			return true
+		}
+		conn.Close()
+		return false
+	}, time.Second, 10*time.Millisecond)
+}
+
 func buildRequest(method, endpoint string, body *string) *http.Request {
 	req := httptest.NewRequest(method, endpoint, nil)
 	if method != "GET" && body != nil {
@@ -198,7 +246,8 @@ func getTestcases() []testcase {
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/beacon/deposit_snapshot",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"data\":{\"finalized\":[\"0x0100000000000000000000000000000000000000000000000000000000000000\"],\"deposit_root\":\"0x0100000000000000000000000000000000000000000000000000000000000000\",\"deposit_count\":\"1\",\"execution_block_hash\":\"0x0100000000000000000000000000000000000000000000000000000000000000\",\"execution_block_height\":\"1\"}}\n",
 		},
 		{
 			method:         "GET",
@@ -374,7 +423,13 @@ func getTestcases() []testcase {
 		{
 			method:         "GET",
 			endpoint:       "/eth/v1/validator/duties/proposer/:epoch",
-			expectedStatus: http.StatusNotImplemented,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"dependent_root\":\"0x0100000000000000000000000000000000000000000000000000000000000000\",\"execution_optimistic\":false,\"data\":[{\"pubkey\":\"0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000\",\"validator_index\":\"0\",\"slot\":\"2\"},{\"pubkey\":\"0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000\",\"validator_index\":\"0\",\"slot\":\"3\"}]}\n",
+		},
+		{
+			method:         "GET",
+			endpoint:       "/eth/v1/validator/duties/proposer/3",
+			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			method:         "POST",
@@ -434,12 +489,14 @@ func getTestcases() []testcase {
 		{
 			method:         "POST",
 			endpoint:       "/eth/v1/validator/prepare_beacon_proposer",
-			expectedStatus: http.StatusNotImplemented,
+			body:           `[{"validator_index":"1","fee_recipient":"0x0100000000000000000000000000000000000000"}]`,
+			expectedStatus: http.StatusOK,
 		},
 		{
 			method:         "POST",
 			endpoint:       "/eth/v1/validator/register_validator",
-			expectedStatus: http.StatusNotImplemented,
+			body:           `[{"message":{"fee_recipient":"0x0100000000000000000000000000000000000000","gas_limit":"30000000","timestamp":"1","pubkey":"0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},"signature":"0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}]`,
+			expectedStatus: http.StatusOK,
 		},
 		{
 			method:         "POST",
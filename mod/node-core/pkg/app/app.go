@@ -30,6 +30,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -42,6 +43,8 @@ var (
 // functions, as object capabilities aren't needed for testing.
 type BeaconApp struct {
 	*runtime.App
+	// stopServices stops the beacon-kit services once the app is closed.
+	stopServices context.CancelFunc
 }
 
 // NewBeaconKitApp returns a reference to an initialized BeaconApp.
@@ -64,9 +67,20 @@ func NewBeaconKitApp(
 		panic(err)
 	}
 
+	app.startServices()
+	app.pruneOnStartup()
+	app.syncExecutionClientOnStartup()
 	return app
 }
 
+// Close stops the beacon-kit services and closes the app.
+func (app *BeaconApp) Close() error {
+	if app.stopServices != nil {
+		app.stopServices()
+	}
+	return app.App.Close()
+}
+
 // TODO: Unhack this.
 func (app *BeaconApp) setupBeaconModule() {
 	// Get the beacon module.
@@ -89,11 +103,75 @@ func (app *BeaconApp) setupBeaconModule() {
 			ProcessProposalHandler,
 	)
 	app.SetPreBlocker(beaconModule.ABCIFinalizeBlockMiddleware().PreBlock)
+}
+
+// startServices starts the beacon-kit services, which run until the app is
+// closed.
+func (app *BeaconApp) startServices() {
+	beaconModule, ok := app.ModuleManager.
+		Modules[beacon.ModuleName].(beacon.AppModule)
+	if !ok {
+		panic("beacon module not found")
+	}
+
+	// The node API reads the committed state through query contexts, which
+	// are safe to use concurrently with block execution.
+	beaconModule.SetQueryContextFn(app.CreateQueryContext)
 
+	var ctx context.Context
+	ctx, app.stopServices = context.WithCancel(context.Background())
 	// TODO: this needs to be made un-hood.
-	if err := beaconModule.StartServices(
-		context.Background(),
-	); err != nil {
+	if err := beaconModule.StartServices(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// pruneOnStartup prunes the stores based on the latest committed state, so
+// that data that fell out of retention while the node was offline does not
+// linger until the next finalized block.
+func (app *BeaconApp) pruneOnStartup() {
+	// There is nothing to prune before genesis.
+	if app.LastBlockHeight() == 0 {
+		return
+	}
+
+	beaconModule, ok := app.ModuleManager.
+		Modules[beacon.ModuleName].(beacon.AppModule)
+	if !ok {
+		panic("beacon module not found")
+	}
+
+	// The context is backed by a branch of the committed state that is never
+	// written back.
+	ctx := sdk.NewContext(
+		app.CommitMultiStore().CacheMultiStore(), false, app.Logger(),
+	)
+	if err := beaconModule.PruneOnStartup(ctx); err != nil {
 		panic(err)
 	}
 }
+
+// syncExecutionClientOnStartup reconciles the execution client with the
+// latest committed state, replaying the execution payloads it is missing or
+// waiting for it to sync before any block is proposed.
+func (app *BeaconApp) syncExecutionClientOnStartup() {
+	// The execution client starts from the genesis block.
+	if app.LastBlockHeight() == 0 {
+		return
+	}
+
+	beaconModule, ok := app.ModuleManager.
+		Modules[beacon.ModuleName].(beacon.AppModule)
+	if !ok {
+		panic("beacon module not found")
+	}
+
+	ctx := sdk.NewContext(
+		app.CommitMultiStore().CacheMultiStore(), false, app.Logger(),
+	)
+	if err := beaconModule.SyncExecutionClient(ctx); err != nil {
+		app.Logger().Error(
+			"failed to sync execution client on startup", "error", err,
+		)
+	}
+}
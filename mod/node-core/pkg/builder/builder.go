@@ -38,7 +38,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/node"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/forkchoice"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -104,6 +106,8 @@ func (nb *NodeBuilder[NodeT]) buildRootCmd() (*cobra.Command, error) {
 				viper.GetViper(),
 				nb.chainSpec,
 				&depositdb.KVStore[*consensustypes.Deposit]{},
+				&forkchoice.KVStore{},
+				&block.KVStore[*consensustypes.BeaconBlock]{},
 				&engineclient.EngineClient[*consensustypes.ExecutionPayload]{},
 				&gokzg4844.JSONTrustedSetup{},
 				&noop.Verifier{},
@@ -121,11 +125,15 @@ func (nb *NodeBuilder[NodeT]) buildRootCmd() (*cobra.Command, error) {
 				components.ProvideKeyring,
 				components.ProvideConfig,
 				components.ProvideLocalBuilder,
+				components.ProvideProposerConfig,
+				components.ProvideRelayService,
 				components.ProvideStateProcessor,
 				components.ProvideExecutionEngine[*consensustypes.ExecutionPayload],
 				components.ProvideBlockFeed[*consensustypes.BeaconBlock],
 				components.ProvideDepositPruner,
+				components.ProvideBlockPruner,
 				components.ProvideAvailabilityPruner,
+				components.ProvideAvailabilityIntegrityChecker,
 				components.ProvideBlobProcessor[*consensustypes.BeaconBlockBody],
 				components.ProvideDBManager,
 				components.ProvideDepositService,
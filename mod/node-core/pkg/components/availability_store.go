@@ -26,9 +26,14 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
@@ -42,9 +47,12 @@ import (
 // function for the depinject framework.
 type AvailabilityStoreInput struct {
 	depinject.In
-	AppOpts   servertypes.AppOptions
-	ChainSpec primitives.ChainSpec
-	Logger    log.Logger
+	AppOpts           servertypes.AppOptions
+	BlobProofVerifier kzg.BlobProofVerifier
+	ChainSpec         primitives.ChainSpec
+	Config            *config.Config
+	Logger            log.Logger
+	TelemetrySink     *metrics.TelemetrySink
 }
 
 // ProvideAvailibilityStore provides the availability store.
@@ -53,54 +61,116 @@ func ProvideAvailibilityStore[
 ](
 	in AvailabilityStoreInput,
 ) (*dastore.Store[BeaconBlockBodyT], error) {
-	return dastore.New[BeaconBlockBodyT](
-		filedb.NewRangeDB(
-			filedb.NewDB(
-				filedb.WithRootDirectory(
-					cast.ToString(
-						in.AppOpts.Get(flags.FlagHome),
-					)+"/data/blobs",
-				),
-				filedb.WithFileExtension("ssz"),
-				filedb.WithDirectoryPermissions(os.ModePerm),
-				filedb.WithLogger(in.Logger),
-			),
-		),
-		in.Logger.With("service", "beacon-kit.da.store"),
+	return NewAvailabilityStore[BeaconBlockBodyT](
+		&in.Config.AvailabilityStore,
+		cast.ToString(in.AppOpts.Get(flags.FlagHome)),
 		in.ChainSpec,
+		in.BlobProofVerifier,
+		in.Logger,
+		in.TelemetrySink,
+	)
+}
+
+// NewAvailabilityStore creates the availability store that keeps its blob
+// sidecars in the data directory of the given home directory. The store is
+// locked for as long as the process runs, so that it is not opened by
+// another process at the same time.
+func NewAvailabilityStore[
+	BeaconBlockBodyT types.RawBeaconBlockBody,
+](
+	cfg *dastore.Config,
+	homeDir string,
+	chainSpec primitives.ChainSpec,
+	blobProofVerifier kzg.BlobProofVerifier,
+	logger log.Logger,
+	telemetrySink *metrics.TelemetrySink,
+) (*dastore.Store[BeaconBlockBodyT], error) {
+	db := filedb.NewDB(
+		filedb.WithRootDirectory(homeDir+"/data/blobs"),
+		filedb.WithFileExtension("ssz"),
+		filedb.WithDirectoryPermissions(os.ModePerm),
+		filedb.WithLogger(logger),
+	)
+	if err := db.Lock(); err != nil {
+		return nil, err
+	}
+
+	return dastore.New[BeaconBlockBodyT](
+		cfg,
+		filedb.NewRangeDB(db),
+		logger.With("service", "beacon-kit.da.store"),
+		chainSpec,
+		dablob.NewVerifier(blobProofVerifier, telemetrySink),
+		types.BlockBodyKZGOffset,
+		telemetrySink,
 	), nil
 }
 
+// AvailabilityIntegrityCheckerInput is the input for the
+// ProvideAvailabilityIntegrityChecker function for the depinject framework.
+type AvailabilityIntegrityCheckerInput struct {
+	depinject.In
+	AvailabilityStore *dastore.Store[*types.BeaconBlockBody]
+	BlockStore        *block.KVStore[*types.BeaconBlock]
+	Config            *config.Config
+	Logger            log.Logger
+	TelemetrySink     *metrics.TelemetrySink
+}
+
+// ProvideAvailabilityIntegrityChecker provides the availability store
+// integrity checker for the depinject framework.
+func ProvideAvailabilityIntegrityChecker(
+	in AvailabilityIntegrityCheckerInput,
+) *dastore.IntegrityChecker[*types.BeaconBlock, *types.BeaconBlockBody] {
+	return dastore.NewIntegrityChecker[
+		*types.BeaconBlock, *types.BeaconBlockBody,
+	](
+		&in.Config.AvailabilityStore,
+		in.AvailabilityStore,
+		in.BlockStore,
+		in.Logger.With("service", "da-integrity-checker"),
+		in.TelemetrySink,
+	)
+}
+
 // AvailabilityPrunerInput is the input for the ProviderAvailabilityPruner
 // function for the depinject framework.
 type AvailabilityPrunerInput struct {
 	depinject.In
+	Config            *config.Config
 	Logger            log.Logger
 	ChainSpec         primitives.ChainSpec
 	BlockFeed         *event.FeedOf[*feed.Event[*types.BeaconBlock]]
 	AvailabilityStore *dastore.Store[*types.BeaconBlockBody]
+	TelemetrySink     *metrics.TelemetrySink
 }
 
 // ProvideAvailabilityPruner provides a availability pruner for the depinject
 // framework.
 func ProvideAvailabilityPruner(
 	in AvailabilityPrunerInput,
-) pruner.Pruner[*filedb.RangeDB] {
-	rangeDB, _ := in.AvailabilityStore.IndexDB.(*filedb.RangeDB)
-	// build the availability pruner if IndexDB is available.
+) pruner.Pruner[*dastore.Store[*types.BeaconBlockBody]] {
+	// The store is pruned rather than its database, so that the pruning is
+	// serialized with the reads of the store.
 	return pruner.NewPruner[
 		*types.BeaconBlock,
 		*feed.Event[*types.BeaconBlock],
-		*filedb.RangeDB,
+		*dastore.Store[*types.BeaconBlockBody],
 		event.Subscription,
 	](
+		&in.Config.AvailabilityPruner,
 		in.Logger.With("service", manager.AvailabilityPrunerName),
-		rangeDB,
+		in.AvailabilityStore,
 		manager.AvailabilityPrunerName,
 		in.BlockFeed,
 		dastore.BuildPruneRangeFn[
 			*types.BeaconBlock,
 			*feed.Event[*types.BeaconBlock],
-		](in.ChainSpec),
+		](
+			&in.Config.AvailabilityStore,
+			in.ChainSpec,
+			in.Config.AvailabilityPruner.RetentionSlots,
+		),
+		in.TelemetrySink,
 	)
 }
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/spf13/cast"
+)
+
+// BlockStoreInput is the input for the dep inject framework.
+type BlockStoreInput struct {
+	depinject.In
+	AppOpts servertypes.AppOptions
+}
+
+// ProvideBlockStore provides the store of the recently finalized beacon
+// blocks, from which execution payloads are replayed to the execution client.
+func ProvideBlockStore(
+	in BlockStoreInput,
+) (*block.KVStore[*types.BeaconBlock], error) {
+	name := "blocks"
+	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
+	kvp, err := storev2.NewDB(storev2.DBTypePebbleDB, name, dir, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return block.NewStore[*types.BeaconBlock](&depositstore.KVStoreProvider{
+		KVStoreWithBatch: kvp,
+	}), nil
+}
+
+// BlockPrunerInput is the input for the block pruner.
+type BlockPrunerInput struct {
+	depinject.In
+	Config        *config.Config
+	Logger        log.Logger
+	BlockFeed     *event.FeedOf[*feed.Event[*types.BeaconBlock]]
+	BlockStore    *block.KVStore[*types.BeaconBlock]
+	TelemetrySink *metrics.TelemetrySink
+}
+
+// ProvideBlockPruner provides a block pruner for the depinject framework.
+func ProvideBlockPruner(
+	in BlockPrunerInput,
+) pruner.Pruner[*block.KVStore[*types.BeaconBlock]] {
+	return pruner.NewPruner[
+		*types.BeaconBlock,
+		*feed.Event[*types.BeaconBlock],
+		*block.KVStore[*types.BeaconBlock],
+		event.Subscription,
+	](
+		&in.Config.BlockPruner,
+		in.Logger.With("service", manager.BlockPrunerName),
+		in.BlockStore,
+		manager.BlockPrunerName,
+		in.BlockFeed,
+		block.BuildPruneRangeFn[
+			*types.BeaconBlock,
+			*feed.Event[*types.BeaconBlock],
+		](in.Config.BlockPruner.RetentionSlots),
+		in.TelemetrySink,
+	)
+}
@@ -24,9 +24,10 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
-	dastore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
-	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	"github.com/ethereum/go-ethereum/event"
@@ -36,8 +37,9 @@ import (
 type DBManagerInput struct {
 	depinject.In
 	Logger             log.Logger
-	DepositPruner      pruner.Pruner[*dastore.KVStore[*types.Deposit]]
-	AvailabilityPruner pruner.Pruner[*filedb.RangeDB]
+	DepositPruner      pruner.Pruner[*depositstore.KVStore[*types.Deposit]]
+	AvailabilityPruner pruner.Pruner[*dastore.Store[*types.BeaconBlockBody]]
+	BlockPruner        pruner.Pruner[*block.KVStore[*types.BeaconBlock]]
 }
 
 // ProvideDBManager provides a DBManager for the depinject framework.
@@ -55,5 +57,6 @@ func ProvideDBManager(
 		in.Logger.With("service", "db-manager"),
 		in.DepositPruner,
 		in.AvailabilityPruner,
+		in.BlockPruner,
 	)
 }
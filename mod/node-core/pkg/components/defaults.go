@@ -31,6 +31,9 @@ func DefaultComponentsWithStandardTypes() []any {
 		ProvideBlsSigner,
 		ProvideTrustedSetup,
 		ProvideDepositStore[*types.Deposit],
+		ProvideBlockStore,
+		ProvideForkchoiceStore,
+		ProvideSlashingProtectionDB,
 		ProvideConfig,
 		ProvideEngineClient[*types.ExecutionPayload],
 		ProvideJWTSecret,
@@ -45,10 +48,14 @@ func DefaultComponentsWithStandardTypes() []any {
 			types.WithdrawalCredentials,
 		],
 		ProvideLocalBuilder,
+		ProvideProposerConfig,
+		ProvideRelayService,
 		ProvideStateProcessor,
 		ProvideBlockFeed[*types.BeaconBlock],
 		ProvideDepositPruner,
+		ProvideBlockPruner,
 		ProvideAvailabilityPruner,
+		ProvideAvailabilityIntegrityChecker,
 		ProvideDBManager,
 		ProvideDepositService,
 	}
@@ -27,7 +27,10 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/interfaces"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
@@ -51,6 +54,7 @@ func ProvideDepositStore[
 		interfaces.SSZMarshallable
 		GetIndex() uint64
 		HashTreeRoot() ([32]byte, error)
+		DepositDataRoot() (common.Root, error)
 	},
 ](
 	in DepositStoreInput,
@@ -70,10 +74,12 @@ func ProvideDepositStore[
 // DepositPrunerInput is the input for the deposit pruner.
 type DepositPrunerInput struct {
 	depinject.In
-	Logger       log.Logger
-	ChainSpec    primitives.ChainSpec
-	BlockFeed    *event.FeedOf[*feed.Event[*types.BeaconBlock]]
-	DepositStore *depositstore.KVStore[*types.Deposit]
+	Config        *config.Config
+	Logger        log.Logger
+	ChainSpec     primitives.ChainSpec
+	BlockFeed     *event.FeedOf[*feed.Event[*types.BeaconBlock]]
+	DepositStore  *depositstore.KVStore[*types.Deposit]
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideDepositPruner provides a deposit pruner for the depinject framework.
@@ -86,8 +92,9 @@ func ProvideDepositPruner(
 		*depositstore.KVStore[*types.Deposit],
 		event.Subscription,
 	](
+		&in.Config.DepositPruner,
 		in.Logger.With("service", manager.DepositPrunerName),
-		in.DepositStore,
+		depositstore.NewSlotPrunable(in.DepositStore),
 		manager.DepositPrunerName,
 		in.BlockFeed,
 		deposit.BuildPruneRangeFn[
@@ -97,6 +104,7 @@ func ProvideDepositPruner(
 			*types.Deposit,
 			*types.ExecutionPayload,
 			types.WithdrawalCredentials,
-		](in.ChainSpec),
+		](in.Config.DepositPruner.RetentionSlots),
+		in.TelemetrySink,
 	)
 }
@@ -64,14 +64,31 @@ func ProvideEngineClient[
 	WithdrawalT any,
 ](
 	in EngineClientInputs,
-) *engineclient.EngineClient[ExecutionPayloadT] {
+) (*engineclient.EngineClient[ExecutionPayloadT], error) {
+	// Load the JWT secrets of the failover execution clients, the ones
+	// without a path fall back to the secret of the primary.
+	failoverJWTSecrets := make(
+		[]*jwt.Secret, len(in.Config.Engine.FailoverJWTSecretPaths),
+	)
+	for i, path := range in.Config.Engine.FailoverJWTSecretPaths {
+		if path == "" {
+			continue
+		}
+		secret, err := LoadJWTFromFile(path)
+		if err != nil {
+			return nil, err
+		}
+		failoverJWTSecrets[i] = secret
+	}
+
 	return engineclient.New[ExecutionPayloadT](
 		&in.Config.Engine,
 		in.Logger.With("service", "engine.client"),
 		in.JWTSecret,
+		failoverJWTSecrets,
 		in.TelemetrySink,
 		new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
-	)
+	), nil
 }
 
 // ExecutionEngineInput is the input for the execution engine for the depinject
@@ -21,6 +21,8 @@
 package beacon
 
 import (
+	"context"
+
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
@@ -38,7 +40,10 @@ import (
 	modulev1alpha1 "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module/api/module/v1alpha1"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
@@ -46,10 +51,13 @@ import (
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/forkchoice"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/ethereum/go-ethereum/event"
+	"github.com/spf13/cast"
 )
 
 // TODO: we don't allow generics here? Why? Is it fixable?
@@ -70,12 +78,16 @@ type DepInjectInput struct {
 	Environment appmodule.Environment
 
 	// BeaconKit components
-	AvailabilityStore     *dastore.Store[*types.BeaconBlockBody]
+	AvailabilityStore   *dastore.Store[*types.BeaconBlockBody]
+	AvailabilityChecker *dastore.IntegrityChecker[
+		*types.BeaconBlock, *types.BeaconBlockBody,
+	]
 	BeaconConfig          *config.Config
 	BeaconDepositContract *deposit.WrappedBeaconDepositContract[
 		*types.Deposit, types.WithdrawalCredentials,
 	]
 	BlockFeed     *event.FeedOf[*feed.Event[*types.BeaconBlock]]
+	BlockStore    *block.KVStore[*types.BeaconBlock]
 	BlobProcessor *dablobs.Processor[
 		*dastore.Store[*types.BeaconBlockBody],
 		*types.BeaconBlockBody,
@@ -98,11 +110,14 @@ type DepInjectInput struct {
 	]
 	ExecutionEngine *execution.Engine[*types.ExecutionPayload]
 	EngineClient    *engineclient.EngineClient[*types.ExecutionPayload]
+	ForkchoiceStore *forkchoice.KVStore
 	LocalBuilder    *payloadbuilder.PayloadBuilder[
 		components.BeaconState,
 		*types.ExecutionPayload,
 		*types.ExecutionPayloadHeader,
 	]
+	ProposerConfig *proposer.Store
+	RelayService   *relay.Service
 	Signer         crypto.BLSSigner
 	StateProcessor blockchain.StateProcessor[
 		*types.BeaconBlock,
@@ -124,6 +139,17 @@ type DepInjectOutput struct {
 func ProvideModule(in DepInjectInput) (DepInjectOutput, error) {
 	payloadCodec := &encoding.
 		SSZInterfaceCodec[*types.ExecutionPayloadHeader]{}
+	beaconStore, err := beacondb.New[
+		*types.Fork,
+		*types.BeaconBlockHeader,
+		*types.ExecutionPayloadHeader,
+		*types.Eth1Data,
+		*types.Validator,
+	](in.Environment.KVStoreService, payloadCodec)
+	if err != nil {
+		return DepInjectOutput{}, err
+	}
+
 	storageBackend := storage.NewBackend[
 		*dastore.Store[*types.BeaconBlockBody],
 		*types.BeaconBlock,
@@ -136,13 +162,7 @@ func ProvideModule(in DepInjectInput) (DepInjectOutput, error) {
 	](
 		in.ChainSpec,
 		in.AvailabilityStore,
-		beacondb.New[
-			*types.Fork,
-			*types.BeaconBlockHeader,
-			*types.ExecutionPayloadHeader,
-			*types.Eth1Data,
-			*types.Validator,
-		](in.Environment.KVStoreService, payloadCodec),
+		beaconStore,
 		in.DepositStore,
 	)
 
@@ -151,8 +171,22 @@ func ProvideModule(in DepInjectInput) (DepInjectOutput, error) {
 		in.BeaconConfig.KZG.Implementation = "crate-crypto/go-kzg-4844"
 	}
 
+	queryContexts := &components.QueryContexts{}
+	nodeAPIService := components.NewNodeAPIService(
+		in.BeaconConfig,
+		in.Environment.Logger.With("service", "node-api"),
+		in.ChainSpec,
+		storageBackend.BeaconStore(),
+		in.DepositStore,
+		in.RelayService,
+		in.ProposerConfig,
+		queryContexts,
+		cast.ToString(in.AppOpts.Get(flags.CometRPCListenAddress)),
+	)
+
 	runtime, err := components.ProvideRuntime(
 		in.BeaconConfig,
+		in.AvailabilityChecker,
 		in.BlobProcessor,
 		in.BlockFeed,
 		in.ChainSpec,
@@ -161,9 +195,14 @@ func ProvideModule(in DepInjectInput) (DepInjectOutput, error) {
 		in.Signer,
 		in.EngineClient,
 		in.ExecutionEngine,
+		in.ForkchoiceStore,
+		in.BlockStore,
 		in.StateProcessor,
 		storageBackend,
 		in.LocalBuilder,
+		in.ProposerConfig,
+		in.RelayService,
+		nodeAPIService,
 		in.TelemetrySink,
 		in.Environment.Logger.With("module", "beacon-kit"),
 	)
@@ -172,6 +211,13 @@ func ProvideModule(in DepInjectInput) (DepInjectOutput, error) {
 	}
 
 	return DepInjectOutput{
-		Module: NewAppModule(runtime),
+		Module: NewAppModule(runtime, func(ctx context.Context) error {
+			return components.PruneOnStartup(
+				in.BeaconConfig,
+				in.ChainSpec,
+				in.DBManager,
+				storageBackend.StateFromContext(ctx),
+			)
+		}, queryContexts),
 	}, nil
 }
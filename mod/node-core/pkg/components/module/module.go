@@ -48,17 +48,39 @@ var (
 // AppModule implements an application module for the evm module.
 type AppModule struct {
 	*components.BeaconKitRuntime
+	// pruneOnStartupFn prunes the stores based on the state of the given
+	// context.
+	pruneOnStartupFn func(context.Context) error
+	// queryContexts creates the query contexts the node API reads the
+	// committed state from.
+	queryContexts *components.QueryContexts
 }
 
 // NewAppModule creates a new AppModule object.
 func NewAppModule(
 	runtime *components.BeaconKitRuntime,
+	pruneOnStartupFn func(context.Context) error,
+	queryContexts *components.QueryContexts,
 ) AppModule {
 	return AppModule{
 		BeaconKitRuntime: runtime,
+		pruneOnStartupFn: pruneOnStartupFn,
+		queryContexts:    queryContexts,
 	}
 }
 
+// PruneOnStartup prunes the stores based on the state of the given context,
+// rather than waiting for the next finalized block.
+func (am AppModule) PruneOnStartup(ctx context.Context) error {
+	return am.pruneOnStartupFn(ctx)
+}
+
+// SetQueryContextFn sets the function creating the query contexts the node
+// API reads the committed state from.
+func (am AppModule) SetQueryContextFn(fn components.QueryContextFn) {
+	am.queryContexts.Set(fn)
+}
+
 // Name is the name of this module.
 func (am AppModule) Name() string {
 	return ModuleName
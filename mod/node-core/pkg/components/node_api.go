@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"context"
+	"sync/atomic"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/node-api/server/handlers"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// cometBFTValidatorsPerPage is the maximum number of validators returned by
+// a page of the validators endpoint of CometBFT.
+const cometBFTValidatorsPerPage = 100
+
+// errQueryContextUnavailable is returned when the node API is queried before
+// the app sets the function creating query contexts.
+var errQueryContextUnavailable = errors.New("query context is not available")
+
+// QueryContextFn creates a context reading the state committed at the given
+// height, or at the latest height if it is zero.
+type QueryContextFn = func(height int64, prove bool) (sdk.Context, error)
+
+// QueryContexts creates the query contexts the node API reads the committed
+// state from, once the app sets the function creating them. The app is only
+// built after the node API service.
+type QueryContexts struct {
+	fn atomic.Pointer[QueryContextFn]
+}
+
+// Set sets the function creating the query contexts.
+func (q *QueryContexts) Set(fn QueryContextFn) {
+	q.fn.Store(&fn)
+}
+
+// New creates a context reading the state committed at the given height.
+func (q *QueryContexts) New(height int64, prove bool) (sdk.Context, error) {
+	fn := q.fn.Load()
+	if fn == nil {
+		return sdk.Context{}, errQueryContextUnavailable
+	}
+	return (*fn)(height, prove)
+}
+
+// NewNodeAPIService builds the node API service, which reads the beacon state
+// from the given query contexts, forwards validator registrations to the
+// relays of the relay service, prepares the fee recipients of the proposer
+// config and reads the CometBFT validator set from the CometBFT RPC server at
+// cometRPCAddress.
+func NewNodeAPIService(
+	cfg *config.Config,
+	logger log.Logger,
+	chainSpec primitives.ChainSpec,
+	beaconStore *storage.KVStore,
+	depositStore *depositdb.KVStore[*types.Deposit],
+	relayService *relay.Service,
+	proposerConfig *proposer.Store,
+	queryContexts *QueryContexts,
+	cometRPCAddress string,
+) *server.Service {
+	return server.NewService(
+		cfg.NodeAPI,
+		logger,
+		handlers.RouteHandlers{Backend: backend.New(
+			func(context.Context, string) (backend.StateDB, error) {
+				// Only the latest committed state is available for now.
+				ctx, err := queryContexts.New(0, false)
+				if err != nil {
+					return nil, err
+				}
+				return beaconStore.WithContext(ctx), nil
+			},
+			func(context.Context) (*backend.DepositSnapshot, error) {
+				snapshot, err := depositStore.GetSnapshot()
+				if err != nil || snapshot == nil {
+					return nil, err
+				}
+				return &backend.DepositSnapshot{
+					Finalized:            snapshot.Finalized,
+					DepositRoot:          snapshot.DepositRoot,
+					DepositCount:         snapshot.DepositCount,
+					ExecutionBlockHash:   snapshot.ExecutionBlockHash,
+					ExecutionBlockHeight: snapshot.ExecutionBlockHeight,
+				}, nil
+			},
+			relayService.RegisterValidators,
+			func(
+				_ context.Context,
+				index math.ValidatorIndex,
+				feeRecipient common.ExecutionAddress,
+			) {
+				proposerConfig.Prepare(index, feeRecipient)
+			},
+			chainSpec,
+			newCometBFTValidatorsFn(cometRPCAddress),
+		)},
+	)
+}
+
+// newCometBFTValidatorsFn returns a function that fetches the validator set
+// of the given height, or of the latest height if the given one is ahead of
+// it, from the validators endpoint of the CometBFT RPC server at the given
+// address.
+func newCometBFTValidatorsFn(
+	rpcAddress string,
+) func(context.Context, math.Slot) (math.Slot, []*cmttypes.Validator, error) {
+	if rpcAddress == "" {
+		rpcAddress = cmtcfg.DefaultRPCConfig().ListenAddress
+	}
+	client, clientErr := rpchttp.New(rpcAddress)
+	return func(
+		ctx context.Context,
+		height math.Slot,
+	) (math.Slot, []*cmttypes.Validator, error) {
+		if clientErr != nil {
+			return 0, nil, errors.Wrap(
+				clientErr, "invalid cometbft rpc address",
+			)
+		}
+		status, err := client.Status(ctx)
+		if err != nil {
+			return 0, nil, err
+		}
+		//#nosec:G701 // heights are never negative.
+		cmtHeight := min(
+			int64(height.Unwrap()), status.SyncInfo.LatestBlockHeight,
+		)
+
+		var (
+			validators []*cmttypes.Validator
+			perPage    = cometBFTValidatorsPerPage
+		)
+		for page := 1; ; page++ {
+			res, vErr := client.Validators(ctx, &cmtHeight, &page, &perPage)
+			if vErr != nil {
+				return 0, nil, vErr
+			}
+			validators = append(validators, res.Validators...)
+			if res.Count == 0 || len(validators) >= res.Total {
+				//#nosec:G701 // heights are never negative.
+				return math.Slot(res.BlockHeight), validators, nil
+			}
+		}
+	}
+}
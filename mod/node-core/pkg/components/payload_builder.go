@@ -29,7 +29,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -39,6 +41,8 @@ type LocalBuilderInput struct {
 	ChainSpec       primitives.ChainSpec
 	Logger          log.Logger
 	ExecutionEngine *execution.Engine[*types.ExecutionPayload]
+	ProposerConfig  *proposer.Store
+	Signer          crypto.BLSSigner
 }
 
 func ProvideLocalBuilder(
@@ -53,6 +57,8 @@ func ProvideLocalBuilder(
 		in.ChainSpec,
 		in.Logger.With("service", "payload-builder"),
 		in.ExecutionEngine,
+		in.ProposerConfig,
+		in.Signer,
 		cache.NewPayloadIDCache[engineprimitives.PayloadID, [32]byte, math.Slot](),
 	)
 }
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ProposerConfigInput is the input for the ProvideProposerConfig function
+// for the depinject framework.
+type ProposerConfigInput struct {
+	depinject.In
+	Config *config.Config
+	Logger log.Logger
+}
+
+// ProvideProposerConfig provides the store of the preferences of the
+// proposers for the depinject framework.
+func ProvideProposerConfig(in ProposerConfigInput) (*proposer.Store, error) {
+	return proposer.NewStore(
+		&in.Config.ProposerConfig,
+		in.Logger.With("service", "proposer-config"),
+		proposer.Preferences{
+			FeeRecipient: in.Config.PayloadBuilder.SuggestedFeeRecipient,
+			GasLimit:     math.U64(in.Config.Relay.GasLimit),
+		},
+	)
+}
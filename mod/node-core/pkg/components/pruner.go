@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// PruneOnStartup runs an immediate prune pass of every enabled pruner based
+// on the given head state, rather than waiting for the next finalized block.
+func PruneOnStartup(
+	cfg *config.Config,
+	chainSpec primitives.ChainSpec,
+	dbManager *manager.DBManager[
+		*types.BeaconBlock,
+		*feed.Event[*types.BeaconBlock],
+		event.Subscription,
+	],
+	st BeaconState,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	if cfg.AvailabilityPruner.Enabled {
+		_, end := dastore.PruneRange(
+			&cfg.AvailabilityStore,
+			chainSpec,
+			cfg.AvailabilityPruner.RetentionSlots,
+			slot,
+		)
+		if err = dbManager.Prune(
+			manager.AvailabilityPrunerName, 0, end,
+		); err != nil {
+			return err
+		}
+	}
+
+	if cfg.BlockPruner.Enabled {
+		_, end := block.PruneRange(cfg.BlockPruner.RetentionSlots, slot)
+		if err = dbManager.Prune(manager.BlockPrunerName, 0, end); err != nil {
+			return err
+		}
+	}
+
+	if cfg.DepositPruner.Enabled {
+		_, end := deposit.PruneRange(cfg.DepositPruner.RetentionSlots, slot)
+		if err = dbManager.Prune(
+			manager.DepositPrunerName, 0, end,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -32,10 +32,13 @@ import (
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	execution "github.com/berachain/beacon-kit/mod/execution/pkg/engine"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/version"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
@@ -43,7 +46,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/forkchoice"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	sdkversion "github.com/cosmos/cosmos-sdk/version"
 	"github.com/ethereum/go-ethereum/event"
@@ -79,6 +84,9 @@ type BeaconKitRuntime = runtime.BeaconKitRuntime[
 //nolint:funlen // bullish.
 func ProvideRuntime(
 	cfg *config.Config,
+	availabilityChecker *dastore.IntegrityChecker[
+		*types.BeaconBlock, *types.BeaconBlockBody,
+	],
 	blobProcessor *dablob.Processor[
 		*dastore.Store[*types.BeaconBlockBody],
 		*types.BeaconBlockBody,
@@ -102,6 +110,8 @@ func ProvideRuntime(
 	signer crypto.BLSSigner,
 	engineClient *engineclient.EngineClient[*types.ExecutionPayload],
 	executionEngine *execution.Engine[*types.ExecutionPayload],
+	forkchoiceStore *forkchoice.KVStore,
+	blockStore *block.KVStore[*types.BeaconBlock],
 	stateProcessor blockchain.StateProcessor[
 		*types.BeaconBlock,
 		BeaconState,
@@ -120,9 +130,37 @@ func ProvideRuntime(
 	localBuilder *payloadbuilder.PayloadBuilder[
 		BeaconState, *types.ExecutionPayload, *types.ExecutionPayloadHeader,
 	],
+	proposerConfig *proposer.Store,
+	relayService *relay.Service,
+	nodeAPIService *server.Service,
 	telemetrySink *metrics.TelemetrySink,
 	logger log.Logger,
 ) (*BeaconKitRuntime, error) {
+	// Build the blockchain service.
+	chainService := blockchain.NewService[
+		*dastore.Store[*types.BeaconBlockBody],
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		BeaconState,
+		*datypes.BlobSidecars,
+		*depositdb.KVStore[*types.Deposit],
+	](
+		storageBackend,
+		logger.With("service", "blockchain"),
+		chainSpec,
+		executionEngine,
+		forkchoiceStore,
+		blockStore,
+		block.AvailabilityWindow(cfg.BlockPruner.RetentionSlots),
+		localBuilder,
+		blobProcessor,
+		stateProcessor,
+		telemetrySink,
+		blockFeed,
+		// If optimistic is enabled, we want to skip post finalization FCUs.
+		cfg.Validator.EnableOptimisticPayloadBuilds,
+	)
+
 	// Build the builder service.
 	validatorService := validator.NewService[
 		*types.BeaconBlock,
@@ -147,34 +185,23 @@ func ProvideRuntime(
 			types.KZGPositionDeneb,
 			telemetrySink,
 		),
+		chainService,
+		chainService,
 		localBuilder,
 		[]validator.PayloadBuilder[BeaconState, *types.ExecutionPayload]{
 			localBuilder,
 		},
+		relayService,
+		validator.NewGraffitiBuilder(
+			&cfg.Validator,
+			logger.With("service", "graffiti"),
+			sdkversion.Version,
+			sdkversion.Commit,
+			engineClient,
+		),
 		telemetrySink,
 	)
 
-	// Build the blockchain service.
-	chainService := blockchain.NewService[
-		*dastore.Store[*types.BeaconBlockBody],
-		*types.BeaconBlock,
-		*types.BeaconBlockBody,
-		BeaconState,
-		*datypes.BlobSidecars,
-		*depositdb.KVStore[*types.Deposit],
-	](
-		storageBackend,
-		logger.With("service", "blockchain"),
-		chainSpec,
-		executionEngine,
-		localBuilder,
-		blobProcessor,
-		stateProcessor,
-		telemetrySink,
-		blockFeed,
-		// If optimistic is enabled, we want to skip post finalization FCUs.
-		cfg.Validator.EnableOptimisticPayloadBuilds,
-	)
 	// Build the service registry.
 	svcRegistry := service.NewRegistry(
 		service.WithLogger(logger.With("service", "service-registry")),
@@ -188,6 +215,10 @@ func ProvideRuntime(
 			sdkversion.Version,
 		)),
 		service.WithService(dbManagerService),
+		service.WithService(availabilityChecker),
+		service.WithService(proposerConfig),
+		service.WithService(relayService),
+		service.WithService(nodeAPIService),
 	)
 
 	// Pass all the services and options into the BeaconKitRuntime.
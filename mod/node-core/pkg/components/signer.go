@@ -22,9 +22,12 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/slashingprotection"
 	clientFlags "github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/spf13/cast"
@@ -34,7 +37,12 @@ import (
 type BlsSignerInput struct {
 	depinject.In
 	AppOpts servertypes.AppOptions
-	PrivKey LegacyKey `optional:"true"`
+	// Cfg selects the signer, the local one if not provided.
+	Cfg     *config.Config `optional:"true"`
+	PrivKey LegacyKey      `optional:"true"`
+	// SlashingProtectionDB guards the signer against signing slashable
+	// messages, if provided.
+	SlashingProtectionDB *slashingprotection.KVStore `optional:"true"`
 }
 
 // type alias to LegacyKey used for LegacySinger construction.
@@ -42,15 +50,44 @@ type LegacyKey = signer.LegacyKey
 
 // ProvideBlsSigner is a function that provides the module to the application.
 func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
-	if in.PrivKey == [constants.BLSSecretKeyLength]byte{} {
-		// if no private key is provided, use privval signer
+	var (
+		blsSigner crypto.BLSSigner
+		err       error
+	)
+	switch {
+	case in.PrivKey != [constants.BLSSecretKeyLength]byte{}:
+		// a provided private key overrides the configured signer
+		if blsSigner, err = signer.NewLegacySigner(in.PrivKey); err != nil {
+			return nil, err
+		}
+	case in.Cfg == nil || in.Cfg.Signer.Type == "" ||
+		in.Cfg.Signer.Type == signer.TypeLocal:
+		// use the privval signer of the node
 		homeDir := cast.ToString(in.AppOpts.Get(clientFlags.FlagHome))
-		return signer.NewBLSSigner(
+		blsSigner = signer.NewBLSSigner(
 			homeDir+"/config/priv_validator_key.json",
 			homeDir+"/data/priv_validator_state.json",
-		), nil
+		)
+	case in.Cfg.Signer.Type == signer.TypeKeystore:
+		if blsSigner, err = signer.NewKeystoreSigner(
+			in.Cfg.Signer.Keystore, in.Cfg.Signer.KeystorePasswordFile,
+		); err != nil {
+			return nil, err
+		}
+	case in.Cfg.Signer.Type == signer.TypeRemote:
+		if blsSigner, err = signer.NewRemoteSigner(in.Cfg.Signer); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Wrapf(
+			signer.ErrUnknownSignerType, "%s", in.Cfg.Signer.Type,
+		)
 	}
-	return signer.NewLegacySigner(in.PrivKey)
+
+	if in.SlashingProtectionDB == nil {
+		return blsSigner, nil
+	}
+	return signer.NewProtectedSigner(blsSigner, in.SlashingProtectionDB), nil
 }
 
 func GetLegacyKey(privKey string) (LegacyKey, error) {
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"crypto/rand"
+	"sync/atomic"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+const (
+	// randBits is the number of random bits of the scalars the signatures
+	// of a batch are combined with. An invalid batch passes verification
+	// with a probability of 2^-randBits.
+	randBits = 64
+	// minBatchSize is the smallest batch that is verified as a whole, the
+	// signatures of smaller batches are cheaper to verify one by one.
+	minBatchSize = 2
+)
+
+// dst is the domain separation tag of the signatures of the beacon chain.
+//
+//nolint:gochecknoglobals // read-only.
+var dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// VerifySignatures verifies the signatures against the messages and the
+// public keys of the same index at once. The signatures are combined with
+// random scalars, so that invalid signatures cannot cancel each other out,
+// and the batch costs a single final exponentiation rather than one per
+// signature. It fails if any signature is invalid, without identifying it.
+func (f BLSSigner) VerifySignatures(
+	pubkeys []crypto.BLSPubkey,
+	msgs [][]byte,
+	signatures []crypto.BLSSignature,
+) error {
+	n := len(pubkeys)
+	if len(msgs) != n || len(signatures) != n {
+		return ErrBatchLengthMismatch
+	} else if n < minBatchSize {
+		for i := range n {
+			if err := f.VerifySignature(
+				pubkeys[i], msgs[i], signatures[i],
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var (
+		pks  = make([]*blst.P1Affine, n)
+		sigs = make([]*blst.P2Affine, n)
+		bmsg = make([]blst.Message, n)
+	)
+	for i := range n {
+		// The public keys and signatures are validated by the verification.
+		if pks[i] = new(blst.P1Affine).Uncompress(pubkeys[i][:]); pks[i] == nil {
+			return ErrInvalidPubkey
+		}
+		if sigs[i] = new(blst.P2Affine).Uncompress(
+			signatures[i][:],
+		); sigs[i] == nil {
+			return ErrInvalidSignature
+		}
+		bmsg[i] = msgs[i]
+	}
+
+	// The scalars are drawn concurrently by the verification.
+	var randFailed atomic.Bool
+	randFn := func(s *blst.Scalar) {
+		// Only the low randBits of the scalar are used, but blst requires
+		// the input to be a full scalar.
+		var b [blst.BLST_SCALAR_BYTES]byte
+		if _, err := rand.Read(b[:]); err != nil || s.FromBEndian(b[:]) == nil {
+			randFailed.Store(true)
+		}
+	}
+	if !new(blst.P2Affine).MultipleAggregateVerify(
+		sigs, true, pks, true, bmsg, dst, randFn, randBits,
+	) || randFailed.Load() {
+		return ErrInvalidSignature
+	}
+	return nil
+}
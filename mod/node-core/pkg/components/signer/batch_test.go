@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// signedBatch returns n messages signed by n distinct keys.
+func signedBatch(tb testing.TB, n int) (
+	[]crypto.BLSPubkey, [][]byte, []crypto.BLSSignature,
+) {
+	tb.Helper()
+	var (
+		pubkeys    = make([]crypto.BLSPubkey, n)
+		msgs       = make([][]byte, n)
+		signatures = make([]crypto.BLSSignature, n)
+	)
+	for i := range n {
+		local, err := signer.NewLegacySigner(
+			signer.LegacyKey{byte(i >> 8), byte(i), 0x01},
+		)
+		require.NoError(tb, err)
+		pubkeys[i] = local.PublicKey()
+		msgs[i] = []byte(fmt.Sprintf("deposit %d", i))
+		signatures[i], err = local.Sign(msgs[i])
+		require.NoError(tb, err)
+	}
+	return pubkeys, msgs, signatures
+}
+
+func TestBLSSigner_VerifySignatures(t *testing.T) {
+	var verifier signer.BLSSigner
+
+	t.Run("valid", func(t *testing.T) {
+		for _, n := range []int{0, 1, 2, 16} {
+			pubkeys, msgs, signatures := signedBatch(t, n)
+			require.NoError(
+				t, verifier.VerifySignatures(pubkeys, msgs, signatures),
+			)
+		}
+	})
+
+	t.Run("swapped signatures", func(t *testing.T) {
+		pubkeys, msgs, signatures := signedBatch(t, 8)
+		signatures[2], signatures[5] = signatures[5], signatures[2]
+		require.ErrorIs(
+			t,
+			verifier.VerifySignatures(pubkeys, msgs, signatures),
+			signer.ErrInvalidSignature,
+		)
+	})
+
+	t.Run("wrong message", func(t *testing.T) {
+		pubkeys, msgs, signatures := signedBatch(t, 8)
+		msgs[7] = []byte("forged")
+		require.ErrorIs(
+			t,
+			verifier.VerifySignatures(pubkeys, msgs, signatures),
+			signer.ErrInvalidSignature,
+		)
+	})
+
+	t.Run("invalid pubkey", func(t *testing.T) {
+		pubkeys, msgs, signatures := signedBatch(t, 4)
+		pubkeys[1] = crypto.BLSPubkey{0x01}
+		require.ErrorIs(
+			t,
+			verifier.VerifySignatures(pubkeys, msgs, signatures),
+			signer.ErrInvalidPubkey,
+		)
+	})
+
+	t.Run("length mismatch", func(t *testing.T) {
+		pubkeys, msgs, signatures := signedBatch(t, 4)
+		require.ErrorIs(
+			t,
+			verifier.VerifySignatures(pubkeys, msgs[:3], signatures),
+			signer.ErrBatchLengthMismatch,
+		)
+	})
+}
+
+func BenchmarkVerifySignatures(b *testing.B) {
+	var verifier signer.BLSSigner
+	for _, n := range []int{1, 16, 64, 256} {
+		pubkeys, msgs, signatures := signedBatch(b, n)
+
+		b.Run(fmt.Sprintf("individual/%d", n), func(b *testing.B) {
+			for range b.N {
+				for i := range n {
+					if err := verifier.VerifySignature(
+						pubkeys[i], msgs[i], signatures[i],
+					); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("batch/%d", n), func(b *testing.B) {
+			for range b.N {
+				if err := verifier.VerifySignatures(
+					pubkeys, msgs, signatures,
+				); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
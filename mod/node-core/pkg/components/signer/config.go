@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import "time"
+
+const (
+	// TypeLocal selects the signer of the key files of the node.
+	TypeLocal = "local"
+	// TypeKeystore selects the signer of an EIP-2335 keystore.
+	TypeKeystore = "keystore"
+	// TypeRemote selects a remote signer.
+	TypeRemote = "remote"
+)
+
+const (
+	// defaultTimeout is the default timeout of the requests to the remote
+	// signer.
+	defaultTimeout = 2 * time.Second
+	// defaultRetries is the default number of times a failed request to the
+	// remote signer is retried.
+	defaultRetries = 2
+)
+
+// Config is the configuration of the BLS signer.
+//
+//nolint:lll // struct tags.
+type Config struct {
+	// Type selects the signer, either local, keystore or remote.
+	Type string `mapstructure:"type"`
+	// Keystore is the path of the EIP-2335 keystore holding the key of the
+	// validator.
+	Keystore string `mapstructure:"keystore"`
+	// KeystorePasswordFile is the path of the file holding the password of
+	// the keystore.
+	KeystorePasswordFile string `mapstructure:"keystore-password-file"`
+	// URL is the URL of the Web3Signer compatible remote signer.
+	URL string `mapstructure:"url"`
+	// PublicKey is the public key of the validator, whose key is held by
+	// the remote signer.
+	PublicKey string `mapstructure:"public-key"`
+	// Timeout is the timeout of a request to the remote signer.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// Retries is the number of times a request to the remote signer is
+	// retried after failing to reach it, or after a server error.
+	Retries uint64 `mapstructure:"retries"`
+	// TLSCACert is the path of the CA certificate the certificate of the
+	// remote signer is verified against, the system pool if empty.
+	TLSCACert string `mapstructure:"tls-ca-cert"`
+	// TLSClientCert is the path of the certificate the node authenticates
+	// with to the remote signer, if any.
+	TLSClientCert string `mapstructure:"tls-client-cert"`
+	// TLSClientKey is the path of the key of the client certificate.
+	TLSClientKey string `mapstructure:"tls-client-key"`
+}
+
+// DefaultConfig returns the default signer configuration.
+func DefaultConfig() Config {
+	return Config{
+		Type:    TypeLocal,
+		Timeout: defaultTimeout,
+		Retries: defaultRetries,
+	}
+}
@@ -27,6 +27,15 @@ var (
 	// ErrInvalidSignature is returned when a signature is invalid.
 	ErrInvalidSignature = errors.New("invalid BLS signature")
 
+	// ErrBatchLengthMismatch is returned when the public keys, messages and
+	// signatures of a batch verification are not as many.
+	ErrBatchLengthMismatch = errors.New(
+		"public keys, messages and signatures lengths differ",
+	)
+
+	// ErrInvalidPubkey is returned when a public key is malformed.
+	ErrInvalidPubkey = errors.New("invalid BLS public key")
+
 	// ErrValidatorPrivateKeyRequired is returned when the validator private key
 	// is required but not provided.
 	ErrValidatorPrivateKeyRequired = errors.New(
@@ -37,4 +46,45 @@ var (
 	ErrInvalidValidatorPrivateKeyLength = errors.New(
 		"invalid validator private key length",
 	)
+
+	// ErrUnknownSignerType is returned when the configured signer type is
+	// neither local nor remote.
+	ErrUnknownSignerType = errors.New("unknown signer type")
+
+	// ErrKeystorePubkeyMismatch is returned when the secret key of a
+	// keystore does not match its public key.
+	ErrKeystorePubkeyMismatch = errors.New(
+		"keystore secret key does not match its public key",
+	)
+
+	// ErrRemoteSignerURLRequired is returned when the remote signer is
+	// selected without a URL.
+	ErrRemoteSignerURLRequired = errors.New("remote signer url required")
+
+	// ErrInvalidRemoteSignerPublicKey is returned when the public key of the
+	// remote signer is missing or malformed.
+	ErrInvalidRemoteSignerPublicKey = errors.New(
+		"invalid remote signer public key",
+	)
+
+	// ErrInvalidCACert is returned when the CA certificate of the remote
+	// signer holds no valid certificate.
+	ErrInvalidCACert = errors.New("invalid CA certificate")
+
+	// ErrUntypedSigningRequest is returned when the remote signer is asked
+	// to sign a message whose type is unknown.
+	ErrUntypedSigningRequest = errors.New(
+		"remote signer only signs typed requests",
+	)
+
+	// ErrUnprotectedSigningRequest is returned when the slashing protected
+	// signer is asked to sign a message whose type is unknown, which thus
+	// cannot be checked against the slashing protection database.
+	ErrUnprotectedSigningRequest = errors.New(
+		"slashing protected signer only signs typed requests",
+	)
+
+	// ErrRemoteSignerStatus is returned when the remote signer answers with
+	// an unexpected HTTP status.
+	ErrRemoteSignerStatus = errors.New("unexpected status from remote signer")
 )
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"bytes"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/keystore"
+)
+
+// NewKeystoreSigner creates a new signer of the secret key of an EIP-2335
+// keystore, decrypted with the password of the given file.
+func NewKeystoreSigner(
+	keystorePath string,
+	passwordFile string,
+) (*LegacySigner, error) {
+	ks, err := keystore.Load(keystorePath)
+	if err != nil {
+		return nil, err
+	}
+	password, err := keystore.ReadPasswordFile(passwordFile)
+	if err != nil {
+		return nil, err
+	}
+	secretKey, err := ks.Decrypt(password)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := NewLegacySigner(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	if pubkey := signer.PublicKey(); len(ks.Pubkey) > 0 &&
+		!bytes.Equal(ks.Pubkey, pubkey[:]) {
+		return nil, ErrKeystorePubkeyMismatch
+	}
+	return signer, nil
+}
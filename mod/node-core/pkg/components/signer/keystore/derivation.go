@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystore
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/cosmos/go-bip39"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// mnemonicEntropyBits is the entropy of a generated mnemonic, which
+	// makes it 24 words long.
+	mnemonicEntropyBits = 256
+	// minSeedLength is the minimum length of a seed, as per EIP-2333.
+	minSeedLength = 32
+	// okmLength is the length of the keying material a secret key is
+	// reduced from, as per EIP-2333.
+	okmLength = 48
+	// lamportChunks is the number of chunks of a Lamport secret key.
+	lamportChunks = 255
+	// keygenSalt is the initial salt of the secret key derivation.
+	keygenSalt = "BLS-SIG-KEYGEN-SALT-"
+)
+
+// curveOrder is the order r of the BLS12-381 curve.
+//
+//nolint:gochecknoglobals // constant.
+var curveOrder, _ = new(big.Int).SetString(
+	"73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16,
+)
+
+// SigningKeyPath returns the EIP-2334 path of the signing key of the
+// validator of the given index.
+func SigningKeyPath(index uint32) string {
+	return fmt.Sprintf("m/12381/3600/%d/0/0", index)
+}
+
+// NewMnemonic generates a new 24 words BIP-39 mnemonic.
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(mnemonicEntropyBits)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// SeedFromMnemonic returns the BIP-39 seed of the given mnemonic, with an
+// empty passphrase.
+func SeedFromMnemonic(mnemonic string) ([]byte, error) {
+	return bip39.NewSeedWithErrorChecking(
+		strings.Join(strings.Fields(mnemonic), " "), "",
+	)
+}
+
+// DeriveSecretKey derives the secret key of the given EIP-2334 path, e.g.
+// m/12381/3600/0/0/0, from a seed as per EIP-2333.
+func DeriveSecretKey(seed []byte, path string) ([32]byte, error) {
+	nodes := strings.Split(path, "/")
+	if len(nodes) == 0 || nodes[0] != "m" {
+		return [32]byte{}, errors.Wrapf(ErrInvalidPath, "%s", path)
+	}
+	sk, err := DeriveMasterSK(seed)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	for _, node := range nodes[1:] {
+		index, err := strconv.ParseUint(node, 10, 32)
+		if err != nil {
+			return [32]byte{}, errors.Wrapf(ErrInvalidPath, "%s", path)
+		}
+		sk = DeriveChildSK(sk, uint32(index))
+	}
+	return sk, nil
+}
+
+// DeriveMasterSK derives the master secret key of a seed, as per EIP-2333.
+func DeriveMasterSK(seed []byte) ([32]byte, error) {
+	if len(seed) < minSeedLength {
+		return [32]byte{}, ErrSeedTooShort
+	}
+	return hkdfModR(seed), nil
+}
+
+// DeriveChildSK derives the child secret key of the given index of a parent
+// secret key, as per EIP-2333.
+func DeriveChildSK(parentSK [32]byte, index uint32) [32]byte {
+	return hkdfModR(parentSKToLamportPK(parentSK, index))
+}
+
+// hkdfModR derives a secret key from the given keying material.
+func hkdfModR(ikm []byte) [32]byte {
+	var (
+		salt = []byte(keygenSalt)
+		okm  = make([]byte, okmLength)
+		sk   = new(big.Int)
+	)
+	ikm = append(append([]byte(nil), ikm...), 0)
+	for sk.Sign() == 0 {
+		digest := sha256.Sum256(salt)
+		salt = digest[:]
+		prk := hkdf.Extract(sha256.New, ikm, salt)
+		//nolint:mnd // I2OSP(L, 2).
+		info := []byte{0, okmLength}
+		if _, err := io.ReadFull(
+			hkdf.Expand(sha256.New, prk, info), okm,
+		); err != nil {
+			panic(err) // the output is much shorter than the limit
+		}
+		sk.Mod(new(big.Int).SetBytes(okm), curveOrder)
+	}
+	var out [32]byte
+	sk.FillBytes(out[:])
+	return out
+}
+
+// parentSKToLamportPK returns the compressed Lamport public key of the
+// given index of a parent secret key.
+func parentSKToLamportPK(parentSK [32]byte, index uint32) []byte {
+	salt := binary.BigEndian.AppendUint32(nil, index)
+	notIKM := parentSK
+	for i := range notIKM {
+		notIKM[i] = ^notIKM[i]
+	}
+
+	h := sha256.New()
+	for _, ikm := range [][32]byte{parentSK, notIKM} {
+		for _, chunk := range ikmToLamportSK(ikm[:], salt) {
+			digest := sha256.Sum256(chunk)
+			h.Write(digest[:])
+		}
+	}
+	return h.Sum(nil)
+}
+
+// ikmToLamportSK returns the chunks of the Lamport secret key of the given
+// keying material.
+func ikmToLamportSK(ikm, salt []byte) [][]byte {
+	okm := make([]byte, lamportChunks*sha256.Size)
+	if _, err := io.ReadFull(
+		hkdf.New(sha256.New, ikm, salt, nil), okm,
+	); err != nil {
+		panic(err) // the output is shorter than the limit
+	}
+	chunks := make([][]byte, lamportChunks)
+	for i := range chunks {
+		chunks[i] = okm[i*sha256.Size : (i+1)*sha256.Size]
+	}
+	return chunks
+}
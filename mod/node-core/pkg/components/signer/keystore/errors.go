@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystore
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidPassword is returned when a keystore is decrypted with the
+	// wrong password.
+	ErrInvalidPassword = errors.New("invalid keystore password")
+
+	// ErrUnsupportedKeystore is returned when a keystore uses a version or
+	// a cryptographic module that is not supported.
+	ErrUnsupportedKeystore = errors.New("unsupported keystore")
+
+	// ErrInvalidKeystoreParam is returned when a parameter of a keystore
+	// module is missing or malformed.
+	ErrInvalidKeystoreParam = errors.New("invalid keystore parameter")
+
+	// ErrSeedTooShort is returned when deriving keys from a seed shorter
+	// than 32 bytes.
+	ErrSeedTooShort = errors.New("seed shorter than 32 bytes")
+
+	// ErrInvalidPath is returned when a derivation path is malformed.
+	ErrInvalidPath = errors.New("invalid derivation path")
+)
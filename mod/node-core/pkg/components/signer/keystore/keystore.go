@@ -0,0 +1,356 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// version is the version of the EIP-2335 keystore format.
+	version = 4
+
+	kdfScrypt     = "scrypt"
+	kdfPBKDF2     = "pbkdf2"
+	prfHMACSHA256 = "hmac-sha256"
+	checksumSHA   = "sha256"
+	cipherAES     = "aes-128-ctr"
+
+	// keyLength is the length of the decryption key.
+	keyLength = 32
+	// saltLength is the length of the salt of a new keystore.
+	saltLength = 32
+)
+
+// ScryptParams are the parameters of the scrypt key derivation function of
+// new keystores.
+type ScryptParams struct {
+	N int
+	R int
+	P int
+}
+
+// DefaultScryptParams are the scrypt parameters recommended by EIP-2335.
+//
+//nolint:gochecknoglobals // default parameters.
+var DefaultScryptParams = ScryptParams{N: 1 << 18, R: 8, P: 1}
+
+// Keystore is an EIP-2335 keystore, holding a BLS secret key encrypted with
+// a password.
+type Keystore struct {
+	Crypto      Crypto   `json:"crypto"`
+	Description string   `json:"description"`
+	Pubkey      hexBytes `json:"pubkey"`
+	Path        string   `json:"path"`
+	UUID        string   `json:"uuid"`
+	Version     int      `json:"version"`
+}
+
+// Crypto are the modules of a keystore.
+type Crypto struct {
+	KDF      Module `json:"kdf"`
+	Checksum Module `json:"checksum"`
+	Cipher   Module `json:"cipher"`
+}
+
+// Module is a cryptographic module of a keystore.
+type Module struct {
+	Function string         `json:"function"`
+	Params   map[string]any `json:"params"`
+	Message  hexBytes       `json:"message"`
+}
+
+// hexBytes are bytes encoded as hex without prefix, as in keystores.
+type hexBytes []byte
+
+// MarshalText implements encoding.TextMarshaler.
+func (b hexBytes) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(b)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *hexBytes) UnmarshalText(text []byte) error {
+	bz, err := hex.DecodeString(strings.TrimPrefix(string(text), "0x"))
+	if err != nil {
+		return err
+	}
+	*b = bz
+	return nil
+}
+
+// Encrypt encrypts the secret key of the given public key and EIP-2334
+// path with a password, using scrypt with the given parameters.
+func Encrypt(
+	secretKey [32]byte,
+	pubkey crypto.BLSPubkey,
+	path string,
+	password string,
+	params ScryptParams,
+) (*Keystore, error) {
+	salt := make([]byte, saltLength)
+	iv := make([]byte, aes.BlockSize)
+	uuid := make([]byte, 16) //nolint:mnd // 128 bits.
+	for _, bz := range [][]byte{salt, iv, uuid} {
+		if _, err := rand.Read(bz); err != nil {
+			return nil, err
+		}
+	}
+
+	kdf := Module{
+		Function: kdfScrypt,
+		Params: map[string]any{
+			"dklen": keyLength,
+			"n":     params.N,
+			"r":     params.R,
+			"p":     params.P,
+			"salt":  hex.EncodeToString(salt),
+		},
+		Message: hexBytes{},
+	}
+	key, err := deriveKey(kdf, password)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := aesCTR(key[:16], iv, secretKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// Set the version and variant bits of a random UUID.
+	uuid[6] = uuid[6]&0x0f | 0x40
+	uuid[8] = uuid[8]&0x3f | 0x80
+	return &Keystore{
+		Crypto: Crypto{
+			KDF: kdf,
+			Checksum: Module{
+				Function: checksumSHA,
+				Params:   map[string]any{},
+				Message:  checksum(key, ciphertext),
+			},
+			Cipher: Module{
+				Function: cipherAES,
+				Params:   map[string]any{"iv": hex.EncodeToString(iv)},
+				Message:  ciphertext,
+			},
+		},
+		Pubkey: pubkey[:],
+		Path:   path,
+		UUID: fmt.Sprintf("%x-%x-%x-%x-%x",
+			uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:],
+		),
+		Version: version,
+	}, nil
+}
+
+// Decrypt decrypts the secret key of the keystore with a password.
+func (ks *Keystore) Decrypt(password string) ([32]byte, error) {
+	if ks.Version != version {
+		return [32]byte{}, errors.Wrapf(
+			ErrUnsupportedKeystore, "version %d", ks.Version,
+		)
+	}
+	if ks.Crypto.Checksum.Function != checksumSHA {
+		return [32]byte{}, errors.Wrapf(ErrUnsupportedKeystore,
+			"checksum %s", ks.Crypto.Checksum.Function,
+		)
+	}
+	if ks.Crypto.Cipher.Function != cipherAES {
+		return [32]byte{}, errors.Wrapf(ErrUnsupportedKeystore,
+			"cipher %s", ks.Crypto.Cipher.Function,
+		)
+	}
+
+	key, err := deriveKey(ks.Crypto.KDF, password)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if subtle.ConstantTimeCompare(
+		checksum(key, ks.Crypto.Cipher.Message), ks.Crypto.Checksum.Message,
+	) != 1 {
+		return [32]byte{}, ErrInvalidPassword
+	}
+
+	iv, err := hexParam(ks.Crypto.Cipher.Params, "iv")
+	if err != nil {
+		return [32]byte{}, err
+	}
+	secret, err := aesCTR(key[:16], iv, ks.Crypto.Cipher.Message)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if len(secret) != len([32]byte{}) {
+		return [32]byte{}, errors.Wrapf(
+			ErrUnsupportedKeystore, "secret of %d bytes", len(secret),
+		)
+	}
+	return [32]byte(secret), nil
+}
+
+// Load reads a keystore from a file.
+func Load(path string) (*Keystore, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var ks Keystore
+	if err = json.Unmarshal(bz, &ks); err != nil {
+		return nil, err
+	}
+	return &ks, nil
+}
+
+// Save writes the keystore to a file readable by its owner only.
+func (ks *Keystore) Save(path string) error {
+	bz, err := json.MarshalIndent(ks, "", "  ")
+	if err != nil {
+		return err
+	}
+	//nolint:mnd // owner only.
+	return os.WriteFile(path, bz, 0o600)
+}
+
+// ReadPasswordFile reads a password from a file, without its trailing
+// newline.
+func ReadPasswordFile(path string) (string, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(bz), "\r\n"), nil
+}
+
+// deriveKey derives the decryption key of a password with the given key
+// derivation function.
+func deriveKey(kdf Module, password string) ([]byte, error) {
+	salt, err := hexParam(kdf.Params, "salt")
+	if err != nil {
+		return nil, err
+	}
+	dklen, err := intParam(kdf.Params, "dklen")
+	if err != nil {
+		return nil, err
+	} else if dklen != keyLength {
+		return nil, errors.Wrapf(ErrUnsupportedKeystore, "dklen %d", dklen)
+	}
+
+	pw := processPassword(password)
+	switch kdf.Function {
+	case kdfScrypt:
+		var n, r, p int
+		if n, err = intParam(kdf.Params, "n"); err != nil {
+			return nil, err
+		}
+		if r, err = intParam(kdf.Params, "r"); err != nil {
+			return nil, err
+		}
+		if p, err = intParam(kdf.Params, "p"); err != nil {
+			return nil, err
+		}
+		return scrypt.Key(pw, salt, n, r, p, dklen)
+	case kdfPBKDF2:
+		if prf, _ := kdf.Params["prf"].(string); prf != prfHMACSHA256 {
+			return nil, errors.Wrapf(ErrUnsupportedKeystore, "prf %s", prf)
+		}
+		var c int
+		if c, err = intParam(kdf.Params, "c"); err != nil {
+			return nil, err
+		}
+		return pbkdf2.Key(pw, salt, c, dklen, sha256.New), nil
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedKeystore, "kdf %s", kdf.Function)
+	}
+}
+
+// processPassword normalizes a password to NFKD and strips its control
+// codes, as per EIP-2335.
+func processPassword(password string) []byte {
+	return []byte(strings.Map(func(r rune) rune {
+		if r < 0x20 || (r >= 0x7f && r <= 0x9f) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(password)))
+}
+
+// checksum returns the checksum of a ciphertext.
+func checksum(key, ciphertext []byte) []byte {
+	h := sha256.New()
+	h.Write(key[16:32])
+	h.Write(ciphertext)
+	return h.Sum(nil)
+}
+
+// aesCTR encrypts or decrypts a message with AES-128-CTR.
+func aesCTR(key, iv, msg []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, errors.Wrapf(ErrUnsupportedKeystore, "iv of %d bytes",
+			len(iv),
+		)
+	}
+	out := make([]byte, len(msg))
+	cipher.NewCTR(block, iv).XORKeyStream(out, msg)
+	return out, nil
+}
+
+// hexParam returns a hex encoded parameter of a module.
+func hexParam(params map[string]any, name string) ([]byte, error) {
+	s, ok := params[name].(string)
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidKeystoreParam, "%s", name)
+	}
+	var b hexBytes
+	if err := b.UnmarshalText([]byte(s)); err != nil {
+		return nil, errors.Wrapf(ErrInvalidKeystoreParam, "%s: %s", name, err)
+	}
+	return b, nil
+}
+
+// intParam returns an integer parameter of a module.
+func intParam(params map[string]any, name string) (int, error) {
+	switch v := params[name].(type) {
+	case int:
+		return v, nil
+	case float64:
+		if v == float64(int(v)) && v > 0 {
+			return int(v), nil
+		}
+	}
+	return 0, errors.Wrapf(ErrInvalidKeystoreParam, "%s", name)
+}
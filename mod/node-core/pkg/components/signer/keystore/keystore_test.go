@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keystore_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/keystore"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func fromHex(t *testing.T, s string) []byte {
+	t.Helper()
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+func fromDecimal(t *testing.T, s string) [32]byte {
+	t.Helper()
+	n, ok := new(big.Int).SetString(s, 10)
+	require.True(t, ok)
+	var out [32]byte
+	n.FillBytes(out[:])
+	return out
+}
+
+// Test vectors of EIP-2333.
+func TestDeriveChildSK(t *testing.T) {
+	tests := []struct {
+		seed     string
+		masterSK string
+		index    uint32
+		childSK  string
+	}{
+		{
+			seed:     "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+			masterSK: "6083874454709270928345386274498605044986640685124978867557563392430687146096",
+			index:    0,
+			childSK:  "20397789859736650942317412262472558107875392172444076792671091975210932703118",
+		},
+		{
+			seed:     "3141592653589793238462643383279502884197169399375105820974944592",
+			masterSK: "29757020647961307431480504535336562678282505419141012933316116377660817309383",
+			index:    3141592653,
+			childSK:  "25457201688850691947727629385191704516744796114925897962676248250929345014287",
+		},
+	}
+	for _, tt := range tests {
+		master, err := keystore.DeriveMasterSK(fromHex(t, tt.seed))
+		require.NoError(t, err)
+		require.Equal(t, fromDecimal(t, tt.masterSK), master)
+		require.Equal(t,
+			fromDecimal(t, tt.childSK), keystore.DeriveChildSK(master, tt.index),
+		)
+	}
+
+	_, err := keystore.DeriveMasterSK(make([]byte, 31))
+	require.ErrorIs(t, err, keystore.ErrSeedTooShort)
+}
+
+func TestDeriveSecretKey(t *testing.T) {
+	seed, err := keystore.SeedFromMnemonic(
+		"abandon abandon abandon abandon abandon abandon abandon abandon " +
+			"abandon abandon abandon about",
+	)
+	require.NoError(t, err)
+
+	sk, err := keystore.DeriveSecretKey(seed, keystore.SigningKeyPath(0))
+	require.NoError(t, err)
+	master, err := keystore.DeriveMasterSK(seed)
+	require.NoError(t, err)
+	for _, index := range []uint32{12381, 3600, 0, 0, 0} {
+		master = keystore.DeriveChildSK(master, index)
+	}
+	require.Equal(t, master, sk)
+
+	_, err = keystore.DeriveSecretKey(seed, "m/12381/x")
+	require.ErrorIs(t, err, keystore.ErrInvalidPath)
+	_, err = keystore.SeedFromMnemonic("abandon abandon")
+	require.Error(t, err)
+}
+
+// Test vectors of EIP-2335.
+const (
+	testPassword = "\U0001d531\U0001d522\U0001d530\U0001d531\U0001d52d" +
+		"\U0001d51e\U0001d530\U0001d530\U0001d534\U0001d52c\U0001d52f" +
+		"\U0001d521\U0001f511"
+	testSecret = "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"
+
+	scryptKeystore = `{
+    "crypto": {
+        "kdf": {
+            "function": "scrypt",
+            "params": {
+                "dklen": 32,
+                "n": 262144,
+                "p": 1,
+                "r": 8,
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "d2217fe5f3e9a1e34581ef8a78f7c9928e436d36dacc5e846690a5581e8ea484"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "06ae90d55fe0a6e9c5c3bc5b170827b2e5cce3929ed3f116c2811e6366dfe20f"
+        }
+    },
+    "description": "This is a test keystore that uses scrypt to secure the secret.",
+    "pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+    "path": "m/12381/60/3141592653/589793238",
+    "uuid": "1d85ae20-35c5-4611-98e8-aa14a633906f",
+    "version": 4
+}`
+
+	pbkdf2Keystore = `{
+    "crypto": {
+        "kdf": {
+            "function": "pbkdf2",
+            "params": {
+                "dklen": 32,
+                "c": 262144,
+                "prf": "hmac-sha256",
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "8a9f5d9912ed7e75ea794bc5a89bca5f193721d30868ade6f73043c6ea6febf1"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "cee03fde2af33149775b7223e7845e4fb2c8ae1792e5f99fe9ecf474cc8c16ad"
+        }
+    },
+    "description": "This is a test keystore that uses PBKDF2 to secure the secret.",
+    "pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+    "path": "m/12381/60/0/0",
+    "uuid": "64625def-3331-4eea-ab6f-782f3ed16a83",
+    "version": 4
+}`
+)
+
+func TestDecrypt(t *testing.T) {
+	for _, raw := range []string{scryptKeystore, pbkdf2Keystore} {
+		var ks keystore.Keystore
+		require.NoError(t, json.Unmarshal([]byte(raw), &ks))
+
+		secret, err := ks.Decrypt(testPassword)
+		require.NoError(t, err)
+		require.Equal(t, fromHex(t, testSecret), secret[:])
+
+		_, err = ks.Decrypt("testpassword")
+		require.ErrorIs(t, err, keystore.ErrInvalidPassword)
+	}
+}
+
+func TestEncrypt(t *testing.T) {
+	secret := [32]byte(fromHex(t, testSecret))
+	pubkey := crypto.BLSPubkey{0x01}
+	ks, err := keystore.Encrypt(
+		secret, pubkey, keystore.SigningKeyPath(1), testPassword,
+		keystore.ScryptParams{N: 1 << 10, R: 8, P: 1},
+	)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "keystore.json")
+	require.NoError(t, ks.Save(path))
+	loaded, err := keystore.Load(path)
+	require.NoError(t, err)
+	require.Equal(t, pubkey[:], []byte(loaded.Pubkey))
+	require.Equal(t, "m/12381/3600/1/0/0", loaded.Path)
+	require.Len(t, loaded.UUID, 36)
+
+	// The password is normalized and stripped of its control codes.
+	decrypted, err := loaded.Decrypt(testPassword + "\x7f")
+	require.NoError(t, err)
+	require.Equal(t, secret, decrypted)
+	_, err = loaded.Decrypt("")
+	require.ErrorIs(t, err, keystore.ErrInvalidPassword)
+}
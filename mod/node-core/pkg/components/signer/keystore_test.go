@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/keystore"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKeystoreSigner(t *testing.T) {
+	local, err := signer.NewLegacySigner(key)
+	require.NoError(t, err)
+	ks, err := keystore.Encrypt(
+		key, local.PublicKey(), "", "password",
+		keystore.ScryptParams{N: 2, R: 8, P: 1},
+	)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	keystorePath := filepath.Join(dir, "keystore.json")
+	passwordFile := filepath.Join(dir, "password")
+	require.NoError(t, ks.Save(keystorePath))
+	require.NoError(t, os.WriteFile(passwordFile, []byte("password\n"), 0o600))
+
+	blsSigner, err := signer.NewKeystoreSigner(keystorePath, passwordFile)
+	require.NoError(t, err)
+	require.Equal(t, local.PublicKey(), blsSigner.PublicKey())
+
+	require.NoError(t, os.WriteFile(passwordFile, []byte("wrong"), 0o600))
+	_, err = signer.NewKeystoreSigner(keystorePath, passwordFile)
+	require.ErrorIs(t, err, keystore.ErrInvalidPassword)
+
+	ks.Pubkey = make([]byte, len(ks.Pubkey))
+	require.NoError(t, ks.Save(keystorePath))
+	require.NoError(t, os.WriteFile(passwordFile, []byte("password"), 0o600))
+	_, err = signer.NewKeystoreSigner(keystorePath, passwordFile)
+	require.ErrorIs(t, err, signer.ErrKeystorePubkeyMismatch)
+}
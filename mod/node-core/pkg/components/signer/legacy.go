@@ -81,6 +81,16 @@ func (LegacySigner) VerifySignature(
 	return nil
 }
 
+// VerifySignatures verifies the signatures against the messages and the
+// public keys of the same index at once.
+func (LegacySigner) VerifySignatures(
+	pubkeys []crypto.BLSPubkey,
+	msgs [][]byte,
+	signatures []crypto.BLSSignature,
+) error {
+	return BLSSigner{}.VerifySignatures(pubkeys, msgs, signatures)
+}
+
 // LegacyKey is a byte array that represents a BLS12-381 secret key.
 type LegacyKey [constants.BLSSecretKeyLength]byte
 
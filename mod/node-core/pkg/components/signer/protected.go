@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/slashingprotection"
+)
+
+// ProtectedSigner is a BLS signer guarded by a slashing protection database.
+// The slashable messages signed through it are recorded to the database
+// before being signed, and refused if conflicting with those signed before.
+type ProtectedSigner struct {
+	crypto.BLSSigner
+	db *slashingprotection.KVStore
+}
+
+// NewProtectedSigner creates a new ProtectedSigner guarding the given signer
+// with the given slashing protection database.
+func NewProtectedSigner(
+	signer crypto.BLSSigner,
+	db *slashingprotection.KVStore,
+) *ProtectedSigner {
+	return &ProtectedSigner{
+		BLSSigner: signer,
+		db:        db,
+	}
+}
+
+// Sign refuses to sign the given message, as its type is unknown and it thus
+// cannot be checked against the slashing protection database. The messages
+// must be signed through the typed signing methods instead.
+func (*ProtectedSigner) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, ErrUnprotectedSigningRequest
+}
+
+// VerifySignatures verifies the signatures against the messages and the
+// public keys of the same index at once.
+func (p *ProtectedSigner) VerifySignatures(
+	pubkeys []crypto.BLSPubkey,
+	msgs [][]byte,
+	signatures []crypto.BLSSignature,
+) error {
+	return BLSSigner{}.VerifySignatures(pubkeys, msgs, signatures)
+}
+
+// SignRandaoReveal signs the randao reveal of the given epoch, unless a
+// different one was signed for it.
+func (p *ProtectedSigner) SignRandaoReveal(
+	forkVersion common.Version,
+	genesisValidatorsRoot common.Root,
+	epoch math.Epoch,
+	signingRoot common.Root,
+) (crypto.BLSSignature, error) {
+	if err := p.db.CheckGenesisValidatorsRoot(
+		genesisValidatorsRoot,
+	); err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	if err := p.db.CheckAndRecordRandaoReveal(
+		p.PublicKey(), epoch, signingRoot,
+	); err != nil {
+		return crypto.BLSSignature{}, err
+	}
+
+	if signer, ok := p.BLSSigner.(RandaoSigner); ok {
+		return signer.SignRandaoReveal(
+			forkVersion, genesisValidatorsRoot, epoch, signingRoot,
+		)
+	}
+	return p.BLSSigner.Sign(signingRoot[:])
+}
+
+// SignBlock signs the block of the given header, unless it conflicts with
+// the blocks signed before.
+func (p *ProtectedSigner) SignBlock(
+	forkVersion common.Version,
+	genesisValidatorsRoot common.Root,
+	header *types.BeaconBlockHeader,
+	signingRoot common.Root,
+) (crypto.BLSSignature, error) {
+	if err := p.db.CheckGenesisValidatorsRoot(
+		genesisValidatorsRoot,
+	); err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	if err := p.db.CheckAndRecordBlock(
+		p.PublicKey(), header.GetSlot(), signingRoot,
+	); err != nil {
+		return crypto.BLSSignature{}, err
+	}
+
+	if signer, ok := p.BLSSigner.(BlockSigner); ok {
+		return signer.SignBlock(
+			forkVersion, genesisValidatorsRoot, header, signingRoot,
+		)
+	}
+	return p.BLSSigner.Sign(signingRoot[:])
+}
+
+// SignDeposit signs the given deposit message, which is not slashable.
+func (p *ProtectedSigner) SignDeposit(
+	forkVersion common.Version,
+	msg *types.DepositMessage,
+	signingRoot common.Root,
+) (crypto.BLSSignature, error) {
+	if signer, ok := p.BLSSigner.(DepositSigner); ok {
+		return signer.SignDeposit(forkVersion, msg, signingRoot)
+	}
+	return p.BLSSigner.Sign(signingRoot[:])
+}
+
+// SignValidatorRegistration signs the given validator registration, which is
+// not slashable.
+func (p *ProtectedSigner) SignValidatorRegistration(
+	msg *types.ValidatorRegistration,
+	signingRoot common.Root,
+) (crypto.BLSSignature, error) {
+	if signer, ok := p.BLSSigner.(RegistrationSigner); ok {
+		return signer.SignValidatorRegistration(msg, signingRoot)
+	}
+	return p.BLSSigner.Sign(signingRoot[:])
+}
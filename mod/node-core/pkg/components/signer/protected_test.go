@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"testing"
+
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/slashingprotection"
+	"github.com/stretchr/testify/require"
+)
+
+func newProtectedSigner(t *testing.T) *signer.ProtectedSigner {
+	t.Helper()
+	local, err := signer.NewLegacySigner(key)
+	require.NoError(t, err)
+	return signer.NewProtectedSigner(
+		local, slashingprotection.NewStore(storev2.NewMemDB()),
+	)
+}
+
+func TestProtectedSigner_Sign(t *testing.T) {
+	_, err := newProtectedSigner(t).Sign([]byte{0x01})
+	require.ErrorIs(t, err, signer.ErrUnprotectedSigningRequest)
+}
+
+func TestProtectedSigner_SignBlock(t *testing.T) {
+	p := newProtectedSigner(t)
+	local, err := signer.NewLegacySigner(key)
+	require.NoError(t, err)
+
+	header := types.NewBeaconBlockHeader(
+		5, 1, common.Root{}, common.Root{}, common.Root{},
+	)
+	signingRoot := common.Root{0x01}
+	signature, err := p.SignBlock(
+		forkVersion, genesisRoot, header, signingRoot,
+	)
+	require.NoError(t, err)
+	require.NoError(t, local.VerifySignature(
+		p.PublicKey(), signingRoot[:], signature,
+	))
+
+	// The same block can be signed again.
+	_, err = p.SignBlock(forkVersion, genesisRoot, header, common.Root{0x01})
+	require.NoError(t, err)
+
+	// A conflicting block at the same slot is refused.
+	_, err = p.SignBlock(forkVersion, genesisRoot, header, common.Root{0x02})
+	require.ErrorIs(t, err, slashingprotection.ErrDoubleProposal)
+
+	// Blocks of another chain are refused.
+	_, err = p.SignBlock(
+		forkVersion, common.Root{0x0b}, header, common.Root{0x01},
+	)
+	require.ErrorIs(
+		t, err, slashingprotection.ErrGenesisValidatorsRootMismatch,
+	)
+}
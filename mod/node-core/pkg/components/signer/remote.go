@@ -0,0 +1,298 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// signPath is the path of the signing endpoint of the Web3Signer API,
+	// followed by the public key to sign with.
+	signPath = "/api/v1/eth2/sign"
+	// retryInterval is the interval between the attempts of a request.
+	retryInterval = 100 * time.Millisecond
+	// maxErrorBodySize is the maximum number of bytes of an error response
+	// that are included in the returned error.
+	maxErrorBodySize = 256
+)
+
+// Types of the signing requests of the Web3Signer API.
+const (
+	requestTypeRandaoReveal = "RANDAO_REVEAL"
+	requestTypeBlock        = "BLOCK_V2"
+	requestTypeDeposit      = "DEPOSIT"
+	requestTypeRegistration = "VALIDATOR_REGISTRATION"
+)
+
+// RemoteSigner is a BLS signer whose key is held by a remote signer
+// implementing the Web3Signer API, e.g. backed by an HSM. The remote signer
+// only signs typed requests, from which it computes the signing root itself,
+// thus messages are signed with SignRandaoReveal, SignBlock and SignDeposit.
+type RemoteSigner struct {
+	// url is the URL of the remote signer.
+	url *url.URL
+	// pubkey is the public key of the key to sign with.
+	pubkey crypto.BLSPubkey
+	// retries is the number of times a failed request is retried.
+	retries uint64
+	// httpClient is the HTTP client used to reach the remote signer.
+	httpClient *http.Client
+}
+
+// NewRemoteSigner creates a new RemoteSigner from the given configuration.
+// The remote signer is not reached until the first signing request.
+func NewRemoteSigner(cfg Config) (*RemoteSigner, error) {
+	if cfg.URL == "" {
+		return nil, ErrRemoteSignerURLRequired
+	}
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	var pubkey crypto.BLSPubkey
+	if err = pubkey.UnmarshalText([]byte(cfg.PublicKey)); err != nil {
+		return nil, errors.Wrapf(ErrInvalidRemoteSignerPublicKey, "%s: %s",
+			cfg.PublicKey, err,
+		)
+	}
+
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &RemoteSigner{
+		url:     u,
+		pubkey:  pubkey,
+		retries: cfg.Retries,
+		httpClient: &http.Client{
+			Timeout:   cfg.Timeout,
+			Transport: transport,
+		},
+	}, nil
+}
+
+// PublicKey returns the public key of the signer.
+func (s *RemoteSigner) PublicKey() crypto.BLSPubkey {
+	return s.pubkey
+}
+
+// Sign refuses to sign untyped messages, as the remote signer only signs
+// typed requests.
+func (s *RemoteSigner) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, ErrUntypedSigningRequest
+}
+
+// VerifySignature verifies a signature against a message and a public key.
+func (s *RemoteSigner) VerifySignature(
+	pubkey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	return BLSSigner{}.VerifySignature(pubkey, msg, signature)
+}
+
+// VerifySignatures verifies the signatures against the messages and the
+// public keys of the same index at once.
+func (s *RemoteSigner) VerifySignatures(
+	pubkeys []crypto.BLSPubkey,
+	msgs [][]byte,
+	signatures []crypto.BLSSignature,
+) error {
+	return BLSSigner{}.VerifySignatures(pubkeys, msgs, signatures)
+}
+
+// SignRandaoReveal signs the randao reveal of the given epoch.
+func (s *RemoteSigner) SignRandaoReveal(
+	forkVersion common.Version,
+	genesisValidatorsRoot common.Root,
+	epoch math.Epoch,
+	signingRoot common.Root,
+) (crypto.BLSSignature, error) {
+	return s.sign(&signRequest{
+		Type:         requestTypeRandaoReveal,
+		ForkInfo:     newForkInfo(forkVersion, genesisValidatorsRoot),
+		SigningRoot:  signingRoot,
+		RandaoReveal: &randaoReveal{Epoch: epoch.Unwrap()},
+	})
+}
+
+// SignBlock signs the block of the given header.
+func (s *RemoteSigner) SignBlock(
+	forkVersion common.Version,
+	genesisValidatorsRoot common.Root,
+	header *types.BeaconBlockHeader,
+	signingRoot common.Root,
+) (crypto.BLSSignature, error) {
+	return s.sign(&signRequest{
+		Type:        requestTypeBlock,
+		ForkInfo:    newForkInfo(forkVersion, genesisValidatorsRoot),
+		SigningRoot: signingRoot,
+		BeaconBlock: &beaconBlock{
+			Version: consensusVersionDeneb,
+			BlockHeader: blockHeader{
+				Slot:          header.Slot,
+				ProposerIndex: header.ProposerIndex,
+				ParentRoot:    header.ParentBlockRoot,
+				StateRoot:     header.StateRoot,
+				BodyRoot:      header.BodyRoot,
+			},
+		},
+	})
+}
+
+// SignDeposit signs the given deposit message. The remote signer computes
+// the deposit domain from the fork version alone, i.e. with a zero genesis
+// validators root.
+func (s *RemoteSigner) SignDeposit(
+	forkVersion common.Version,
+	msg *types.DepositMessage,
+	signingRoot common.Root,
+) (crypto.BLSSignature, error) {
+	return s.sign(&signRequest{
+		Type:        requestTypeDeposit,
+		SigningRoot: signingRoot,
+		Deposit: &depositData{
+			Pubkey:                msg.Pubkey,
+			WithdrawalCredentials: msg.Credentials,
+			Amount:                msg.Amount.Unwrap(),
+			GenesisForkVersion:    forkVersion,
+		},
+	})
+}
+
+// SignValidatorRegistration signs the given validator registration. The
+// remote signer computes the builder domain from the genesis fork version.
+func (s *RemoteSigner) SignValidatorRegistration(
+	msg *types.ValidatorRegistration,
+	signingRoot common.Root,
+) (crypto.BLSSignature, error) {
+	return s.sign(&signRequest{
+		Type:        requestTypeRegistration,
+		SigningRoot: signingRoot,
+		ValidatorRegistration: &validatorRegistration{
+			FeeRecipient: msg.FeeRecipient,
+			GasLimit:     msg.GasLimit.Unwrap(),
+			Timestamp:    msg.Timestamp.Unwrap(),
+			Pubkey:       msg.Pubkey,
+		},
+	})
+}
+
+// sign sends a signing request to the remote signer, retrying it after
+// failing to reach the remote signer or after a server error.
+func (s *RemoteSigner) sign(req *signRequest) (crypto.BLSSignature, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+
+	var resp signResponse
+	for attempt := uint64(0); ; attempt++ {
+		var retry bool
+		if retry, err = s.do(body, &resp); err == nil {
+			return resp.Signature, nil
+		} else if !retry || attempt == s.retries {
+			return crypto.BLSSignature{}, err
+		}
+		time.Sleep(retryInterval)
+	}
+}
+
+// do sends a signing request to the remote signer and decodes its response.
+// It returns whether the request may be retried if it failed.
+func (s *RemoteSigner) do(body []byte, resp *signResponse) (bool, error) {
+	req, err := http.NewRequestWithContext(
+		context.Background(), http.MethodPost,
+		s.url.JoinPath(signPath, s.pubkey.String()).String(),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer res.Body.Close()
+
+	bz, err := io.ReadAll(res.Body)
+	if err != nil {
+		return true, err
+	}
+	if res.StatusCode != http.StatusOK {
+		if len(bz) > maxErrorBodySize {
+			bz = bz[:maxErrorBodySize]
+		}
+		return res.StatusCode >= http.StatusInternalServerError,
+			errors.Wrapf(ErrRemoteSignerStatus, "%d %s",
+				res.StatusCode, bz,
+			)
+	}
+	return false, json.Unmarshal(bz, resp)
+}
+
+// newTLSConfig returns the TLS configuration of the connections to the
+// remote signer.
+func newTLSConfig(cfg Config) (*tls.Config, error) {
+	//#nosec:G402 // the minimum version is set.
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.TLSCACert != "" {
+		pem, err := os.ReadFile(cfg.TLSCACert)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.Wrapf(ErrInvalidCACert, "%s", cfg.TLSCACert)
+		}
+	}
+	if cfg.TLSClientCert != "" || cfg.TLSClientKey != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.TLSClientCert, cfg.TLSClientKey)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
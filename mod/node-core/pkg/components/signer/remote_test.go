@@ -0,0 +1,281 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer/signertest"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	key         = signer.LegacyKey{0x01}
+	forkVersion = common.Version{0x04}
+	genesisRoot = common.Root{0x0a}
+)
+
+func newRemoteSigner(
+	t *testing.T, url string, mutate ...func(*signer.Config),
+) *signer.RemoteSigner {
+	t.Helper()
+	local, err := signer.NewLegacySigner(key)
+	require.NoError(t, err)
+	cfg := signer.DefaultConfig()
+	cfg.Type = signer.TypeRemote
+	cfg.URL = url
+	cfg.PublicKey = local.PublicKey().String()
+	for _, fn := range mutate {
+		fn(&cfg)
+	}
+	rs, err := signer.NewRemoteSigner(cfg)
+	require.NoError(t, err)
+	return rs
+}
+
+// requireSignedBy requires the signature to be that of the signing root by
+// the local key.
+func requireSignedBy(
+	t *testing.T, signingRoot common.Root, sig crypto.BLSSignature,
+) {
+	t.Helper()
+	local, err := signer.NewLegacySigner(key)
+	require.NoError(t, err)
+	require.NoError(t, local.VerifySignature(
+		local.PublicKey(), signingRoot[:], sig,
+	))
+}
+
+func TestRemoteSigner_TypedRequests(t *testing.T) {
+	cs := spec.TestnetChainSpec()
+	srv, err := signertest.NewServer(cs, key)
+	require.NoError(t, err)
+	defer srv.Close()
+	rs := newRemoteSigner(t, srv.URL)
+	forkData := types.NewForkData(forkVersion, genesisRoot)
+
+	// Randao reveal.
+	signingRoot, err := forkData.ComputeRandaoSigningRoot(
+		cs.DomainTypeRandao(), 7,
+	)
+	require.NoError(t, err)
+	sig, err := rs.SignRandaoReveal(forkVersion, genesisRoot, 7, signingRoot)
+	require.NoError(t, err)
+	requireSignedBy(t, signingRoot, sig)
+
+	// A signing root not matching the typed message is refused.
+	_, err = rs.SignRandaoReveal(forkVersion, genesisRoot, 8, signingRoot)
+	require.ErrorIs(t, err, signer.ErrRemoteSignerStatus)
+
+	// Block.
+	header := types.NewBeaconBlockHeader(
+		3, 1, common.Root{1}, common.Root{2}, common.Root{3},
+	)
+	domain, err := forkData.ComputeDomain(cs.DomainTypeProposer())
+	require.NoError(t, err)
+	signingRoot, err = ssz.ComputeSigningRoot(header, domain)
+	require.NoError(t, err)
+	sig, err = rs.SignBlock(forkVersion, genesisRoot, header, signingRoot)
+	require.NoError(t, err)
+	requireSignedBy(t, signingRoot, sig)
+
+	// Deposit, as signed for deposit create-validator.
+	depositForkData := types.NewForkData(forkVersion, common.Root{})
+	msg, sig, err := types.CreateAndSignDepositMessage(
+		depositForkData, cs.DomainTypeDeposit(), rs,
+		types.WithdrawalCredentials{0x01}, math.Gwei(32e9),
+	)
+	require.NoError(t, err)
+	require.NoError(t, msg.VerifyCreateValidator(
+		depositForkData, sig, cs.DomainTypeDeposit(), rs.VerifySignature,
+	))
+
+	// Validator registration, as signed by the relay service.
+	registration := &types.ValidatorRegistration{
+		FeeRecipient: common.ExecutionAddress{0x01},
+		GasLimit:     30_000_000,
+		Timestamp:    1,
+		Pubkey:       rs.PublicKey(),
+	}
+	domain, err = types.NewForkData(
+		version.FromUint32[common.Version](cs.ActiveForkVersionForEpoch(0)),
+		common.Root{},
+	).ComputeDomain(cs.DomainTypeApplicationMask())
+	require.NoError(t, err)
+	signingRoot, err = ssz.ComputeSigningRoot(registration, domain)
+	require.NoError(t, err)
+	sig, err = rs.SignValidatorRegistration(registration, signingRoot)
+	require.NoError(t, err)
+	requireSignedBy(t, signingRoot, sig)
+
+	require.Equal(t,
+		[]string{
+			"RANDAO_REVEAL", "BLOCK_V2", "DEPOSIT", "VALIDATOR_REGISTRATION",
+		},
+		srv.Requests(),
+	)
+
+	// Untyped messages are refused.
+	_, err = rs.Sign(signingRoot[:])
+	require.ErrorIs(t, err, signer.ErrUntypedSigningRequest)
+}
+
+func TestRemoteSigner_Retries(t *testing.T) {
+	cs := spec.TestnetChainSpec()
+	srv, err := signertest.NewServer(cs, key)
+	require.NoError(t, err)
+	defer srv.Close()
+	rs := newRemoteSigner(t, srv.URL)
+	signingRoot, err := types.NewForkData(forkVersion, genesisRoot).
+		ComputeRandaoSigningRoot(cs.DomainTypeRandao(), 1)
+	require.NoError(t, err)
+
+	// Server errors are retried.
+	srv.FailNext(2)
+	_, err = rs.SignRandaoReveal(forkVersion, genesisRoot, 1, signingRoot)
+	require.NoError(t, err)
+
+	srv.FailNext(3)
+	_, err = rs.SignRandaoReveal(forkVersion, genesisRoot, 1, signingRoot)
+	require.ErrorIs(t, err, signer.ErrRemoteSignerStatus)
+	require.Len(t, srv.Requests(), 1)
+
+	// Unknown keys are not.
+	other := newRemoteSigner(t, srv.URL, func(cfg *signer.Config) {
+		cfg.PublicKey = crypto.BLSPubkey{0x02}.String()
+	})
+	srv.FailNext(0)
+	_, err = other.SignRandaoReveal(forkVersion, genesisRoot, 1, signingRoot)
+	require.ErrorIs(t, err, signer.ErrRemoteSignerStatus)
+}
+
+func TestRemoteSigner_Timeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(http.ResponseWriter, *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+		},
+	))
+	defer srv.Close()
+	rs := newRemoteSigner(t, srv.URL, func(cfg *signer.Config) {
+		cfg.Timeout = 20 * time.Millisecond
+		cfg.Retries = 0
+	})
+
+	start := time.Now()
+	_, err := rs.SignRandaoReveal(forkVersion, genesisRoot, 1, common.Root{})
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 150*time.Millisecond)
+}
+
+// writeClientCert writes a self-signed client certificate and its key to
+// the given directory, and returns the certificate.
+func writeClientCert(t *testing.T, dir string) *x509.Certificate {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "beacond"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &priv.PublicKey, priv)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(priv)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "client.crt"),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		0o600,
+	))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "client.key"),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		0o600,
+	))
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestRemoteSigner_TLSClientAuth(t *testing.T) {
+	cs := spec.TestnetChainSpec()
+	dir := t.TempDir()
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(writeClientCert(t, dir))
+
+	srv, err := signertest.NewUnstartedServer(cs, key)
+	require.NoError(t, err)
+	srv.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+	srv.StartTLS()
+	defer srv.Close()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ca.crt"),
+		pem.EncodeToMemory(&pem.Block{
+			Type: "CERTIFICATE", Bytes: srv.Certificate().Raw,
+		}),
+		0o600,
+	))
+	signingRoot, err := types.NewForkData(forkVersion, genesisRoot).
+		ComputeRandaoSigningRoot(cs.DomainTypeRandao(), 1)
+	require.NoError(t, err)
+
+	rs := newRemoteSigner(t, srv.URL, func(cfg *signer.Config) {
+		cfg.TLSCACert = filepath.Join(dir, "ca.crt")
+		cfg.TLSClientCert = filepath.Join(dir, "client.crt")
+		cfg.TLSClientKey = filepath.Join(dir, "client.key")
+	})
+	_, err = rs.SignRandaoReveal(forkVersion, genesisRoot, 1, signingRoot)
+	require.NoError(t, err)
+
+	// Without a client certificate, the remote signer is not reached.
+	rs = newRemoteSigner(t, srv.URL, func(cfg *signer.Config) {
+		cfg.TLSCACert = filepath.Join(dir, "ca.crt")
+		cfg.Retries = 0
+	})
+	_, err = rs.SignRandaoReveal(forkVersion, genesisRoot, 1, signingRoot)
+	require.Error(t, err)
+	require.Len(t, srv.Requests(), 1)
+}
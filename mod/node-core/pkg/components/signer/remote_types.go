@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// consensusVersionDeneb is the name of the Deneb fork.
+const consensusVersionDeneb = "DENEB"
+
+// signRequest is a signing request of the Web3Signer API. Exactly one of
+// the typed messages is set, as given by the type of the request.
+type signRequest struct {
+	Type         string        `json:"type"`
+	ForkInfo     *forkInfo     `json:"fork_info,omitempty"`
+	SigningRoot  common.Root   `json:"signingRoot"`
+	RandaoReveal *randaoReveal `json:"randao_reveal,omitempty"`
+	BeaconBlock  *beaconBlock  `json:"beacon_block,omitempty"`
+	Deposit      *depositData  `json:"deposit,omitempty"`
+	//nolint:lll // struct tags.
+	ValidatorRegistration *validatorRegistration `json:"validator_registration,omitempty"`
+}
+
+// signResponse is the response to a signing request of the Web3Signer API.
+type signResponse struct {
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// forkInfo is the fork of a signing request, from which the remote signer
+// computes the signing domain.
+type forkInfo struct {
+	Fork                  fork        `json:"fork"`
+	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
+}
+
+// newForkInfo returns the fork info of the given fork version. The previous
+// version is that of the fork as well, since beacon-kit computes the signing
+// domain from the fork version alone.
+func newForkInfo(
+	forkVersion common.Version,
+	genesisValidatorsRoot common.Root,
+) *forkInfo {
+	return &forkInfo{
+		Fork: fork{
+			PreviousVersion: forkVersion,
+			CurrentVersion:  forkVersion,
+		},
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}
+}
+
+type fork struct {
+	PreviousVersion common.Version `json:"previous_version"`
+	CurrentVersion  common.Version `json:"current_version"`
+	Epoch           uint64         `json:"epoch,string"`
+}
+
+type randaoReveal struct {
+	Epoch uint64 `json:"epoch,string"`
+}
+
+type beaconBlock struct {
+	Version     string      `json:"version"`
+	BlockHeader blockHeader `json:"block_header"`
+}
+
+type blockHeader struct {
+	Slot          uint64      `json:"slot,string"`
+	ProposerIndex uint64      `json:"proposer_index,string"`
+	ParentRoot    common.Root `json:"parent_root"`
+	StateRoot     common.Root `json:"state_root"`
+	BodyRoot      common.Root `json:"body_root"`
+}
+
+//nolint:lll // struct tags.
+type depositData struct {
+	Pubkey                crypto.BLSPubkey            `json:"pubkey"`
+	WithdrawalCredentials types.WithdrawalCredentials `json:"withdrawal_credentials"`
+	Amount                uint64                      `json:"amount,string"`
+	GenesisForkVersion    common.Version              `json:"genesis_fork_version"`
+}
+
+//nolint:lll // struct tags.
+type validatorRegistration struct {
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	GasLimit     uint64                  `json:"gas_limit,string"`
+	Timestamp    uint64                  `json:"timestamp,string"`
+	Pubkey       crypto.BLSPubkey        `json:"pubkey"`
+}
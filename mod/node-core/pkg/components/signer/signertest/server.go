@@ -0,0 +1,266 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package signertest provides a local stand-in for a Web3Signer compatible
+// remote signer, for tests.
+package signertest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// signPath is the path of the signing endpoint, followed by the public key
+// to sign with.
+const signPath = "/api/v1/eth2/sign/"
+
+// Server is a local stand-in for a Web3Signer compatible remote signer. It
+// signs the typed requests of the remote signer with local keys, after
+// checking their signing root against their typed message.
+type Server struct {
+	*httptest.Server
+
+	// chainSpec is the chain spec the signing domains are computed with.
+	chainSpec primitives.ChainSpec
+	// signers are the signers of the keys of the server, by public key.
+	signers map[crypto.BLSPubkey]*signer.LegacySigner
+
+	mu sync.Mutex
+	// failures is the number of requests to fail with a server error.
+	failures int
+	// requests are the types of the signed requests.
+	requests []string
+}
+
+// NewServer starts a new Server signing with the given keys.
+func NewServer(
+	chainSpec primitives.ChainSpec,
+	keys ...signer.LegacyKey,
+) (*Server, error) {
+	s, err := NewUnstartedServer(chainSpec, keys...)
+	if err != nil {
+		return nil, err
+	}
+	s.Start()
+	return s, nil
+}
+
+// NewUnstartedServer creates a new Server signing with the given keys, to
+// be started with Start, or StartTLS once its TLS configuration is set.
+func NewUnstartedServer(
+	chainSpec primitives.ChainSpec,
+	keys ...signer.LegacyKey,
+) (*Server, error) {
+	s := &Server{
+		chainSpec: chainSpec,
+		signers:   make(map[crypto.BLSPubkey]*signer.LegacySigner),
+	}
+	for _, key := range keys {
+		ls, err := signer.NewLegacySigner(key)
+		if err != nil {
+			return nil, err
+		}
+		s.signers[ls.PublicKey()] = ls
+	}
+	s.Server = httptest.NewUnstartedServer(http.HandlerFunc(s.serveSign))
+	return s, nil
+}
+
+// FailNext makes the next n requests fail with a server error.
+func (s *Server) FailNext(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = n
+}
+
+// Requests returns the types of the signed requests.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// request is a signing request of the Web3Signer API.
+type request struct {
+	Type     string `json:"type"`
+	ForkInfo *struct {
+		Fork struct {
+			CurrentVersion common.Version `json:"current_version"`
+		} `json:"fork"`
+		GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
+	} `json:"fork_info"`
+	SigningRoot  common.Root `json:"signingRoot"`
+	RandaoReveal *struct {
+		Epoch uint64 `json:"epoch,string"`
+	} `json:"randao_reveal"`
+	BeaconBlock *struct {
+		BlockHeader struct {
+			Slot          uint64      `json:"slot,string"`
+			ProposerIndex uint64      `json:"proposer_index,string"`
+			ParentRoot    common.Root `json:"parent_root"`
+			StateRoot     common.Root `json:"state_root"`
+			BodyRoot      common.Root `json:"body_root"`
+		} `json:"block_header"`
+	} `json:"beacon_block"`
+	Deposit *struct {
+		Pubkey                crypto.BLSPubkey            `json:"pubkey"`
+		WithdrawalCredentials types.WithdrawalCredentials `json:"withdrawal_credentials"`
+		Amount                uint64                      `json:"amount,string"`
+		GenesisForkVersion    common.Version              `json:"genesis_fork_version"`
+	} `json:"deposit"`
+	ValidatorRegistration *struct {
+		FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+		GasLimit     uint64                  `json:"gas_limit,string"`
+		Timestamp    uint64                  `json:"timestamp,string"`
+		Pubkey       crypto.BLSPubkey        `json:"pubkey"`
+	} `json:"validator_registration"`
+}
+
+// serveSign serves the signing endpoint.
+func (s *Server) serveSign(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || !strings.HasPrefix(r.URL.Path, signPath) {
+		http.NotFound(w, r)
+		return
+	}
+	s.mu.Lock()
+	if s.failures > 0 {
+		s.failures--
+		s.mu.Unlock()
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	s.mu.Unlock()
+
+	var pubkey crypto.BLSPubkey
+	if err := pubkey.UnmarshalText(
+		[]byte(strings.TrimPrefix(r.URL.Path, signPath)),
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ls, ok := s.signers[pubkey]
+	if !ok {
+		http.Error(w, "unknown public key", http.StatusNotFound)
+		return
+	}
+
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	signingRoot, err := s.signingRoot(&req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if signingRoot != req.SigningRoot {
+		http.Error(w, "signing root mismatch", http.StatusBadRequest)
+		return
+	}
+
+	sig, err := ls.Sign(signingRoot[:])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.mu.Lock()
+	s.requests = append(s.requests, req.Type)
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	//nolint:errchkjson // the response is fully written or not at all.
+	_ = json.NewEncoder(w).Encode(struct {
+		Signature crypto.BLSSignature `json:"signature"`
+	}{sig})
+}
+
+// signingRoot computes the signing root of the typed message of a request.
+func (s *Server) signingRoot(req *request) (common.Root, error) {
+	switch {
+	case req.Type == "RANDAO_REVEAL" && req.ForkInfo != nil &&
+		req.RandaoReveal != nil:
+		return types.NewForkData(
+			req.ForkInfo.Fork.CurrentVersion,
+			req.ForkInfo.GenesisValidatorsRoot,
+		).ComputeRandaoSigningRoot(
+			s.chainSpec.DomainTypeRandao(),
+			math.Epoch(req.RandaoReveal.Epoch),
+		)
+	case req.Type == "BLOCK_V2" && req.ForkInfo != nil &&
+		req.BeaconBlock != nil:
+		h := req.BeaconBlock.BlockHeader
+		domain, err := types.NewForkData(
+			req.ForkInfo.Fork.CurrentVersion,
+			req.ForkInfo.GenesisValidatorsRoot,
+		).ComputeDomain(s.chainSpec.DomainTypeProposer())
+		if err != nil {
+			return common.Root{}, err
+		}
+		return ssz.ComputeSigningRoot(types.NewBeaconBlockHeader(
+			math.Slot(h.Slot), math.ValidatorIndex(h.ProposerIndex),
+			h.ParentRoot, h.StateRoot, h.BodyRoot,
+		), domain)
+	case req.Type == "DEPOSIT" && req.Deposit != nil:
+		d := req.Deposit
+		domain, err := types.NewForkData(
+			d.GenesisForkVersion, common.Root{},
+		).ComputeDomain(s.chainSpec.DomainTypeDeposit())
+		if err != nil {
+			return common.Root{}, err
+		}
+		return ssz.ComputeSigningRoot(&types.DepositMessage{
+			Pubkey:      d.Pubkey,
+			Credentials: d.WithdrawalCredentials,
+			Amount:      math.Gwei(d.Amount),
+		}, domain)
+	case req.Type == "VALIDATOR_REGISTRATION" &&
+		req.ValidatorRegistration != nil:
+		r := req.ValidatorRegistration
+		domain, err := types.NewForkData(
+			version.FromUint32[common.Version](
+				s.chainSpec.ActiveForkVersionForEpoch(0),
+			),
+			common.Root{},
+		).ComputeDomain(s.chainSpec.DomainTypeApplicationMask())
+		if err != nil {
+			return common.Root{}, err
+		}
+		return ssz.ComputeSigningRoot(&types.ValidatorRegistration{
+			FeeRecipient: r.FeeRecipient,
+			GasLimit:     math.U64(r.GasLimit),
+			Timestamp:    math.U64(r.Timestamp),
+			Pubkey:       r.Pubkey,
+		}, domain)
+	default:
+		return common.Root{}, signer.ErrUntypedSigningRequest
+	}
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// RandaoSigner is a signer of typed randao reveals.
+type RandaoSigner interface {
+	// SignRandaoReveal signs the randao reveal of the given epoch.
+	SignRandaoReveal(
+		forkVersion common.Version,
+		genesisValidatorsRoot common.Root,
+		epoch math.Epoch,
+		signingRoot common.Root,
+	) (crypto.BLSSignature, error)
+}
+
+// BlockSigner is a signer of typed blocks.
+type BlockSigner interface {
+	// SignBlock signs the block of the given header.
+	SignBlock(
+		forkVersion common.Version,
+		genesisValidatorsRoot common.Root,
+		header *types.BeaconBlockHeader,
+		signingRoot common.Root,
+	) (crypto.BLSSignature, error)
+}
+
+// DepositSigner is a signer of typed deposit messages.
+type DepositSigner interface {
+	// SignDeposit signs the given deposit message.
+	SignDeposit(
+		forkVersion common.Version,
+		msg *types.DepositMessage,
+		signingRoot common.Root,
+	) (crypto.BLSSignature, error)
+}
+
+// RegistrationSigner is a signer of typed validator registrations.
+type RegistrationSigner interface {
+	// SignValidatorRegistration signs the given validator registration.
+	SignValidatorRegistration(
+		msg *types.ValidatorRegistration,
+		signingRoot common.Root,
+	) (crypto.BLSSignature, error)
+}
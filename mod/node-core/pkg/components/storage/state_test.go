@@ -0,0 +1,407 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package storage_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	statedb "github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+type beaconState = core.BeaconState[
+	*types.BeaconBlockHeader, *types.Eth1Data,
+	*types.ExecutionPayloadHeader, *types.Fork,
+	*types.Validator, *engineprimitives.Withdrawal,
+]
+
+// fullRoot returns the hash tree root of the state read whole.
+func fullRoot(t testing.TB, st beaconState) [32]byte {
+	t.Helper()
+	m, ok := st.(interface {
+		GetMarshallable() (state.RawBeaconState, error)
+	})
+	require.True(t, ok)
+	raw, err := m.GetMarshallable()
+	require.NoError(t, err)
+	root, err := raw.HashTreeRoot()
+	require.NoError(t, err)
+	return root
+}
+
+// testDB is an in-memory application database holding a beacon store.
+type testDB struct {
+	cms storetypes.CommitMultiStore
+	kv  *beacondb.KVStore[
+		*types.Fork, *types.BeaconBlockHeader,
+		*types.ExecutionPayloadHeader, *types.Eth1Data, *types.Validator,
+	]
+}
+
+func newTestDB(t testing.TB) *testDB {
+	t.Helper()
+	key := storetypes.NewKVStoreKey("beacon")
+	cms := store.NewCommitMultiStore(
+		dbm.NewMemDB(), log.NewNopLogger(), storemetrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	kv, err := beacondb.New[
+		*types.Fork, *types.BeaconBlockHeader,
+		*types.ExecutionPayloadHeader, *types.Eth1Data, *types.Validator,
+	](
+		runtime.NewKVStoreService(key),
+		&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+	)
+	require.NoError(t, err)
+
+	return &testDB{cms: cms, kv: kv}
+}
+
+// state returns the beacon state of a new context on top of the database,
+// and the function writing the context back to it.
+func (db *testDB) state() (beaconState, func()) {
+	ms := db.cms.CacheMultiStore()
+	ctx := sdk.NewContext(ms, false, log.NewNopLogger())
+	return statedb.NewBeaconStateFromDB[beaconState](
+		db.kv.WithContext(ctx), spec.TestnetChainSpec(),
+	), ms.Write
+}
+
+func newValidator(i uint64) *types.Validator {
+	var pubkey crypto.BLSPubkey
+	binary.LittleEndian.PutUint64(pubkey[:], i)
+	return types.NewValidatorFromDeposit(
+		pubkey, types.WithdrawalCredentials{}, 32e9, 1e9, 32e9,
+	)
+}
+
+// initState fills a state with the given number of validators.
+func initState(t testing.TB, st beaconState, validators uint64) {
+	t.Helper()
+	cs := spec.TestnetChainSpec()
+	require.NoError(t, st.SetSlot(0))
+	require.NoError(t, st.SetFork(&types.Fork{
+		PreviousVersion: version.FromUint32[common.Version](version.Deneb),
+		CurrentVersion:  version.FromUint32[common.Version](version.Deneb),
+	}))
+	require.NoError(t, st.SetGenesisValidatorsRoot(common.Root{1}))
+	require.NoError(t, st.SetEth1DepositIndex(0))
+	require.NoError(t, st.SetEth1Data(&types.Eth1Data{}))
+	require.NoError(t, st.SetLatestBlockHeader(&types.BeaconBlockHeader{}))
+	require.NoError(t, st.SetLatestExecutionPayloadHeader(
+		&types.ExecutionPayloadHeader{
+			InnerExecutionPayloadHeader: &types.ExecutionPayloadHeaderDeneb{
+				LogsBloom: make([]byte, 256),
+				ExtraData: []byte{},
+			},
+		},
+	))
+	require.NoError(t, st.SetNextWithdrawalIndex(0))
+	require.NoError(t, st.SetNextWithdrawalValidatorIndex(0))
+	require.NoError(t, st.SetTotalSlashing(0))
+	for i := range cs.SlotsPerHistoricalRoot() {
+		require.NoError(t, st.UpdateBlockRootAtIndex(i, common.Root{}))
+		require.NoError(t, st.UpdateStateRootAtIndex(i, common.Root{}))
+	}
+	for i := range cs.EpochsPerHistoricalVector() {
+		require.NoError(t, st.UpdateRandaoMixAtIndex(i, primitives.Bytes32{}))
+	}
+	for i := range validators {
+		require.NoError(t, st.AddValidator(newValidator(i)))
+	}
+}
+
+// requireRoot requires the hash tree root of the state to be that of the
+// state read whole.
+func requireRoot(t testing.TB, st beaconState) [32]byte {
+	t.Helper()
+	root, err := st.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, fullRoot(t, st), root)
+	return root
+}
+
+// nextSlot changes the state as the processing of a block would.
+func nextSlot(t testing.TB, st beaconState, i uint64) {
+	t.Helper()
+	slot, err := st.GetSlot()
+	require.NoError(t, err)
+	root := common.Root{byte(slot), byte(i)}
+	require.NoError(t, st.UpdateStateRootAtIndex(uint64(slot)%8, root))
+	require.NoError(t, st.UpdateBlockRootAtIndex(uint64(slot)%8, root))
+	require.NoError(t, st.SetSlot(slot+1))
+	require.NoError(t, st.SetLatestBlockHeader(&types.BeaconBlockHeader{
+		BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+			Slot:            uint64(slot + 1),
+			ParentBlockRoot: root,
+		},
+	}))
+	require.NoError(t, st.UpdateRandaoMixAtIndex(
+		uint64(slot)%8, primitives.Bytes32(root),
+	))
+	require.NoError(t, st.IncreaseBalance(math.ValidatorIndex(i), 1))
+	require.NoError(t, st.DecreaseBalance(math.ValidatorIndex(i/2), 1))
+}
+
+func TestStateDB_HashTreeRoot(t *testing.T) {
+	db := newTestDB(t)
+	st, write := db.state()
+	initState(t, st, 10)
+	requireRoot(t, st)
+	write()
+
+	// Changes to every field and list.
+	for i := range uint64(10) {
+		nextSlot(t, st, i)
+		requireRoot(t, st)
+	}
+	require.NoError(t, st.AddValidator(newValidator(10)))
+	require.NoError(t, st.SetEth1DepositIndex(11))
+	requireRoot(t, st)
+	val := newValidator(3)
+	val.Slashed = true
+	require.NoError(t, st.UpdateValidatorAtIndex(3, val))
+	require.NoError(t, st.UpdateSlashingAtIndex(2, 5))
+	require.NoError(t, st.SetTotalSlashing(5))
+	require.NoError(t, st.SetNextWithdrawalIndex(7))
+	require.NoError(t, st.SetNextWithdrawalValidatorIndex(2))
+	require.NoError(t, st.SetEth1Data(&types.Eth1Data{DepositCount: 11}))
+	requireRoot(t, st)
+	write()
+
+	// A copy does not change the state until saved.
+	cpy := st.Copy()
+	before := requireRoot(t, st)
+	nextSlot(t, cpy, 4)
+	requireRoot(t, cpy)
+	nextSlot(t, cpy, 5)
+	require.Equal(t, before, requireRoot(t, st))
+	cpy.Save()
+	requireRoot(t, st)
+	write()
+
+	// A new context starts from the snapshot of the same state, with the
+	// changes made since.
+	proposal, _ := db.state()
+	requireRoot(t, proposal)
+	nextSlot(t, proposal, 1)
+	requireRoot(t, proposal)
+	finalize, write := db.state()
+	nextSlot(t, finalize, 1)
+	require.NoError(t, finalize.AddValidator(newValidator(11)))
+	write()
+	next, write := db.state()
+	requireRoot(t, next)
+
+	// A new context whose changes were not hashed.
+	nextSlot(t, next, 6)
+	write()
+	next, write = db.state()
+	requireRoot(t, next)
+
+	// Removing a validator makes the registry sparse.
+	require.NoError(t, next.RemoveValidatorAtIndex(4))
+	requireRoot(t, next)
+	nextSlot(t, next, 7)
+	requireRoot(t, next)
+	write()
+}
+
+func TestStateDB_Copy(t *testing.T) {
+	db := newTestDB(t)
+	st, write := db.state()
+	initState(t, st, 10)
+	write()
+	root := requireRoot(t, st)
+
+	// A discarded copy leaves no trace.
+	discarded := st.Copy()
+	nextSlot(t, discarded, 1)
+	require.NoError(t, discarded.RemoveValidatorAtIndex(2))
+	requireRoot(t, discarded)
+	require.Equal(t, root, requireRoot(t, st))
+
+	// Copies of the same state are independent, and may be transitioned
+	// concurrently.
+	var (
+		copies = make([]beaconState, 4)
+		roots  = make([][32]byte, len(copies))
+		g      errgroup.Group
+	)
+	for i := range copies {
+		copies[i] = st.Copy()
+		g.Go(func() error {
+			for j := range uint64(i + 1) {
+				nextSlot(t, copies[i], j)
+			}
+			var err error
+			roots[i], err = copies[i].HashTreeRoot()
+			return err
+		})
+	}
+	require.NoError(t, g.Wait())
+	for i, cpy := range copies {
+		require.Equal(t, roots[i], requireRoot(t, cpy))
+		slot, err := cpy.GetSlot()
+		require.NoError(t, err)
+		require.Equal(t, math.Slot(i+1), slot)
+	}
+
+	// A copy of a copy reads its parent, including the keys it removed, and
+	// saving both writes the changes through to the state.
+	parent := st.Copy()
+	require.NoError(t, parent.RemoveValidatorAtIndex(3))
+	nextSlot(t, parent, 4)
+	child := parent.Copy()
+	_, err := child.ValidatorByIndex(3)
+	require.Error(t, err)
+	nextSlot(t, child, 5)
+	require.NoError(t, child.AddValidator(newValidator(10)))
+	childRoot := requireRoot(t, child)
+	require.Equal(t, root, requireRoot(t, st))
+	child.Save()
+	require.Equal(t, childRoot, requireRoot(t, parent))
+	require.Equal(t, root, requireRoot(t, st))
+	parent.Save()
+	require.Equal(t, childRoot, requireRoot(t, st))
+	write()
+
+	next, _ := db.state()
+	require.Equal(t, childRoot, requireRoot(t, next))
+}
+
+func TestStateDB_Fork(t *testing.T) {
+	db := newTestDB(t)
+	st, write := db.state()
+	initState(t, st, 10)
+	write()
+	root := requireRoot(t, st)
+
+	// Saving a fork keeps its changes in memory, rather than writing them
+	// to the state.
+	fork := st.Fork()
+	nextSlot(t, fork, 3)
+	require.NoError(t, fork.RemoveValidatorAtIndex(4))
+	fork.Save()
+	forkRoot := requireRoot(t, fork)
+	require.NotEqual(t, root, forkRoot)
+	require.Equal(t, root, requireRoot(t, st))
+	_, err := fork.ValidatorByIndex(4)
+	require.Error(t, err)
+
+	// The saved changes are applied to the state.
+	require.NoError(t, st.Apply(fork))
+	require.Equal(t, forkRoot, requireRoot(t, st))
+}
+
+func TestStateDB_Apply(t *testing.T) {
+	db := newTestDB(t)
+	st, write := db.state()
+	initState(t, st, 10)
+	write()
+	root := requireRoot(t, st)
+
+	// A block is verified on a fork of the state of one context.
+	proposal, _ := db.state()
+	verified := proposal.Fork()
+	nextSlot(t, verified, 3)
+	require.NoError(t, verified.RemoveValidatorAtIndex(4))
+	require.NoError(t, verified.AddValidator(newValidator(10)))
+	verified.Save()
+	verifiedRoot := requireRoot(t, verified)
+	require.Equal(t, root, requireRoot(t, proposal))
+
+	// Its changes are applied to the identical state of another context,
+	// through a copy of the verified state, which starts from its trees.
+	finalize, write := db.state()
+	require.NoError(t, finalize.Apply(verified.Copy()))
+	require.Equal(t, verifiedRoot, requireRoot(t, finalize))
+	nextSlot(t, finalize, 5)
+	finalRoot := requireRoot(t, finalize)
+	write()
+
+	next, _ := db.state()
+	require.Equal(t, finalRoot, requireRoot(t, next))
+
+	// A copy that was not hashed leaves the state to be hashed from a
+	// snapshot or from scratch.
+	unhashed := next.Fork()
+	nextSlot(t, unhashed, 6)
+	unhashed.Save()
+	other, _ := db.state()
+	require.NoError(t, other.Apply(unhashed))
+	require.Equal(t, requireRoot(t, unhashed), requireRoot(t, other))
+
+	// Only the changes of copies can be applied.
+	require.ErrorIs(t, next.Apply(finalize), beacondb.ErrNotACopy)
+}
+
+func BenchmarkStateDB_HashTreeRoot(b *testing.B) {
+	for _, validators := range []uint64{10_000, 100_000} {
+		db := newTestDB(b)
+		st, write := db.state()
+		initState(b, st, validators)
+		write()
+
+		b.Run(fmt.Sprintf("full/%d", validators), func(b *testing.B) {
+			st, _ = db.state()
+			for i := range b.N {
+				nextSlot(b, st, uint64(i))
+				fullRoot(b, st)
+			}
+		})
+		b.Run(fmt.Sprintf("cached/%d", validators), func(b *testing.B) {
+			st, _ = db.state()
+			_, err := st.HashTreeRoot()
+			require.NoError(b, err)
+			b.ResetTimer()
+			for i := range b.N {
+				nextSlot(b, st, uint64(i))
+				_, err = st.HashTreeRoot()
+				require.NoError(b, err)
+			}
+		})
+	}
+}
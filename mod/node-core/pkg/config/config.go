@@ -23,11 +23,17 @@ package config
 import (
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	"github.com/berachain/beacon-kit/mod/errors"
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
 	viperlib "github.com/berachain/beacon-kit/mod/node-core/pkg/config/viper"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cobra"
@@ -37,21 +43,46 @@ import (
 // DefaultConfig returns the default configuration for a BeaconKit chain.
 func DefaultConfig() *Config {
 	return &Config{
-		Engine:         engineclient.DefaultConfig(),
-		KZG:            kzg.DefaultConfig(),
-		PayloadBuilder: builder.DefaultConfig(),
-		Validator:      validator.DefaultConfig(),
+		AvailabilityStore:  dastore.DefaultConfig(),
+		AvailabilityPruner: pruner.DefaultConfig(),
+		BlockPruner:        pruner.DefaultConfig(),
+		DepositPruner:      pruner.DefaultConfig(),
+		Engine:             engineclient.DefaultConfig(),
+		KZG:                kzg.DefaultConfig(),
+		NodeAPI:            server.DefaultConfig(),
+		PayloadBuilder:     builder.DefaultConfig(),
+		ProposerConfig:     proposer.DefaultConfig(),
+		Relay:              relay.DefaultConfig(),
+		Signer:             signer.DefaultConfig(),
+		Validator:          validator.DefaultConfig(),
 	}
 }
 
 // Config is the main configuration struct for the BeaconKit chain.
 type Config struct {
+	// AvailabilityStore is the configuration for the blob availability store.
+	AvailabilityStore dastore.Config `mapstructure:"availability-store"`
+	// AvailabilityPruner is the configuration for the blob sidecar pruner.
+	AvailabilityPruner pruner.Config `mapstructure:"availability-pruner"`
+	// BlockPruner is the configuration for the beacon block pruner.
+	BlockPruner pruner.Config `mapstructure:"block-pruner"`
+	// DepositPruner is the configuration for the deposit pruner.
+	DepositPruner pruner.Config `mapstructure:"deposit-pruner"`
 	// Engine is the configuration for the execution client.
 	Engine engineclient.Config `mapstructure:"engine"`
 	// KZG is the configuration for the KZG blob verifier.
 	KZG kzg.Config `mapstructure:"kzg"`
+	// NodeAPI is the configuration for the beacon node API.
+	NodeAPI server.Config `mapstructure:"node-api"`
 	// PayloadBuilder is the configuration for the local build payload timeout.
 	PayloadBuilder builder.Config `mapstructure:"payload-builder"`
+	// ProposerConfig is the configuration for the preferences of the
+	// proposers.
+	ProposerConfig proposer.Config `mapstructure:"proposer-config"`
+	// Relay is the configuration for the relays of external block builders.
+	Relay relay.Config `mapstructure:"relay"`
+	// Signer is the configuration for the BLS signer of the validator.
+	Signer signer.Config `mapstructure:"signer"`
 	// Validator is the configuration for the validator client.
 	Validator validator.Config `mapstructure:"validator"`
 }
@@ -124,10 +155,87 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Duration(flags.RPCJWTRefreshInterval,
 		defaultCfg.Engine.RPCJWTRefreshInterval,
 		"rpc jwt refresh interval")
+	startCmd.Flags().Duration(flags.RPCHealthCheckInterval,
+		defaultCfg.Engine.RPCHealthCheckInterval,
+		"rpc health check interval")
+	startCmd.Flags().StringSlice(flags.FailoverRPCDialURLs, nil,
+		"rpc dial urls of the failover execution clients")
+	startCmd.Flags().StringSlice(flags.FailoverJWTSecretPaths, nil,
+		"paths to the secrets of the failover execution clients")
+	startCmd.Flags().String(flags.RecordDir, defaultCfg.Engine.RecordDir,
+		"directory to record the engine api calls to")
+	startCmd.Flags().Uint64(flags.RecordMaxFileSize,
+		defaultCfg.Engine.RecordMaxFileSize,
+		"max size of an engine api recording file")
+	startCmd.Flags().Int(flags.RecordMaxFiles,
+		defaultCfg.Engine.RecordMaxFiles,
+		"max number of engine api recording files")
+	startCmd.Flags().String(flags.Graffiti,
+		defaultCfg.Validator.Graffiti,
+		"graffiti template of the proposed blocks")
+	startCmd.Flags().String(flags.GraffitiFile,
+		defaultCfg.Validator.GraffitiFile,
+		"file of the graffiti templates of the proposed blocks, one per slot")
 	startCmd.Flags().String(flags.SuggestedFeeRecipient,
 		defaultCfg.PayloadBuilder.SuggestedFeeRecipient.Hex(),
 		"suggested fee recipient",
 	)
+	startCmd.Flags().String(flags.ProposerConfigFile,
+		defaultCfg.ProposerConfig.File,
+		"path of the file mapping validator pubkeys to fee recipients")
+	startCmd.Flags().Duration(flags.ProposerConfigReloadInterval,
+		defaultCfg.ProposerConfig.ReloadInterval,
+		"interval at which the proposer config file is reloaded")
+	startCmd.Flags().Bool(flags.NodeAPIEnabled,
+		defaultCfg.NodeAPI.Enabled,
+		"serve the beacon node api")
+	startCmd.Flags().String(flags.NodeAPIAddress,
+		defaultCfg.NodeAPI.Address,
+		"address the beacon node api listens on")
+	startCmd.Flags().Bool(flags.RelayEnabled,
+		defaultCfg.Relay.Enabled,
+		"source payloads from external block builders")
+	startCmd.Flags().StringSlice(flags.RelayURLs, nil,
+		"urls of the relays of external block builders, as https://0xPUBKEY@host")
+	startCmd.Flags().Duration(flags.RelayTimeout,
+		defaultCfg.Relay.Timeout,
+		"timeout of the requests to the relays")
+	startCmd.Flags().Uint64(flags.RelayGasLimit,
+		defaultCfg.Relay.GasLimit,
+		"gas limit the external block builders are asked to target")
+	startCmd.Flags().Duration(flags.RelayRegistrationInterval,
+		defaultCfg.Relay.RegistrationInterval,
+		"interval of the validator registrations with the relays")
+	startCmd.Flags().String(flags.SignerType,
+		defaultCfg.Signer.Type,
+		"bls signer of the validator, either local, keystore or remote")
+	startCmd.Flags().String(flags.SignerKeystore,
+		defaultCfg.Signer.Keystore,
+		"path of the eip-2335 keystore of the validator")
+	startCmd.Flags().String(flags.SignerKeystorePwd,
+		defaultCfg.Signer.KeystorePasswordFile,
+		"path of the file holding the password of the keystore")
+	startCmd.Flags().String(flags.SignerURL,
+		defaultCfg.Signer.URL,
+		"url of the web3signer compatible remote signer")
+	startCmd.Flags().String(flags.SignerPublicKey,
+		defaultCfg.Signer.PublicKey,
+		"public key of the validator held by the remote signer")
+	startCmd.Flags().Duration(flags.SignerTimeout,
+		defaultCfg.Signer.Timeout,
+		"timeout of the requests to the remote signer")
+	startCmd.Flags().Uint64(flags.SignerRetries,
+		defaultCfg.Signer.Retries,
+		"retries of the failed requests to the remote signer")
+	startCmd.Flags().String(flags.SignerTLSCACert,
+		defaultCfg.Signer.TLSCACert,
+		"path of the ca certificate of the remote signer")
+	startCmd.Flags().String(flags.SignerTLSClientCert,
+		defaultCfg.Signer.TLSClientCert,
+		"path of the tls client certificate for the remote signer")
+	startCmd.Flags().String(flags.SignerTLSClientKey,
+		defaultCfg.Signer.TLSClientKey,
+		"path of the key of the tls client certificate")
 	startCmd.Flags().String(flags.KZGTrustedSetupPath,
 		defaultCfg.KZG.TrustedSetupPath,
 		"kzg trusted setup path",
@@ -135,6 +243,33 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().String(flags.KZGImplementation,
 		defaultCfg.KZG.Implementation,
 		"kzg implementation")
+	startCmd.Flags().Bool(flags.VerifyBlobsOnRead,
+		defaultCfg.AvailabilityStore.VerifyOnRead,
+		"re-verify blob sidecars when reading them from the store")
+	startCmd.Flags().Duration(flags.BlobIntegrityCheckInterval,
+		defaultCfg.AvailabilityStore.IntegrityCheckInterval,
+		"interval of the blob store integrity check, 0 disables it")
+	startCmd.Flags().Bool(flags.BlobArchiveMode,
+		defaultCfg.AvailabilityStore.ArchiveMode,
+		"disable the pruning of blob sidecars")
+	startCmd.Flags().Bool(flags.AvailabilityPrunerEnabled,
+		defaultCfg.AvailabilityPruner.Enabled,
+		"enable the blob sidecar pruner")
+	startCmd.Flags().Uint64(flags.AvailabilityPrunerRetentionSlots,
+		defaultCfg.AvailabilityPruner.RetentionSlots,
+		"number of slots of blob sidecars to retain")
+	startCmd.Flags().Bool(flags.BlockPrunerEnabled,
+		defaultCfg.BlockPruner.Enabled,
+		"enable the beacon block pruner")
+	startCmd.Flags().Uint64(flags.BlockPrunerRetentionSlots,
+		defaultCfg.BlockPruner.RetentionSlots,
+		"number of slots of beacon blocks to retain")
+	startCmd.Flags().Bool(flags.DepositPrunerEnabled,
+		defaultCfg.DepositPruner.Enabled,
+		"enable the deposit pruner")
+	startCmd.Flags().Uint64(flags.DepositPrunerRetentionSlots,
+		defaultCfg.DepositPruner.RetentionSlots,
+		"number of slots of deposits to retain")
 }
 
 // AddToSFlag adds the terms of service flag to the given command.
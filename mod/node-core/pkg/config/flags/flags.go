@@ -25,25 +25,89 @@ const (
 	beaconKitRoot      = "beacon-kit."
 	BeaconKitAcceptTos = beaconKitRoot + "accept-tos"
 
+	// Availability Store Config.
+	daStoreRoot                = beaconKitRoot + "availability-store."
+	VerifyBlobsOnRead          = daStoreRoot + "verify-on-read"
+	BlobIntegrityCheckInterval = daStoreRoot + "integrity-check-interval"
+	BlobArchiveMode            = daStoreRoot + "archive-mode"
+
+	// Availability Pruner Config.
+	daPrunerRoot                     = beaconKitRoot + "availability-pruner."
+	AvailabilityPrunerEnabled        = daPrunerRoot + "enabled"
+	AvailabilityPrunerRetentionSlots = daPrunerRoot + "retention-slots"
+
 	// Builder Config.
 	builderRoot              = beaconKitRoot + "builder."
 	SuggestedFeeRecipient    = builderRoot + "suggested-fee-recipient"
-	Graffiti                 = builderRoot + "graffiti"
 	LocalBuilderEnabled      = builderRoot + "local-builder-enabled"
 	LocalBuildPayloadTimeout = builderRoot + "local-build-payload-timeout"
 
+	// Validator Config.
+	validatorRoot = beaconKitRoot + "validator."
+	Graffiti      = validatorRoot + "graffiti"
+	GraffitiFile  = validatorRoot + "graffiti-file"
+
+	// Block Pruner Config.
+	blockPrunerRoot           = beaconKitRoot + "block-pruner."
+	BlockPrunerEnabled        = blockPrunerRoot + "enabled"
+	BlockPrunerRetentionSlots = blockPrunerRoot + "retention-slots"
+
+	// Deposit Pruner Config.
+	depositPrunerRoot           = beaconKitRoot + "deposit-pruner."
+	DepositPrunerEnabled        = depositPrunerRoot + "enabled"
+	DepositPrunerRetentionSlots = depositPrunerRoot + "retention-slots"
+
 	// Engine Config.
 	engineRoot              = beaconKitRoot + "engine."
 	RPCDialURL              = engineRoot + "rpc-dial-url"
 	RPCRetries              = engineRoot + "rpc-retries"
 	RPCTimeout              = engineRoot + "rpc-timeout"
 	RPCStartupCheckInterval = engineRoot + "rpc-startup-check-interval"
-	RPCHealthCheckInteval   = engineRoot + "rpc-health-check-interval"
+	RPCHealthCheckInterval  = engineRoot + "rpc-health-check-interval"
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
+	FailoverRPCDialURLs     = engineRoot + "failover-rpc-dial-urls"
+	FailoverJWTSecretPaths  = engineRoot + "failover-jwt-secret-paths"
+	RecordDir               = engineRoot + "record-dir"
+	RecordMaxFileSize       = engineRoot + "record-max-file-size"
+	RecordMaxFiles          = engineRoot + "record-max-files"
+
+	// Node API Config.
+	nodeAPIRoot    = beaconKitRoot + "node-api."
+	NodeAPIEnabled = nodeAPIRoot + "enabled"
+	NodeAPIAddress = nodeAPIRoot + "address"
+
+	// Proposer Config.
+	proposerConfigRoot           = beaconKitRoot + "proposer-config."
+	ProposerConfigFile           = proposerConfigRoot + "file"
+	ProposerConfigReloadInterval = proposerConfigRoot + "reload-interval"
+
+	// Relay Config.
+	relayRoot                 = beaconKitRoot + "relay."
+	RelayEnabled              = relayRoot + "enabled"
+	RelayURLs                 = relayRoot + "urls"
+	RelayTimeout              = relayRoot + "timeout"
+	RelayGasLimit             = relayRoot + "gas-limit"
+	RelayRegistrationInterval = relayRoot + "registration-interval"
+
+	// Signer Config.
+	signerRoot          = beaconKitRoot + "signer."
+	SignerType          = signerRoot + "type"
+	SignerKeystore      = signerRoot + "keystore"
+	SignerKeystorePwd   = signerRoot + "keystore-password-file"
+	SignerURL           = signerRoot + "url"
+	SignerPublicKey     = signerRoot + "public-key"
+	SignerTimeout       = signerRoot + "timeout"
+	SignerRetries       = signerRoot + "retries"
+	SignerTLSCACert     = signerRoot + "tls-ca-cert"
+	SignerTLSClientCert = signerRoot + "tls-client-cert"
+	SignerTLSClientKey  = signerRoot + "tls-client-key"
 
 	// KZG Config.
 	kzgRoot             = beaconKitRoot + "kzg."
 	KZGTrustedSetupPath = kzgRoot + "trusted-setup-path"
 	KZGImplementation   = kzgRoot + "implementation"
+
+	// CometBFT Config.
+	CometRPCListenAddress = "rpc.laddr"
 )
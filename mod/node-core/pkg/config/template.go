@@ -26,8 +26,46 @@ const Template = `
 ###                                BeaconKit                                ###
 ###############################################################################
 
+[beacon-kit.availability-pruner]
+# Enables the pruning of blob sidecars.
+enabled = {{ .BeaconKit.AvailabilityPruner.Enabled }}
+
+# Number of slots of blob sidecars to retain. Setting this to 0 retains
+# only the data availability period.
+retention-slots = {{ .BeaconKit.AvailabilityPruner.RetentionSlots }}
+
+[beacon-kit.availability-store]
+# Re-verify the KZG and inclusion proofs of blob sidecars when they are read from the store.
+verify-on-read = {{ .BeaconKit.AvailabilityStore.VerifyOnRead }}
+
+# Interval at which every stored blob sidecar is re-verified in the background.
+# Setting this to 0 disables the integrity check.
+integrity-check-interval = "{{ .BeaconKit.AvailabilityStore.IntegrityCheckInterval }}"
+
+# Archive mode disables the pruning of blob sidecars that fall outside of the
+# data availability period.
+archive-mode = {{ .BeaconKit.AvailabilityStore.ArchiveMode }}
+
+[beacon-kit.block-pruner]
+# Enables the pruning of the beacon blocks kept to replay execution payloads
+# to the execution client.
+enabled = {{ .BeaconKit.BlockPruner.Enabled }}
+
+# Number of slots of beacon blocks to retain. Setting this to 0 retains
+# the last 8192 slots.
+retention-slots = {{ .BeaconKit.BlockPruner.RetentionSlots }}
+
+[beacon-kit.deposit-pruner]
+# Enables the pruning of deposits.
+enabled = {{ .BeaconKit.DepositPruner.Enabled }}
+
+# Number of slots of deposits to retain. Setting this to 0 retains
+# only the deposits of the latest block.
+retention-slots = {{ .BeaconKit.DepositPruner.RetentionSlots }}
+
 [beacon-kit.engine]
-# HTTP url of the execution client JSON-RPC endpoint.
+# Url of the execution client JSON-RPC endpoint. The http(s), ws(s) and ipc
+# schemes are supported, e.g. "ipc:///path/to/geth.ipc".
 rpc-dial-url = "{{ .BeaconKit.Engine.RPCDialURL }}"
 
 # Number of retries before shutting down consensus client.
@@ -45,6 +83,28 @@ rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
+# Interval for the health check of the execution clients.
+rpc-health-check-interval = "{{ .BeaconKit.Engine.RPCHealthCheckInterval }}"
+
+# Urls of the failover execution clients. They receive every forkchoice
+# update and new payload alongside the primary and take over from it when it
+# becomes unhealthy.
+failover-rpc-dial-urls = [{{ range $i, $url := .BeaconKit.Engine.FailoverRPCDialURLs }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
+# Paths to the JWT-secrets of the failover execution clients, in the same order
+# as failover-rpc-dial-urls. Failovers without one use jwt-secret-path.
+failover-jwt-secret-paths = [{{ range $i, $path := .BeaconKit.Engine.FailoverJWTSecretPaths }}{{ if $i }}, {{ end }}"{{ $path }}"{{ end }}]
+
+# Directory to record the requests and responses of the engine API calls to,
+# so that they can be replayed offline. Leave empty to disable the recording.
+record-dir = "{{ .BeaconKit.Engine.RecordDir }}"
+
+# Size in bytes after which the recording rotates to a new file.
+record-max-file-size = {{ .BeaconKit.Engine.RecordMaxFileSize }}
+
+# Number of recording files to keep, the oldest are removed.
+record-max-files = {{ .BeaconKit.Engine.RecordMaxFiles }}
+
 [beacon-kit.kzg]
 # Path to the trusted setup path.
 trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
@@ -66,10 +126,88 @@ suggested-fee-recipient = "{{.BeaconKit.PayloadBuilder.SuggestedFeeRecipient}}"
 # timeout_proposal in the CometBFT configuration.
 payload-timeout = "{{ .BeaconKit.PayloadBuilder.PayloadTimeout }}"
 
+[beacon-kit.node-api]
+# Enabled determines if the beacon node API is served.
+enabled = {{ .BeaconKit.NodeAPI.Enabled }}
+
+# Address the beacon node API listens on.
+address = "{{ .BeaconKit.NodeAPI.Address }}"
+
+[beacon-kit.proposer-config]
+# Path of the proposer config file, a JSON file mapping the pubkeys of
+# validators to their fee recipient and gas limit, with a default_config for
+# the others. Fee recipients prepared through prepare_beacon_proposer take
+# precedence over it. Leave empty to use suggested-fee-recipient for all.
+file = "{{ .BeaconKit.ProposerConfig.File }}"
+
+# Interval at which the proposer config file is reloaded when it changes.
+reload-interval = "{{ .BeaconKit.ProposerConfig.ReloadInterval }}"
+
+[beacon-kit.relay]
+# Enabled determines if payloads are also sourced from external block builders,
+# whose bids are used when they are more valuable than the local payload.
+enabled = {{ .BeaconKit.Relay.Enabled }}
+
+# Urls of the relays of the external block builders, each carrying the public
+# key the relay signs its bids with, i.e. "https://0xPUBKEY@host".
+urls = [{{ range $i, $url := .BeaconKit.Relay.URLs }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
+# Timeout of the requests to the relays, after which the local payload is used.
+# It must leave enough time to build the block within timeout_propose in the
+# CometBFT configuration.
+timeout = "{{ .BeaconKit.Relay.Timeout }}"
+
+# Gas limit the external block builders are asked to target.
+gas-limit = {{ .BeaconKit.Relay.GasLimit }}
+
+# Interval at which the validator is registered with the relays.
+registration-interval = "{{ .BeaconKit.Relay.RegistrationInterval }}"
+
+[beacon-kit.signer]
+# Signer of the validator, either local to sign with the key files of the node,
+# keystore to sign with the key of an EIP-2335 keystore, or remote to sign with
+# a Web3Signer compatible remote signer.
+type = "{{ .BeaconKit.Signer.Type }}"
+
+# Path of the EIP-2335 keystore holding the key of the validator.
+keystore = "{{ .BeaconKit.Signer.Keystore }}"
+
+# Path of the file holding the password of the keystore.
+keystore-password-file = "{{ .BeaconKit.Signer.KeystorePasswordFile }}"
+
+# Url of the remote signer.
+url = "{{ .BeaconKit.Signer.URL }}"
+
+# Public key of the validator, whose key is held by the remote signer.
+public-key = "{{ .BeaconKit.Signer.PublicKey }}"
+
+# Timeout of a request to the remote signer.
+timeout = "{{ .BeaconKit.Signer.Timeout }}"
+
+# Number of times a request to the remote signer is retried after failing to
+# reach it, or after a server error.
+retries = {{ .BeaconKit.Signer.Retries }}
+
+# Path of the CA certificate the remote signer is verified against, the system
+# certificates if empty.
+tls-ca-cert = "{{ .BeaconKit.Signer.TLSCACert }}"
+
+# Paths of the certificate and key the node authenticates with to the remote
+# signer, if any.
+tls-client-cert = "{{ .BeaconKit.Signer.TLSClientCert }}"
+tls-client-key = "{{ .BeaconKit.Signer.TLSClientKey }}"
+
 [beacon-kit.validator]
-# Graffiti string that will be included in the graffiti field of the beacon block.
+# Graffiti template of the proposed blocks, truncated to 32 bytes. The
+# placeholders {version} and {commit} are replaced with the version and commit
+# of beacon-kit, and {el_code}, {el_name}, {el_version} and {el_commit} with
+# the code, name, version and commit of the execution client.
 graffiti = "{{.BeaconKit.Validator.Graffiti}}"
 
+# Path of a file whose non-empty lines are used in turn as the graffiti
+# template of each slot, instead of the graffiti above.
+graffiti-file = "{{.BeaconKit.Validator.GraffitiFile}}"
+
 # EnableOptimisticPayloadBuilds enables building the next block's payload optimistically in
 # process-proposal to allow for the execution client to have more time to assemble the block.
 enable-optimistic-payload-builds = "{{.BeaconKit.Validator.EnableOptimisticPayloadBuilds}}"
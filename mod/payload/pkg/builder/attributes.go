@@ -22,12 +22,14 @@ package builder
 
 import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // getPayloadAttributes returns the payload attributes for the given state and
-// slot. The attribute is required to initiate a payload build process in the
-// context of an `engine_forkchoiceUpdated` call.
+// slot, paying the given fee recipient. The attribute is required to initiate
+// a payload build process in the context of an `engine_forkchoiceUpdated`
+// call.
 func (pb *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 ]) getPayloadAttribute(
@@ -35,6 +37,7 @@ func (pb *PayloadBuilder[
 	slot math.Slot,
 	timestamp uint64,
 	prevHeadRoot [32]byte,
+	feeRecipient common.ExecutionAddress,
 ) (engineprimitives.PayloadAttributer, error) {
 	var (
 		prevRandao [32]byte
@@ -65,8 +68,32 @@ func (pb *PayloadBuilder[
 		pb.chainSpec.ActiveForkVersionForEpoch(epoch),
 		timestamp,
 		prevRandao,
-		pb.cfg.SuggestedFeeRecipient,
+		feeRecipient,
 		withdrawals,
 		prevHeadRoot,
 	)
 }
+
+// localFeeRecipient returns the fee recipient of the local validator, whose
+// validator index is looked up in the given state. Payloads requested ahead
+// of a proposal are built for the local validator.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+]) localFeeRecipient(st BeaconStateT) common.ExecutionAddress {
+	pubkey := pb.signer.PublicKey()
+	index, err := st.ValidatorIndexByPubkey(pubkey)
+	if err != nil {
+		return pb.proposers.PreferredFeeRecipient(pubkey)
+	}
+	return pb.proposers.FeeRecipient(index, pubkey)
+}
+
+// proposerFeeRecipient returns the fee recipient of the proposer with the
+// given validator index, which is the local validator.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+]) proposerFeeRecipient(
+	proposerIndex math.ValidatorIndex,
+) common.ExecutionAddress {
+	return pb.proposers.FeeRecipient(proposerIndex, pb.signer.PublicKey())
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -53,6 +54,11 @@ type PayloadBuilder[
 	logger log.Logger[any]
 	// ee is the execution engine.
 	ee ExecutionEngine[ExecutionPayloadT]
+	// proposers resolves the fee recipients of the payloads.
+	proposers ProposerConfig
+	// signer is used to retrieve the public key of the validator the
+	// payloads are built for.
+	signer crypto.BLSSigner
 	// pc is the payload ID cache, it is used to store
 	// "in-flight" payloads that are being built on
 	// the execution client.
@@ -80,6 +86,8 @@ func New[
 	chainSpec primitives.ChainSpec,
 	logger log.Logger[any],
 	ee ExecutionEngine[ExecutionPayloadT],
+	proposers ProposerConfig,
+	signer crypto.BLSSigner,
 	pc *cache.PayloadIDCache[
 		engineprimitves.PayloadID, [32]byte, math.Slot,
 	],
@@ -93,6 +101,8 @@ func New[
 		chainSpec: chainSpec,
 		logger:    logger,
 		ee:        ee,
+		proposers: proposers,
+		signer:    signer,
 		pc:        pc,
 	}
 }
@@ -32,7 +32,8 @@ import (
 )
 
 // RequestPayload builds a payload for the given slot and
-// returns the payload ID.
+// returns the payload ID. The payload is built ahead of a proposal, for the
+// local validator.
 func (pb *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 ]) RequestPayloadAsync(
@@ -47,7 +48,32 @@ func (pb *PayloadBuilder[
 	if !pb.Enabled() {
 		return nil, ErrPayloadBuilderDisabled
 	}
+	return pb.requestPayload(
+		ctx,
+		st,
+		slot,
+		timestamp,
+		parentBlockRoot,
+		headEth1BlockHash,
+		finalEth1BlockHash,
+		pb.localFeeRecipient(st),
+	)
+}
 
+// requestPayload builds a payload for the given slot, paying the given fee
+// recipient, and returns the payload ID.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+]) requestPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	timestamp uint64,
+	parentBlockRoot primitives.Root,
+	headEth1BlockHash common.ExecutionHash,
+	finalEth1BlockHash common.ExecutionHash,
+	feeRecipient common.ExecutionAddress,
+) (*engineprimitives.PayloadID, error) {
 	if payloadID, found := pb.pc.Get(slot, parentBlockRoot); found {
 		pb.logger.Warn(
 			"aborting payload build; payload already exists in cache",
@@ -60,7 +86,9 @@ func (pb *PayloadBuilder[
 	}
 
 	// Assemble the payload attributes.
-	attrs, err := pb.getPayloadAttribute(st, slot, timestamp, parentBlockRoot)
+	attrs, err := pb.getPayloadAttribute(
+		st, slot, timestamp, parentBlockRoot, feeRecipient,
+	)
 	if err != nil {
 		return nil, errors.Newf("%w error when getting payload attributes", err)
 	}
@@ -102,14 +130,16 @@ func (pb *PayloadBuilder[
 	return payloadID, nil
 }
 
-// RequestPayload request a payload for the given slot and
-// blocks until the payload is delivered.
+// RequestPayload request a payload for the given slot, paying the fee
+// recipient of the proposer with the given validator index, and blocks until
+// the payload is delivered.
 func (pb *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 ]) RequestPayloadSync(
 	ctx context.Context,
 	st BeaconStateT,
 	slot math.Slot,
+	proposerIndex math.ValidatorIndex,
 	timestamp uint64,
 	parentBlockRoot primitives.Root,
 	parentEth1Hash common.ExecutionHash,
@@ -121,7 +151,8 @@ func (pb *PayloadBuilder[
 
 	// Build the payload and wait for the execution client to
 	// return the payload ID.
-	payloadID, err := pb.RequestPayloadAsync(
+	feeRecipient := pb.proposerFeeRecipient(proposerIndex)
+	payloadID, err := pb.requestPayload(
 		ctx,
 		st,
 		slot,
@@ -129,6 +160,7 @@ func (pb *PayloadBuilder[
 		parentBlockRoot,
 		parentEth1Hash,
 		finalBlockHash,
+		feeRecipient,
 	)
 	if err != nil {
 		return nil, err
@@ -151,24 +183,33 @@ func (pb *PayloadBuilder[
 	}
 
 	// Get the payload from the execution client.
-	return pb.ee.GetPayload(
+	envelope, err := pb.ee.GetPayload(
 		ctx,
 		&engineprimitives.GetPayloadRequest{
 			PayloadID:   *payloadID,
 			ForkVersion: pb.chainSpec.ActiveForkVersionForSlot(slot),
 		},
 	)
+	if err != nil {
+		return nil, err
+	} else if envelope == nil {
+		return nil, ErrNilPayloadEnvelope
+	}
+	pb.checkFeeRecipient(envelope.GetExecutionPayload(), feeRecipient)
+	return envelope, nil
 }
 
 // RetrieveOrBuildPayload attempts to pull a previously built payload
 // by reading a payloadID from the builder's cache. If it fails to
 // retrieve a payload, it will build a new payload and wait for the
-// execution client to return the payload.
+// execution client to return the payload. The payload is expected to pay the
+// fee recipient of the proposer with the given validator index.
 func (pb *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 ]) RetrievePayload(
 	ctx context.Context,
 	slot math.Slot,
+	proposerIndex math.ValidatorIndex,
 	parentBlockRoot primitives.Root,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	if !pb.Enabled() {
@@ -216,21 +257,32 @@ func (pb *PayloadBuilder[
 
 	pb.logger.Info("payload retrieved from local builder 🏗️ ", args...)
 
+	pb.checkFeeRecipient(payload, pb.proposerFeeRecipient(proposerIndex))
+	return envelope, err
+}
+
+// checkFeeRecipient warns if the given payload does not pay the given fee
+// recipient.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+]) checkFeeRecipient(
+	payload ExecutionPayloadT,
+	feeRecipient common.ExecutionAddress,
+) {
 	// If the payload was built by a different builder, something is
 	// wrong the EL<>CL setup.
-	if payload.GetFeeRecipient() != pb.cfg.SuggestedFeeRecipient {
+	if !payload.IsNil() && payload.GetFeeRecipient() != feeRecipient {
 		pb.logger.Warn(
 			"payload fee recipient does not match suggested fee recipient - "+
 				"please check both your CL and EL configuration",
 			"payload_fee_recipient", payload.GetFeeRecipient(),
-			"suggested_fee_recipient", pb.cfg.SuggestedFeeRecipient,
+			"suggested_fee_recipient", feeRecipient,
 		)
 	}
-	return envelope, err
 }
 
-// RequestPayload builds a payload for the given slot and
-// returns the payload ID.
+// SendForceHeadFCU sends a forkchoice update without attributes for the
+// given slot, forcing the head of the execution client.
 //
 // TODO: This should be moved onto a "sync service"
 // of some kind.
@@ -238,29 +290,25 @@ func (pb *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 ]) SendForceHeadFCU(
 	ctx context.Context,
-	st BeaconStateT,
 	slot math.Slot,
+	headEth1BlockHash common.ExecutionHash,
+	finalEth1BlockHash common.ExecutionHash,
 ) error {
-	lph, err := st.GetLatestExecutionPayloadHeader()
-	if err != nil {
-		return err
-	}
-
 	pb.logger.Info(
 		"sending startup forkchoice update to execution client 🚀 ",
-		"head_eth1_hash", lph.GetBlockHash(),
-		"safe_eth1_hash", lph.GetParentHash(),
-		"finalized_eth1_hash", lph.GetParentHash(),
+		"head_eth1_hash", headEth1BlockHash,
+		"safe_eth1_hash", finalEth1BlockHash,
+		"finalized_eth1_hash", finalEth1BlockHash,
 		"for_slot", slot,
 	)
 
 	// Submit the forkchoice update to the execution client.
-	_, _, err = pb.ee.NotifyForkchoiceUpdate(
+	_, _, err := pb.ee.NotifyForkchoiceUpdate(
 		ctx, &engineprimitives.ForkchoiceUpdateRequest{
 			State: &engineprimitives.ForkchoiceStateV1{
-				HeadBlockHash:      lph.GetBlockHash(),
-				SafeBlockHash:      lph.GetParentHash(),
-				FinalizedBlockHash: lph.GetParentHash(),
+				HeadBlockHash:      headEth1BlockHash,
+				SafeBlockHash:      finalEth1BlockHash,
+				FinalizedBlockHash: finalEth1BlockHash,
 			},
 			PayloadAttributes: nil,
 			ForkVersion:       pb.chainSpec.ActiveForkVersionForSlot(slot),
@@ -52,6 +52,18 @@ type BeaconState[ExecutionPayloadHeaderT interface {
 	GetBlockRootAtIndex(uint64) (primitives.Root, error)
 }
 
+// ProposerConfig resolves the preferences of the proposers.
+type ProposerConfig interface {
+	// FeeRecipient returns the fee recipient of the proposer with the given
+	// validator index and public key.
+	FeeRecipient(
+		index math.ValidatorIndex, pubkey crypto.BLSPubkey,
+	) common.ExecutionAddress
+	// PreferredFeeRecipient returns the fee recipient of the proposer with
+	// the given public key, for a proposer without a validator index yet.
+	PreferredFeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress
+}
+
 // ExecutionEngine is the interface for the execution engine.
 type ExecutionEngine[ExecutionPayloadT any] interface {
 	// GetPayload returns the payload and blobs bundle for the given slot.
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proposer
+
+import "time"
+
+const (
+	// defaultReloadInterval is the default interval at which the proposer
+	// config file is checked for changes.
+	defaultReloadInterval = 10 * time.Second
+)
+
+// Config is the configuration for the preferences of the proposers.
+type Config struct {
+	// File is the path of the proposer config file, which maps the public
+	// keys of the validators to their preferences. An empty path disables
+	// it.
+	File string `mapstructure:"file"`
+	// ReloadInterval is the interval at which the file is checked for
+	// changes and reloaded. A zero interval disables reloading.
+	ReloadInterval time.Duration `mapstructure:"reload-interval"`
+}
+
+// DefaultConfig returns the default configuration for the preferences of
+// the proposers.
+func DefaultConfig() Config {
+	return Config{
+		File:           "",
+		ReloadInterval: defaultReloadInterval,
+	}
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proposer
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// file is the JSON layout of the proposer config file:
+//
+//	{
+//	  "proposer_config": {
+//	    "0xa99a...": {"fee_recipient": "0x50155530...", "gas_limit": "30000000"}
+//	  },
+//	  "default_config": {"fee_recipient": "0x6e35733c..."}
+//	}
+//
+// Every field of the preferences is optional and falls back to the default
+// config of the file, and then to the defaults of the node.
+type file struct {
+	ProposerConfig map[string]*filePreferences `json:"proposer_config"`
+	DefaultConfig  *filePreferences            `json:"default_config"`
+}
+
+// filePreferences are the preferences of a proposer in the file.
+type filePreferences struct {
+	FeeRecipient *common.ExecutionAddress `json:"fee_recipient"`
+	GasLimit     *uint64                  `json:"gas_limit,string"`
+}
+
+// apply overrides the given preferences with the ones set in the file.
+func (p *filePreferences) apply(prefs Preferences) (Preferences, error) {
+	if p == nil {
+		return prefs, nil
+	}
+	if p.FeeRecipient != nil {
+		if *p.FeeRecipient == common.ZeroAddress {
+			return prefs, ErrZeroFeeRecipient
+		}
+		prefs.FeeRecipient = *p.FeeRecipient
+	}
+	if p.GasLimit != nil {
+		prefs.GasLimit = math.U64(*p.GasLimit)
+	}
+	return prefs, nil
+}
+
+// readFile reads the proposer config file at the given path and resolves
+// the preferences it sets on top of the given defaults.
+func readFile(path string, defaults Preferences) (
+	Preferences, map[crypto.BLSPubkey]Preferences, error,
+) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return defaults, nil, err
+	}
+	var f file
+	if err = json.Unmarshal(bz, &f); err != nil {
+		return defaults, nil, err
+	}
+
+	if defaults, err = f.DefaultConfig.apply(defaults); err != nil {
+		return defaults, nil, errors.Wrap(err, "default_config")
+	}
+	proposers := make(map[crypto.BLSPubkey]Preferences, len(f.ProposerConfig))
+	for key, p := range f.ProposerConfig {
+		var pubkey crypto.BLSPubkey
+		if err = pubkey.UnmarshalText([]byte(key)); err != nil {
+			return defaults, nil, errors.Wrapf(ErrInvalidPubkey, "%s", key)
+		}
+		if proposers[pubkey], err = p.apply(defaults); err != nil {
+			return defaults, nil, errors.Wrap(err, key)
+		}
+	}
+	return defaults, proposers, nil
+}
@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proposer
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Preferences are the preferences of a proposer for the payloads of its
+// blocks.
+type Preferences struct {
+	// FeeRecipient is the address that receives the fees of the payloads.
+	FeeRecipient common.ExecutionAddress
+	// GasLimit is the gas limit external builders are asked to target.
+	GasLimit math.U64
+}
+
+// Store holds the preferences of the proposers. They are resolved, in
+// order of precedence, from the fee recipients prepared for the validator
+// indexes through the beacon API, from the entries of the proposer config
+// file for the public keys, from the default config of the file and from
+// the defaults of the node. The file is reloaded whenever it changes.
+type Store struct {
+	// cfg is the configuration of the store.
+	cfg *Config
+	// logger is used for logging.
+	logger log.Logger[any]
+	// nodeDefaults are the preferences of the proposers not configured
+	// otherwise.
+	nodeDefaults Preferences
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// modTime is the modification time of the loaded file.
+	modTime time.Time
+	// defaults are the preferences of the proposers that are not in the
+	// file.
+	defaults Preferences
+	// proposers are the preferences of the proposers in the file.
+	proposers map[crypto.BLSPubkey]Preferences
+	// prepared are the fee recipients prepared for the validator indexes.
+	prepared map[math.ValidatorIndex]common.ExecutionAddress
+}
+
+// NewStore creates a new store of the preferences of the proposers and
+// loads the proposer config file, if any.
+func NewStore(
+	cfg *Config,
+	logger log.Logger[any],
+	nodeDefaults Preferences,
+) (*Store, error) {
+	s := &Store{
+		cfg:          cfg,
+		logger:       logger,
+		nodeDefaults: nodeDefaults,
+		defaults:     nodeDefaults,
+		proposers:    make(map[crypto.BLSPubkey]Preferences),
+		prepared:     make(map[math.ValidatorIndex]common.ExecutionAddress),
+	}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Name returns the name of the service.
+func (*Store) Name() string {
+	return "proposer-config"
+}
+
+// Start periodically reloads the proposer config file, if enabled.
+func (s *Store) Start(ctx context.Context) error {
+	if s.cfg.File == "" || s.cfg.ReloadInterval == 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(s.cfg.ReloadInterval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				if err := s.Reload(); err != nil {
+					s.logger.Error(
+						"failed to reload proposer config, keeping the "+
+							"previous one",
+						"file", s.cfg.File, "error", err,
+					)
+				}
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Status returns nil if the service is healthy.
+func (*Store) Status() error {
+	return nil
+}
+
+// WaitForHealthy waits for the service to be healthy.
+func (*Store) WaitForHealthy(context.Context) {}
+
+// Reload loads the proposer config file if it changed since it was last
+// loaded. The previous preferences are kept if the file is invalid.
+func (s *Store) Reload() error {
+	if s.cfg.File == "" {
+		return nil
+	}
+
+	info, err := os.Stat(s.cfg.File)
+	if err != nil {
+		return err
+	}
+	s.mu.RLock()
+	unchanged := info.ModTime().Equal(s.modTime)
+	s.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	defaults, proposers, err := readFile(s.cfg.File, s.nodeDefaults)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.modTime = info.ModTime()
+	s.defaults = defaults
+	s.proposers = proposers
+	s.logger.Info(
+		"loaded proposer config 📝",
+		"file", s.cfg.File, "num_proposers", len(proposers),
+	)
+	return nil
+}
+
+// Prepare sets the fee recipient of the validator with the given index,
+// which takes precedence over the proposer config file.
+func (s *Store) Prepare(
+	index math.ValidatorIndex,
+	feeRecipient common.ExecutionAddress,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prepared[index] = feeRecipient
+}
+
+// Preferences returns the preferences of the proposer with the given public
+// key, ignoring the fee recipients prepared for the validator indexes.
+func (s *Store) Preferences(pubkey crypto.BLSPubkey) Preferences {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if prefs, ok := s.proposers[pubkey]; ok {
+		return prefs
+	}
+	return s.defaults
+}
+
+// FeeRecipient returns the fee recipient of the proposer with the given
+// validator index and public key. The fee recipient prepared for the index
+// takes precedence over the preferences of the public key.
+func (s *Store) FeeRecipient(
+	index math.ValidatorIndex,
+	pubkey crypto.BLSPubkey,
+) common.ExecutionAddress {
+	s.mu.RLock()
+	feeRecipient, ok := s.prepared[index]
+	s.mu.RUnlock()
+	if ok {
+		return feeRecipient
+	}
+	return s.PreferredFeeRecipient(pubkey)
+}
+
+// PreferredFeeRecipient returns the fee recipient of the preferences of the
+// proposer with the given public key, for a proposer without a validator
+// index yet.
+func (s *Store) PreferredFeeRecipient(
+	pubkey crypto.BLSPubkey,
+) common.ExecutionAddress {
+	return s.Preferences(pubkey).FeeRecipient
+}
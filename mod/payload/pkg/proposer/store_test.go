@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proposer_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	pubkey1 = crypto.BLSPubkey{0x01}
+	pubkey2 = crypto.BLSPubkey{0x02}
+
+	nodeDefaults = proposer.Preferences{
+		FeeRecipient: common.ExecutionAddress{0xaa},
+		GasLimit:     30_000_000,
+	}
+)
+
+func writeFile(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func newStore(t *testing.T, path string) *proposer.Store {
+	t.Helper()
+	cfg := proposer.DefaultConfig()
+	cfg.File = path
+	s, err := proposer.NewStore(&cfg, noop.NewLogger(), nodeDefaults)
+	require.NoError(t, err)
+	return s
+}
+
+func TestStore_NodeDefaults(t *testing.T) {
+	s := newStore(t, "")
+	require.Equal(t, nodeDefaults, s.Preferences(pubkey1))
+	require.Equal(t, nodeDefaults.FeeRecipient, s.FeeRecipient(1, pubkey1))
+	require.Equal(
+		t, nodeDefaults.FeeRecipient, s.PreferredFeeRecipient(pubkey1),
+	)
+}
+
+func TestStore_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proposers.json")
+	writeFile(t, path, `{
+		"proposer_config": {
+			"`+pubkey1.String()+`": {
+				"fee_recipient": "0x0100000000000000000000000000000000000000"
+			}
+		},
+		"default_config": {
+			"fee_recipient": "0x0200000000000000000000000000000000000000",
+			"gas_limit": "36000000"
+		}
+	}`, time.Now())
+	s := newStore(t, path)
+
+	// The proposer inherits the gas limit of the default config.
+	require.Equal(t, proposer.Preferences{
+		FeeRecipient: common.ExecutionAddress{0x01},
+		GasLimit:     36_000_000,
+	}, s.Preferences(pubkey1))
+	require.Equal(t, proposer.Preferences{
+		FeeRecipient: common.ExecutionAddress{0x02},
+		GasLimit:     36_000_000,
+	}, s.Preferences(pubkey2))
+}
+
+func TestStore_Prepare(t *testing.T) {
+	s := newStore(t, "")
+	s.Prepare(3, common.ExecutionAddress{0x03})
+
+	require.Equal(
+		t, common.ExecutionAddress{0x03}, s.FeeRecipient(3, pubkey1),
+	)
+	require.Equal(t, nodeDefaults.FeeRecipient, s.FeeRecipient(4, pubkey2))
+	// Preparations do not apply to proposers without a validator index.
+	require.Equal(
+		t, nodeDefaults.FeeRecipient, s.PreferredFeeRecipient(pubkey1),
+	)
+}
+
+func TestStore_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proposers.json")
+	modTime := time.Now().Add(-time.Hour)
+	writeFile(t, path, `{"default_config": {
+		"fee_recipient": "0x0100000000000000000000000000000000000000"
+	}}`, modTime)
+	s := newStore(t, path)
+
+	// A changed file is reloaded.
+	modTime = modTime.Add(time.Minute)
+	writeFile(t, path, `{"default_config": {
+		"fee_recipient": "0x0200000000000000000000000000000000000000"
+	}}`, modTime)
+	require.NoError(t, s.Reload())
+	require.Equal(
+		t, common.ExecutionAddress{0x02}, s.Preferences(pubkey1).FeeRecipient,
+	)
+
+	// An invalid file keeps the previous preferences.
+	modTime = modTime.Add(time.Minute)
+	writeFile(t, path, `{"default_config": {
+		"fee_recipient": "0x0000000000000000000000000000000000000000"
+	}}`, modTime)
+	require.ErrorIs(t, s.Reload(), proposer.ErrZeroFeeRecipient)
+	require.Equal(
+		t, common.ExecutionAddress{0x02}, s.Preferences(pubkey1).FeeRecipient,
+	)
+}
+
+func TestStore_InvalidPubkey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proposers.json")
+	writeFile(t, path, `{"proposer_config": {"0x01": {}}}`, time.Now())
+
+	cfg := proposer.DefaultConfig()
+	cfg.File = path
+	_, err := proposer.NewStore(&cfg, noop.NewLogger(), nodeDefaults)
+	require.ErrorIs(t, err, proposer.ErrInvalidPubkey)
+}
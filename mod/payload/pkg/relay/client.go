@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// sszContentType is the content type of SSZ encoded requests and
+	// responses.
+	sszContentType = "application/octet-stream"
+	// consensusVersionHeader is the header that carries the fork of SSZ
+	// encoded requests and responses.
+	consensusVersionHeader = "Eth-Consensus-Version"
+	// consensusVersionDeneb is the name of the Deneb fork.
+	consensusVersionDeneb = "deneb"
+	// maxErrorBodySize is the maximum number of bytes of an error response
+	// that are included in the returned error.
+	maxErrorBodySize = 256
+)
+
+// Client is a client of the builder API of a single relay. Requests and
+// responses are SSZ encoded.
+type Client struct {
+	// url is the URL of the relay.
+	url *url.URL
+	// pubkey is the public key the relay signs its bids with.
+	pubkey crypto.BLSPubkey
+	// httpClient is the HTTP client used to reach the relay.
+	httpClient *http.Client
+}
+
+// NewClient creates a new client for the relay at the given URL, which must
+// carry the public key of the relay as its user, i.e. https://0xPUBKEY@host.
+func NewClient(rawURL string) (*Client, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	} else if u.User == nil {
+		return nil, errors.Wrap(ErrMissingRelayPubkey, u.Host)
+	}
+
+	var pubkey crypto.BLSPubkey
+	if err = pubkey.UnmarshalText([]byte(u.User.Username())); err != nil {
+		return nil, errors.Wrapf(err, "invalid pubkey of relay %s", u.Host)
+	}
+	// The public key is not sent to the relay.
+	u.User = nil
+	return &Client{url: u, pubkey: pubkey, httpClient: &http.Client{}}, nil
+}
+
+// Name returns the name of the relay, which is its host.
+func (c *Client) Name() string {
+	return c.url.Host
+}
+
+// Pubkey returns the public key the relay signs its bids with.
+func (c *Client) Pubkey() crypto.BLSPubkey {
+	return c.pubkey
+}
+
+// Status checks whether the relay is available.
+func (c *Client) Status(ctx context.Context) error {
+	_, err := c.do(
+		ctx, http.MethodGet, "/eth/v1/builder/status", nil, http.StatusOK,
+	)
+	return err
+}
+
+// RegisterValidators registers the validators with the relay.
+func (c *Client) RegisterValidators(
+	ctx context.Context,
+	registrations []*types.SignedValidatorRegistration,
+) error {
+	// The registrations are fixed size, so the SSZ list is their
+	// concatenation.
+	var body []byte
+	for _, registration := range registrations {
+		var err error
+		if body, err = registration.MarshalSSZTo(body); err != nil {
+			return err
+		}
+	}
+	_, err := c.do(
+		ctx, http.MethodPost, "/eth/v1/builder/validators",
+		body, http.StatusOK,
+	)
+	return err
+}
+
+// GetHeader requests the relay's bid for the payload of the given slot
+// built on top of the given parent, for the proposer with the given public
+// key. It returns ErrNoBid if the relay does not offer any payload.
+func (c *Client) GetHeader(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+	pubkey crypto.BLSPubkey,
+) (*types.SignedBuilderBidDeneb, error) {
+	bz, err := c.do(
+		ctx, http.MethodGet,
+		fmt.Sprintf(
+			"/eth/v1/builder/header/%d/%s/%s",
+			slot, parentHash.Hex(), pubkey.String(),
+		),
+		nil, http.StatusOK, http.StatusNoContent,
+	)
+	if err != nil {
+		return nil, err
+	} else if len(bz) == 0 {
+		return nil, ErrNoBid
+	}
+
+	bid := new(types.SignedBuilderBidDeneb)
+	if err = bid.UnmarshalSSZ(bz); err != nil {
+		return nil, err
+	}
+	return bid, nil
+}
+
+// SubmitBlindedBlock submits the signed blinded block to the relay, which
+// reveals the payload the block commits to in exchange.
+func (c *Client) SubmitBlindedBlock(
+	ctx context.Context,
+	blk *types.SignedBlindedBeaconBlockDeneb,
+) (*types.ExecutionPayloadAndBlobsBundleDeneb, error) {
+	body, err := blk.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	bz, err := c.do(
+		ctx, http.MethodPost, "/eth/v1/builder/blinded_blocks",
+		body, http.StatusOK,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := new(types.ExecutionPayloadAndBlobsBundleDeneb)
+	if err = payload.UnmarshalSSZ(bz); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// do sends a request to the relay and returns the body of the response. It
+// returns an error if the status of the response is not one of the given
+// ones.
+func (c *Client) do(
+	ctx context.Context,
+	method string,
+	path string,
+	body []byte,
+	statuses ...int,
+) ([]byte, error) {
+	req, err := http.NewRequestWithContext(
+		ctx, method, c.url.JoinPath(path).String(), bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", sszContentType)
+	req.Header.Set(consensusVersionHeader, consensusVersionDeneb)
+	if body != nil {
+		req.Header.Set("Content-Type", sszContentType)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	for _, status := range statuses {
+		if resp.StatusCode == status {
+			return bz, nil
+		}
+	}
+	if len(bz) > maxErrorBodySize {
+		bz = bz[:maxErrorBodySize]
+	}
+	return nil, errors.Wrapf(
+		ErrUnexpectedStatus, "%s %s: %d %s",
+		method, path, resp.StatusCode, bz,
+	)
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "time"
+
+const (
+	// defaultTimeout is the default timeout of the requests to the relays.
+	defaultTimeout = time.Second
+	// defaultGasLimit is the default gas limit the external builders are
+	// asked to target.
+	defaultGasLimit = 30_000_000
+	// defaultRegistrationInterval is the default interval at which the
+	// validator is registered with the relays.
+	defaultRegistrationInterval = time.Minute
+)
+
+// Config is the configuration for the relays of external builders.
+//
+//nolint:lll // struct tags.
+type Config struct {
+	// Enabled determines if payloads are sourced from external builders.
+	Enabled bool `mapstructure:"enabled"`
+	// URLs are the URLs of the relays to request payloads from, each
+	// carrying the public key the relay signs its bids with as its user,
+	// i.e. https://0xPUBKEY@host.
+	URLs []string `mapstructure:"urls"`
+	// Timeout is the timeout of the requests to the relays, after which the
+	// payload of the local builder is used. It must leave enough time to
+	// build the block within timeout_propose in the CometBFT configuration.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// GasLimit is the gas limit the external builders are asked to target,
+	// unless the proposer config sets another one.
+	GasLimit uint64 `mapstructure:"gas-limit"`
+	// RegistrationInterval is the interval at which the validator is
+	// registered with the relays.
+	RegistrationInterval time.Duration `mapstructure:"registration-interval"`
+}
+
+// DefaultConfig returns the default relay configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:              false,
+		URLs:                 []string{},
+		Timeout:              defaultTimeout,
+		GasLimit:             defaultGasLimit,
+		RegistrationInterval: defaultRegistrationInterval,
+	}
+}
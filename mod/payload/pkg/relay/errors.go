@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrMissingRelayPubkey is returned when the URL of a relay does not
+	// carry its public key.
+	ErrMissingRelayPubkey = errors.New("relay url has no pubkey")
+
+	// ErrNoBid is returned when no relay offers a payload for the slot.
+	ErrNoBid = errors.New("no bid offered by the relays")
+
+	// ErrUnknownBid is returned when a bid was not offered by any relay.
+	ErrUnknownBid = errors.New("bid was not offered by any relay")
+
+	// ErrUnexpectedStatus is returned when a relay answers with an
+	// unexpected HTTP status.
+	ErrUnexpectedStatus = errors.New("unexpected status from relay")
+
+	// ErrInvalidBid is returned when a bid is malformed or does not build
+	// on the requested parent.
+	ErrInvalidBid = errors.New("invalid bid")
+
+	// ErrPayloadMismatch is returned when the payload revealed by a relay
+	// does not match the bid it was revealed for.
+	ErrPayloadMismatch = errors.New("revealed payload does not match bid")
+)
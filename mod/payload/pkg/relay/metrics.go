@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+// relayMetrics is a struct that contains metrics for the relays.
+type relayMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newRelayMetrics creates a new relayMetrics.
+func newRelayMetrics(sink TelemetrySink) *relayMetrics {
+	return &relayMetrics{
+		sink: sink,
+	}
+}
+
+// markBidReceived increments the counter for valid bids received from the
+// given relay.
+func (rm *relayMetrics) markBidReceived(relay string) {
+	rm.sink.IncrementCounter(
+		"beacon_kit.payload.relay.bid_received", "relay", relay,
+	)
+}
+
+// markRequestFailed increments the counter for failed requests of the given
+// kind to the given relay.
+func (rm *relayMetrics) markRequestFailed(relay string, request string) {
+	rm.sink.IncrementCounter(
+		"beacon_kit.payload.relay.request_failed",
+		"relay", relay, "request", request,
+	)
+}
+
+// markPayloadRevealed increments the counter for payloads revealed by the
+// given relay.
+func (rm *relayMetrics) markPayloadRevealed(relay string) {
+	rm.sink.IncrementCounter(
+		"beacon_kit.payload.relay.payload_revealed", "relay", relay,
+	)
+}
@@ -0,0 +1,383 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	parentHash   = common.ExecutionHash{0x01}
+	feeRecipient = common.ExecutionAddress{0x02}
+	pubkey       = crypto.BLSPubkey{0x03}
+	relayPubkey  = crypto.BLSPubkey{0x04}
+)
+
+// stubRelay is an in-process relay offering a single payload.
+type stubRelay struct {
+	*httptest.Server
+
+	// value is the value of the offered payload.
+	value uint64
+	// delay delays the bids of the relay.
+	delay time.Duration
+	// noBid makes the relay offer no payload.
+	noBid bool
+	// blockHash overrides the block hash of the revealed payload.
+	blockHash *common.ExecutionHash
+	// bid is the bid of the relay for its payload.
+	bid *types.SignedBuilderBidDeneb
+
+	mu            sync.Mutex
+	registrations []*types.SignedValidatorRegistration
+}
+
+func newStubRelay(t *testing.T, value uint64) *stubRelay {
+	t.Helper()
+	r := &stubRelay{value: value}
+	header, err := (&types.ExecutionPayload{
+		InnerExecutionPayload: r.payload(),
+	}).ToHeader()
+	require.NoError(t, err)
+	denebHeader, ok := header.
+		InnerExecutionPayloadHeader.(*types.ExecutionPayloadHeaderDeneb)
+	require.True(t, ok)
+	r.bid = &types.SignedBuilderBidDeneb{
+		Message: &types.BuilderBidDeneb{
+			Header:             denebHeader,
+			BlobKzgCommitments: r.commitments(),
+			Value: math.MustNewU256LFromBigEndian(
+				[]byte{byte(value)},
+			),
+			Pubkey: relayPubkey,
+		},
+	}
+	r.Server = httptest.NewServer(http.HandlerFunc(r.serveHTTP))
+	t.Cleanup(r.Close)
+	return r
+}
+
+// url returns the URL of the relay, carrying its public key.
+func (r *stubRelay) url() string {
+	return strings.Replace(r.URL, "://", "://"+relayPubkey.String()+"@", 1)
+}
+
+func (r *stubRelay) payload() *types.ExecutableDataDeneb {
+	return &types.ExecutableDataDeneb{
+		ParentHash:    parentHash,
+		FeeRecipient:  feeRecipient,
+		LogsBloom:     make([]byte, 256),
+		BlockHash:     common.ExecutionHash{byte(r.value)},
+		BaseFeePerGas: math.Wei{},
+	}
+}
+
+func (r *stubRelay) commitments() []eip4844.KZGCommitment {
+	return []eip4844.KZGCommitment{{byte(r.value)}}
+}
+
+func (r *stubRelay) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	bz, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var (
+		resp interface{ MarshalSSZ() ([]byte, error) }
+		path = req.URL.Path
+	)
+	switch {
+	case path == "/eth/v1/builder/validators":
+		registration := new(types.SignedValidatorRegistration)
+		if err := registration.UnmarshalSSZ(bz); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.mu.Lock()
+		r.registrations = append(r.registrations, registration)
+		r.mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+		return
+	case strings.HasPrefix(path, "/eth/v1/builder/header/"):
+		time.Sleep(r.delay)
+		if r.noBid {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		resp = r.bid
+	case path == "/eth/v1/builder/blinded_blocks":
+		blk := new(types.SignedBlindedBeaconBlockDeneb)
+		if err := blk.UnmarshalSSZ(bz); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		payload := r.payload()
+		if r.blockHash != nil {
+			payload.BlockHash = *r.blockHash
+		}
+		resp = &types.ExecutionPayloadAndBlobsBundleDeneb{
+			ExecutionPayload: payload,
+			BlobsBundle: &types.BlobsBundleDeneb{
+				Commitments: r.commitments(),
+				Proofs:      []eip4844.KZGProof{{}},
+				Blobs:       []eip4844.Blob{{}},
+			},
+		}
+	default:
+		http.NotFound(w, req)
+		return
+	}
+
+	if bz, err = resp.MarshalSSZ(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write(bz)
+}
+
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string) {}
+
+// blockSigner is a signer of typed blocks recording the blocks it signs.
+type blockSigner struct {
+	*mocks.BLSSigner
+	headers []*types.BeaconBlockHeader
+}
+
+func (s *blockSigner) SignBlock(
+	_ common.Version,
+	_ common.Root,
+	header *types.BeaconBlockHeader,
+	_ common.Root,
+) (crypto.BLSSignature, error) {
+	s.headers = append(s.headers, header)
+	return crypto.BLSSignature{}, nil
+}
+
+func newSigner(t *testing.T) *mocks.BLSSigner {
+	t.Helper()
+	signer := mocks.NewBLSSigner(t)
+	signer.EXPECT().PublicKey().Return(pubkey).Maybe()
+	signer.EXPECT().Sign(mock.Anything).
+		Return(crypto.BLSSignature{}, nil).Maybe()
+	signer.EXPECT().
+		VerifySignature(relayPubkey, mock.Anything, mock.Anything).
+		Return(nil).Maybe()
+	return signer
+}
+
+func newService(t *testing.T, relays ...*stubRelay) *relay.Service {
+	t.Helper()
+	return newServiceWithSigner(t, newSigner(t), relays...)
+}
+
+func newServiceWithSigner(
+	t *testing.T,
+	signer crypto.BLSSigner,
+	relays ...*stubRelay,
+) *relay.Service {
+	t.Helper()
+	cfg := relay.DefaultConfig()
+	cfg.Enabled = true
+	cfg.Timeout = 500 * time.Millisecond
+	for _, r := range relays {
+		cfg.URLs = append(cfg.URLs, r.url())
+	}
+
+	proposers, err := proposer.NewStore(
+		&proposer.Config{}, noop.NewLogger(), proposer.Preferences{
+			FeeRecipient: feeRecipient,
+			GasLimit:     math.U64(cfg.GasLimit),
+		},
+	)
+	require.NoError(t, err)
+
+	s, err := relay.NewService(
+		&cfg,
+		chain.NewChainSpec(chain.SpecData[
+			primitives.DomainType, math.Epoch,
+			common.ExecutionAddress, math.Slot, any,
+		]{
+			SlotsPerEpoch:    32,
+			ElectraForkEpoch: 1 << 32,
+		}),
+		noop.NewLogger(),
+		signer,
+		proposers,
+		noopSink{},
+	)
+	require.NoError(t, err)
+	return s
+}
+
+func TestService_RegisterValidator(t *testing.T) {
+	r := newStubRelay(t, 1)
+	newService(t, r).RegisterValidator(context.Background())
+
+	require.Len(t, r.registrations, 1)
+	registration := r.registrations[0].Message
+	require.Equal(t, pubkey, registration.Pubkey)
+	require.Equal(t, feeRecipient, registration.FeeRecipient)
+	require.Equal(t, math.U64(30_000_000), registration.GasLimit)
+}
+
+func TestService_GetBidAndUnblind(t *testing.T) {
+	ctx := context.Background()
+	s := newService(t, newStubRelay(t, 1), newStubRelay(t, 3))
+
+	bid, err := s.GetBid(ctx, 1, parentHash)
+	require.NoError(t, err)
+	require.Equal(t, common.ExecutionHash{3}, bid.Message.Header.BlockHash)
+
+	envelope, err := s.Unblind(
+		ctx, bid, &types.BlindedBeaconBlockDeneb{
+			Body: &types.BlindedBeaconBlockBodyDeneb{
+				BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+					Eth1Data: &types.Eth1Data{},
+				},
+				ExecutionPayloadHeader: bid.Message.Header,
+				BlobKzgCommitments:     bid.Message.BlobKzgCommitments,
+			},
+		}, common.Root{},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, bid.Message.Header.BlockHash,
+		envelope.GetExecutionPayload().GetBlockHash(),
+	)
+	require.Equal(t, bid.Message.Value, envelope.GetValue())
+	require.Equal(
+		t, bid.Message.BlobKzgCommitments,
+		envelope.GetBlobsBundle().GetCommitments(),
+	)
+}
+
+func TestService_GetBid_NoBid(t *testing.T) {
+	noBid := newStubRelay(t, 1)
+	noBid.noBid = true
+	slow := newStubRelay(t, 2)
+	slow.delay = time.Second
+
+	_, err := newService(t, noBid, slow).GetBid(
+		context.Background(), 1, parentHash,
+	)
+	require.ErrorIs(t, err, relay.ErrNoBid)
+}
+
+func TestNewService_MissingRelayPubkey(t *testing.T) {
+	cfg := relay.DefaultConfig()
+	cfg.URLs = []string{newStubRelay(t, 1).URL}
+	_, err := relay.NewService(
+		&cfg, nil, noop.NewLogger(), newSigner(t), nil, noopSink{},
+	)
+	require.ErrorIs(t, err, relay.ErrMissingRelayPubkey)
+}
+
+func TestService_GetBid_WrongPubkey(t *testing.T) {
+	r := newStubRelay(t, 1)
+	r.bid.Message.Pubkey = pubkey
+	_, err := newService(t, r).GetBid(context.Background(), 1, parentHash)
+	require.ErrorIs(t, err, relay.ErrNoBid)
+}
+
+func TestService_Unblind_SignBlock(t *testing.T) {
+	ctx := context.Background()
+	signer := &blockSigner{BLSSigner: newSigner(t)}
+	s := newServiceWithSigner(t, signer, newStubRelay(t, 1))
+
+	bid, err := s.GetBid(ctx, 1, parentHash)
+	require.NoError(t, err)
+	blk := &types.BlindedBeaconBlockDeneb{
+		BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+			Slot:          7,
+			ProposerIndex: 3,
+		},
+		Body: &types.BlindedBeaconBlockBodyDeneb{
+			BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+				Eth1Data: &types.Eth1Data{},
+			},
+			ExecutionPayloadHeader: bid.Message.Header,
+			BlobKzgCommitments:     bid.Message.BlobKzgCommitments,
+		},
+	}
+	_, err = s.Unblind(ctx, bid, blk, common.Root{})
+	require.NoError(t, err)
+
+	// The block is signed through the typed signing of the signer, with
+	// the header committing to the blinded body.
+	bodyRoot, err := blk.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.Len(t, signer.headers, 1)
+	require.Equal(t, uint64(7), signer.headers[0].GetSlot().Unwrap())
+	require.Equal(t, common.Root(bodyRoot), signer.headers[0].BodyRoot)
+	signer.AssertNotCalled(t, "Sign", mock.Anything)
+}
+
+func TestService_GetBid_WrongParent(t *testing.T) {
+	_, err := newService(t, newStubRelay(t, 1)).GetBid(
+		context.Background(), 1, common.ExecutionHash{0xff},
+	)
+	require.ErrorIs(t, err, relay.ErrNoBid)
+}
+
+func TestService_Unblind_PayloadMismatch(t *testing.T) {
+	ctx := context.Background()
+	r := newStubRelay(t, 1)
+	r.blockHash = &common.ExecutionHash{0xff}
+	s := newService(t, r)
+
+	bid, err := s.GetBid(ctx, 1, parentHash)
+	require.NoError(t, err)
+	_, err = s.Unblind(
+		ctx, bid, &types.BlindedBeaconBlockDeneb{
+			Body: &types.BlindedBeaconBlockBodyDeneb{
+				BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+					Eth1Data: &types.Eth1Data{},
+				},
+				ExecutionPayloadHeader: bid.Message.Header,
+			},
+		}, common.Root{},
+	)
+	require.ErrorIs(t, err, relay.ErrPayloadMismatch)
+}
@@ -0,0 +1,441 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// Service sources payloads from external builders through their relays. It
+// keeps the validator of this node registered with the relays, collects
+// their bids for the payloads of the slots it proposes and reveals the
+// payload of the winning bid by signing the blinded block committing to it.
+type Service struct {
+	// cfg is the relay configuration.
+	cfg *Config
+	// chainSpec is the chain spec.
+	chainSpec primitives.ChainSpec
+	// logger is used for logging.
+	logger log.Logger[any]
+	// signer signs the registrations and blinded blocks of the validator,
+	// and verifies the bids of the builders.
+	signer crypto.BLSSigner
+	// proposers resolves the preferences the validator registers.
+	proposers ProposerConfig
+	// clients are the clients of the relays.
+	clients []*Client
+	// metrics is used to report the activity of the relays.
+	metrics *relayMetrics
+
+	// mu protects bids.
+	mu sync.Mutex
+	// bids maps the block hash of every payload offered in the latest
+	// round of bids to the relay that offered it.
+	bids map[common.ExecutionHash]*Client
+}
+
+// NewService creates a new relay service.
+func NewService(
+	cfg *Config,
+	chainSpec primitives.ChainSpec,
+	logger log.Logger[any],
+	signer crypto.BLSSigner,
+	proposers ProposerConfig,
+	telemetrySink TelemetrySink,
+) (*Service, error) {
+	clients := make([]*Client, 0, len(cfg.URLs))
+	for _, rawURL := range cfg.URLs {
+		client, err := NewClient(rawURL)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+	}
+	return &Service{
+		cfg:       cfg,
+		chainSpec: chainSpec,
+		logger:    logger,
+		signer:    signer,
+		proposers: proposers,
+		clients:   clients,
+		metrics:   newRelayMetrics(telemetrySink),
+		bids:      make(map[common.ExecutionHash]*Client),
+	}, nil
+}
+
+// Name returns the name of the service.
+func (*Service) Name() string {
+	return "relay"
+}
+
+// Enabled returns true if payloads are sourced from external builders.
+func (s *Service) Enabled() bool {
+	return s.cfg.Enabled && len(s.clients) > 0
+}
+
+// Start keeps the validator registered with the relays, if enabled.
+func (s *Service) Start(ctx context.Context) error {
+	if !s.Enabled() {
+		return nil
+	}
+
+	s.logger.Info(
+		"sourcing payloads from external builders 🤝",
+		"num_relays", len(s.clients),
+	)
+	ticker := time.NewTicker(s.cfg.RegistrationInterval)
+	go func() {
+		s.RegisterValidator(ctx)
+		for {
+			select {
+			case <-ticker.C:
+				s.RegisterValidator(ctx)
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Status returns nil if the service is healthy.
+func (*Service) Status() error {
+	return nil
+}
+
+// WaitForHealthy waits for the service to be healthy.
+func (*Service) WaitForHealthy(context.Context) {}
+
+// RegisterValidator registers the validator of this node with every relay.
+func (s *Service) RegisterValidator(ctx context.Context) {
+	pubkey := s.signer.PublicKey()
+	prefs := s.proposers.Preferences(pubkey)
+	registration := &types.ValidatorRegistration{
+		FeeRecipient: prefs.FeeRecipient,
+		GasLimit:     prefs.GasLimit,
+		//#nosec:G701 // the timestamp is always positive.
+		Timestamp: math.U64(time.Now().Unix()),
+		Pubkey:    pubkey,
+	}
+	signature, err := s.signRegistration(registration)
+	if err != nil {
+		s.logger.Error("failed to sign validator registration", "error", err)
+		return
+	}
+
+	if err = s.RegisterValidators(
+		ctx, []*types.SignedValidatorRegistration{{
+			Message:   registration,
+			Signature: signature,
+		}},
+	); err != nil {
+		s.logger.Warn(
+			"failed to register validator with relays", "error", err,
+		)
+	}
+}
+
+// RegisterValidators forwards the signed validator registrations to every
+// relay. It returns the errors of the relays that rejected them.
+func (s *Service) RegisterValidators(
+	ctx context.Context,
+	registrations []*types.SignedValidatorRegistration,
+) error {
+	var (
+		mu   sync.Mutex
+		errs []error
+	)
+	s.forEachRelay(ctx, func(ctx context.Context, client *Client) {
+		if err := client.RegisterValidators(ctx, registrations); err != nil {
+			s.metrics.markRequestFailed(client.Name(), "register_validator")
+			mu.Lock()
+			defer mu.Unlock()
+			errs = append(errs, errors.Wrap(err, client.Name()))
+		}
+	})
+	return errors.Join(errs...)
+}
+
+// GetBid requests the bids of the relays for the payload of the given slot
+// built on top of the given parent, and returns the most valuable valid one.
+// It returns ErrNoBid if no relay offers a valid bid within the timeout.
+func (s *Service) GetBid(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+) (*types.SignedBuilderBidDeneb, error) {
+	var (
+		mu   sync.Mutex
+		best *types.SignedBuilderBidDeneb
+		bids = make(map[common.ExecutionHash]*Client)
+	)
+	s.forEachRelay(ctx, func(ctx context.Context, client *Client) {
+		bid, err := client.GetHeader(
+			ctx, slot, parentHash, s.signer.PublicKey(),
+		)
+		if err == nil {
+			err = s.verifyBid(client, bid, parentHash)
+		}
+		if errors.Is(err, ErrNoBid) {
+			return
+		} else if err != nil {
+			s.metrics.markRequestFailed(client.Name(), "get_header")
+			s.logger.Warn(
+				"failed to get bid from relay",
+				"relay", client.Name(), "error", err,
+			)
+			return
+		}
+
+		s.metrics.markBidReceived(client.Name())
+		mu.Lock()
+		defer mu.Unlock()
+		bids[bid.Message.Header.BlockHash] = client
+		if best == nil || bid.Message.Value.UnwrapBig().Cmp(
+			best.Message.Value.UnwrapBig(),
+		) > 0 {
+			best = bid
+		}
+	})
+
+	s.mu.Lock()
+	s.bids = bids
+	s.mu.Unlock()
+
+	if best == nil {
+		return nil, ErrNoBid
+	}
+	return best, nil
+}
+
+// Unblind signs the blinded block, which must commit to the payload of the
+// given bid, and submits it to the relay that offered the bid in exchange
+// for the payload.
+func (s *Service) Unblind(
+	ctx context.Context,
+	bid *types.SignedBuilderBidDeneb,
+	blk *types.BlindedBeaconBlockDeneb,
+	genesisValidatorsRoot common.Root,
+) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
+	s.mu.Lock()
+	client, ok := s.bids[bid.Message.Header.BlockHash]
+	s.mu.Unlock()
+	if !ok {
+		return nil, ErrUnknownBid
+	}
+
+	signature, err := s.signBlock(blk, genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+	payload, err := client.SubmitBlindedBlock(
+		ctx, &types.SignedBlindedBeaconBlockDeneb{
+			Message:   blk,
+			Signature: signature,
+		},
+	)
+	if err == nil {
+		err = verifyPayload(bid, payload)
+	}
+	if err != nil {
+		s.metrics.markRequestFailed(client.Name(), "submit_blinded_block")
+		return nil, err
+	}
+
+	s.metrics.markPayloadRevealed(client.Name())
+	return &engineprimitives.ExecutionPayloadEnvelope[
+		*types.ExecutionPayload, *types.BlobsBundleDeneb,
+	]{
+		ExecutionPayload: &types.ExecutionPayload{
+			InnerExecutionPayload: payload.ExecutionPayload,
+		},
+		BlockValue:  bid.Message.Value,
+		BlobsBundle: payload.BlobsBundle,
+	}, nil
+}
+
+// forEachRelay calls fn concurrently for every relay, bounded by the
+// timeout of the relay requests, and waits for all the calls to return.
+func (s *Service) forEachRelay(
+	ctx context.Context,
+	fn func(context.Context, *Client),
+) {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, client := range s.clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn(ctx, client)
+		}()
+	}
+	wg.Wait()
+}
+
+// verifyBid verifies that the bid builds on top of the given parent and is
+// signed with the public key configured for the relay that offered it.
+func (s *Service) verifyBid(
+	client *Client,
+	bid *types.SignedBuilderBidDeneb,
+	parentHash common.ExecutionHash,
+) error {
+	switch {
+	case bid.Message == nil || bid.Message.Header == nil:
+		return errors.Wrap(ErrInvalidBid, "missing header")
+	case bid.Message.Header.ParentHash != parentHash:
+		return errors.Wrapf(
+			ErrInvalidBid, "parent hash %s does not match %s",
+			bid.Message.Header.ParentHash, parentHash,
+		)
+	case bid.Message.Pubkey != client.Pubkey():
+		return errors.Wrapf(
+			ErrInvalidBid, "pubkey %s is not the one of the relay",
+			bid.Message.Pubkey,
+		)
+	}
+
+	domain, err := s.builderDomain()
+	if err != nil {
+		return err
+	}
+	signingRoot, err := ssz.ComputeSigningRoot(bid.Message, domain)
+	if err != nil {
+		return err
+	}
+	return s.signer.VerifySignature(
+		client.Pubkey(), signingRoot[:], bid.Signature,
+	)
+}
+
+// verifyPayload verifies that the revealed payload is the one of the bid.
+func verifyPayload(
+	bid *types.SignedBuilderBidDeneb,
+	payload *types.ExecutionPayloadAndBlobsBundleDeneb,
+) error {
+	switch {
+	case payload.ExecutionPayload == nil || payload.BlobsBundle == nil:
+		return errors.Wrap(ErrPayloadMismatch, "missing payload")
+	case payload.ExecutionPayload.BlockHash != bid.Message.Header.BlockHash:
+		return errors.Wrap(ErrPayloadMismatch, "block hash")
+	case len(payload.BlobsBundle.Commitments) !=
+		len(bid.Message.BlobKzgCommitments):
+		return errors.Wrap(ErrPayloadMismatch, "number of blobs")
+	}
+	for i, commitment := range payload.BlobsBundle.Commitments {
+		if commitment != bid.Message.BlobKzgCommitments[i] {
+			return errors.Wrap(ErrPayloadMismatch, "blob commitments")
+		}
+	}
+	return nil
+}
+
+// builderDomain returns the domain of the builder API signatures, which is
+// computed from the genesis fork version as per the builder API.
+func (s *Service) builderDomain() (common.Domain, error) {
+	return types.NewForkData(
+		version.FromUint32[common.Version](
+			s.chainSpec.ActiveForkVersionForEpoch(0),
+		),
+		common.Root{},
+	).ComputeDomain(s.chainSpec.DomainTypeApplicationMask())
+}
+
+// signRegistration signs the validator registration with the validator's
+// key, through the typed signing of the signer if it supports it.
+func (s *Service) signRegistration(
+	registration *types.ValidatorRegistration,
+) (crypto.BLSSignature, error) {
+	domain, err := s.builderDomain()
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	signingRoot, err := ssz.ComputeSigningRoot(registration, domain)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+
+	if signer, ok := s.signer.(RegistrationSigner); ok {
+		return signer.SignValidatorRegistration(registration, signingRoot)
+	}
+	return s.signer.Sign(signingRoot[:])
+}
+
+// signBlock signs the blinded block with the validator's key, through the
+// typed signing of the signer if it supports it, so that it goes through the
+// slashing protection of the signer.
+func (s *Service) signBlock(
+	blk *types.BlindedBeaconBlockDeneb,
+	genesisValidatorsRoot common.Root,
+) (crypto.BLSSignature, error) {
+	forkVersion := version.FromUint32[common.Version](
+		s.chainSpec.ActiveForkVersionForSlot(math.Slot(blk.Slot)),
+	)
+	domain, err := types.NewForkData(
+		forkVersion, genesisValidatorsRoot,
+	).ComputeDomain(s.chainSpec.DomainTypeProposer())
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	signingRoot, err := ssz.ComputeSigningRoot(blk, domain)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+
+	signer, ok := s.signer.(BlockSigner)
+	if !ok {
+		return s.signer.Sign(signingRoot[:])
+	}
+	bodyRoot, err := blk.Body.HashTreeRoot()
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	return signer.SignBlock(
+		forkVersion,
+		genesisValidatorsRoot,
+		types.NewBeaconBlockHeader(
+			math.Slot(blk.Slot),
+			math.ValidatorIndex(blk.ProposerIndex),
+			blk.ParentBlockRoot,
+			blk.StateRoot,
+			bodyRoot,
+		),
+		signingRoot,
+	)
+}
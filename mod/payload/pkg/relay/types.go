@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/proposer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// BlockSigner is a BLS signer of typed blocks, e.g. guarding them with a
+// slashing protection database.
+type BlockSigner interface {
+	// SignBlock signs the block of the given header.
+	SignBlock(
+		forkVersion common.Version,
+		genesisValidatorsRoot common.Root,
+		header *types.BeaconBlockHeader,
+		signingRoot common.Root,
+	) (crypto.BLSSignature, error)
+}
+
+// RegistrationSigner is a BLS signer of typed validator registrations.
+type RegistrationSigner interface {
+	// SignValidatorRegistration signs the given validator registration.
+	SignValidatorRegistration(
+		msg *types.ValidatorRegistration,
+		signingRoot common.Root,
+	) (crypto.BLSSignature, error)
+}
+
+// ProposerConfig resolves the preferences of the proposers.
+type ProposerConfig interface {
+	// Preferences returns the preferences of the proposer with the given
+	// public key.
+	Preferences(crypto.BLSPubkey) proposer.Preferences
+}
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+}
@@ -51,6 +51,9 @@ type Spec[
 	// MinEpochsToInactivityPenalty returns the minimum number of epochs before
 	// an inactivity penalty is applied.
 	MinEpochsToInactivityPenalty() uint64
+	// MinValidatorWithdrawabilityDelay returns the minimum number of epochs
+	// between a validator exiting and becoming withdrawable.
+	MinValidatorWithdrawabilityDelay() uint64
 
 	// Signature Domains
 	//
@@ -237,6 +240,14 @@ func (c chainSpec[
 	return c.Data.MinEpochsToInactivityPenalty
 }
 
+// MinValidatorWithdrawabilityDelay returns the minimum number of epochs
+// between a validator exiting and becoming withdrawable.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinValidatorWithdrawabilityDelay() uint64 {
+	return c.Data.MinValidatorWithdrawabilityDelay
+}
+
 // DomainProposer returns the domain for beacon proposer signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -37,4 +37,20 @@ const (
 	// MaxWithdrawalsPerPayload is the maximum number of withdrawals in a
 	// execution payload.
 	MaxWithdrawalsPerPayload uint64 = 16
+
+	// MaxDepositRequestsPerPayload is the maximum number of EIP-6110 deposit
+	// requests in an execution payload.
+	MaxDepositRequestsPerPayload uint64 = 8192
+
+	// MaxWithdrawalRequestsPerPayload is the maximum number of EIP-7002
+	// withdrawal requests in an execution payload.
+	MaxWithdrawalRequestsPerPayload uint64 = 16
+
+	// MaxConsolidationRequestsPerPayload is the maximum number of EIP-7251
+	// consolidation requests in an execution payload.
+	MaxConsolidationRequestsPerPayload uint64 = 2
+
+	// FullExitRequestAmount is the amount of a withdrawal request that
+	// signals a full exit of the validator.
+	FullExitRequestAmount uint64 = 0
 )
@@ -59,3 +59,14 @@ type BLSSigner interface {
 	// VerifySignature verifies a signature against a message and a public key.
 	VerifySignature(pubKey BLSPubkey, msg []byte, signature BLSSignature) error
 }
+
+// BLSBatchVerifier verifies many BLS signatures at once, which is cheaper
+// than verifying them one by one.
+type BLSBatchVerifier interface {
+	// VerifySignatures verifies the signatures against the messages and the
+	// public keys of the same index. It fails if any signature is invalid,
+	// without identifying it.
+	VerifySignatures(
+		pubkeys []BLSPubkey, msgs [][]byte, signatures []BLSSignature,
+	) error
+}
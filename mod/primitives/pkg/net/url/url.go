@@ -50,6 +50,16 @@ func (d *ConnectionURL) IsHTTPS() bool {
 	return d.Scheme == "https"
 }
 
+// IsWS checks if the DialURL scheme is WS.
+func (d *ConnectionURL) IsWS() bool {
+	return d.Scheme == "ws"
+}
+
+// IsWSS checks if the DialURL scheme is WSS.
+func (d *ConnectionURL) IsWSS() bool {
+	return d.Scheme == "wss"
+}
+
 // IsIPC checks if the DialURL scheme is IPC.
 func (d *ConnectionURL) IsIPC() bool {
 	return d.Scheme == "ipc"
@@ -84,6 +84,16 @@ type BeaconKitRuntime[
 	storageBackend StorageBackendT
 	// chainSpec defines the chain specifications for the BeaconKitRuntime.
 	chainSpec primitives.ChainSpec
+	// chainService is the blockchain service of the BeaconKitRuntime.
+	chainService *blockchain.Service[
+		AvailabilityStoreT,
+		BeaconBlockT,
+		BeaconBlockBodyT,
+		BeaconState,
+		BlobSidecarsT,
+		*types.Deposit,
+		DepositStoreT,
+	]
 	// abciFinalizeBlockMiddleware handles ABCI interactions for the
 	// BeaconKitRuntime.
 	abciFinalizeBlockMiddleware *middleware.FinalizeBlockMiddleware[
@@ -187,6 +197,7 @@ func NewBeaconKitRuntime[
 			storageBackend,
 		),
 		chainSpec:      chainSpec,
+		chainService:   chainService,
 		logger:         logger,
 		services:       services,
 		storageBackend: storageBackend,
@@ -203,6 +214,20 @@ func (r *BeaconKitRuntime[
 	return r.services.StartAll(ctx)
 }
 
+// SyncExecutionClient reconciles the execution client with the latest block
+// committed to the state of the given context in the background. No blocks
+// are proposed until the execution client is consistent with it.
+func (r *BeaconKitRuntime[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	BlobSidecarsT, DepositStoreT, StorageBackendT,
+]) SyncExecutionClient(
+	ctx context.Context,
+) error {
+	return r.chainService.SyncExecutionClient(
+		ctx, r.storageBackend.StateFromContext(ctx),
+	)
+}
+
 // ABCIHandler returns the ABCI handler.
 func (r *BeaconKitRuntime[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
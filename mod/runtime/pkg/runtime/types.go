@@ -46,6 +46,8 @@ type AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
 	// Persist makes sure that the sidecar remains accessible for data
 	// availability checks throughout the beacon node's operation.
 	Persist(math.Slot, BlobSidecarsT) error
+	// GetBlobSidecars returns the sidecars stored for the given slot.
+	GetBlobSidecars(math.Slot) (BlobSidecarsT, error)
 }
 
 // StorageBackend defines an interface for accessing various storage components
@@ -81,6 +83,7 @@ type DepositStore interface {
 	) ([]*types.Deposit, error)
 	EnqueueDeposits(deposits []*types.Deposit) error
 	Prune(index uint64, numPrune uint64) error
+	SetBlockDepositIndex(slot math.Slot, index uint64) error
 }
 
 // Service is a struct that can be registered into a ServiceRegistry for
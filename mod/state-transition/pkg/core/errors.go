@@ -59,6 +59,10 @@ var (
 	// in a block does not match the expected value.
 	ErrPenaltiesLengthMismatch = errors.New("penalties length mismatch")
 
+	// ErrDepositRequestIndexGap is returned when a deposit request does not
+	// follow the deposits already processed.
+	ErrDepositRequestIndexGap = errors.New("deposit request index gap")
+
 	// ErrExceedsBlockBlobLimit is returned when the block exceeds the blob
 	// limit.
 	ErrExceedsBlockBlobLimit = errors.New("block exceeds blob limit")
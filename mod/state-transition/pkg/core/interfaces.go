@@ -40,7 +40,19 @@ type BeaconState[
 		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
 		ValidatorT, WithdrawalT,
 	]
+	// Fork returns a copy of an in-memory fork of the state, which the copy
+	// saves its changes to rather than to the state.
+	Fork() BeaconState[
+		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
+		ValidatorT, WithdrawalT,
+	]
 	Save()
+	// Apply writes the changes made through the given copy of the state,
+	// or of an identical state, to the state.
+	Apply(BeaconState[
+		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
+		ValidatorT, WithdrawalT,
+	]) error
 	Context() context.Context
 	HashTreeRoot() ([32]byte, error)
 	ReadOnlyBeaconState[
@@ -42,6 +42,10 @@ type KVStore[
 		ctx context.Context,
 	) KVStoreT
 	Save()
+	Apply(cpy KVStoreT) error
+	ComputeHashTreeRoot(
+		slotsPerHistoricalRoot, epochsPerHistoricalVector uint64,
+	) ([32]byte, error)
 	GetLatestExecutionPayloadHeader() (
 		ExecutionPayloadHeaderT, error,
 	)
@@ -55,6 +59,7 @@ type KVStore[
 	GetBalance(idx math.ValidatorIndex) (math.Gwei, error)
 	SetBalance(idx math.ValidatorIndex, balance math.Gwei) error
 	Copy() KVStoreT
+	Fork() KVStoreT
 	GetSlot() (math.Slot, error)
 	SetSlot(slot math.Slot) error
 	GetFork() (ForkT, error)
@@ -120,6 +120,41 @@ func (s *StateDB[
 	)
 }
 
+// Fork returns a copy of an in-memory fork of the beacon state, which the
+// copy saves its changes to rather than to the beacon state.
+func (s *StateDB[
+	BeaconStateT, KVStoreT, ForkT,
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ValidatorT, WithdrawalCredentialsT,
+]) Fork() BeaconStateT {
+	return NewBeaconStateFromDB[BeaconStateT](
+		s.KVStore.Fork(),
+		s.cs,
+	)
+}
+
+// Apply writes the changes made through the given copy of the beacon state,
+// or of an identical beacon state, to the beacon state.
+func (s *StateDB[
+	BeaconStateT, KVStoreT, ForkT,
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ValidatorT, WithdrawalCredentialsT,
+]) Apply(cpy BeaconStateT) error {
+	db, ok := any(cpy).(*StateDB[
+		BeaconStateT, KVStoreT, ForkT,
+		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+		ValidatorT, WithdrawalCredentialsT,
+	])
+	if !ok {
+		return errors.Newf("unexpected beacon state type %T", cpy)
+	}
+	kv, ok := db.KVStore.(KVStoreT)
+	if !ok {
+		return errors.Newf("unexpected store type %T", db.KVStore)
+	}
+	return s.KVStore.Apply(kv)
+}
+
 // IncreaseBalance increases the balance of a validator.
 func (s *StateDB[
 	BeaconStateT, KVStoreT, ForkT,
@@ -278,39 +313,52 @@ func (s *StateDB[
 	return withdrawals, nil
 }
 
-// Store is the interface for the beacon store.
+// HashTreeRoot returns the hash tree root of the beacon state. Only the parts
+// of the state changed since it was last hashed are rehashed.
+func (s *StateDB[
+	BeaconStateT, KVStoreT, ForkT,
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ValidatorT, WithdrawalCredentialsT,
+]) HashTreeRoot() ([32]byte, error) {
+	return s.KVStore.ComputeHashTreeRoot(
+		s.cs.SlotsPerHistoricalRoot(), s.cs.EpochsPerHistoricalVector(),
+	)
+}
+
+// GetMarshallable reads the whole beacon state from the store into its
+// SSZ and JSON marshallable form.
 //
 //nolint:funlen,gocognit // todo fix somehow
 func (s *StateDB[
 	BeaconStateT, KVStoreT, ForkT,
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 	ValidatorT, WithdrawalCredentialsT,
-]) HashTreeRoot() ([32]byte, error) {
+]) GetMarshallable() (state.RawBeaconState, error) {
 	slot, err := s.GetSlot()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	fork, err := s.GetFork()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	genesisValidatorsRoot, err := s.GetGenesisValidatorsRoot()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	latestBlockHeader, err := s.GetLatestBlockHeader()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	blockRoots := make([]primitives.Root, s.cs.SlotsPerHistoricalRoot())
 	for i := range s.cs.SlotsPerHistoricalRoot() {
 		blockRoots[i], err = s.GetBlockRootAtIndex(i)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 	}
 
@@ -318,61 +366,61 @@ func (s *StateDB[
 	for i := range s.cs.SlotsPerHistoricalRoot() {
 		stateRoots[i], err = s.StateRootAtIndex(i)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 	}
 
 	latestExecutionPayloadHeader, err := s.GetLatestExecutionPayloadHeader()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	eth1Data, err := s.GetEth1Data()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	eth1DepositIndex, err := s.GetEth1DepositIndex()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	validators, err := s.GetValidators()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	balances, err := s.GetBalances()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	randaoMixes := make([]primitives.Bytes32, s.cs.EpochsPerHistoricalVector())
 	for i := range s.cs.EpochsPerHistoricalVector() {
 		randaoMixes[i], err = s.GetRandaoMixAtIndex(i)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 	}
 
 	nextWithdrawalIndex, err := s.GetNextWithdrawalIndex()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	nextWithdrawalValidatorIndex, err := s.GetNextWithdrawalValidatorIndex()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	slashings, err := s.GetSlashings()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	totalSlashings, err := s.GetTotalSlashing()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	// TODO: Properly move BeaconState into full generics.
@@ -402,7 +450,7 @@ func (s *StateDB[
 		totalSlashings,
 	)
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
-	return st.HashTreeRoot()
+	return st.RawBeaconState, nil
 }
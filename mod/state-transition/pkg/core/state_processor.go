@@ -49,7 +49,7 @@ type StateProcessor[
 	],
 	BlobSidecarsT BlobSidecars,
 	ContextT Context,
-	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
+	DepositT Deposit[DepositT, ForkDataT, WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(primitives.Root, math.U64, common.ExecutionHash) Eth1DataT
 		GetDepositCount() math.U64
@@ -96,7 +96,7 @@ func NewStateProcessor[
 	],
 	BlobSidecarsT BlobSidecars,
 	ContextT Context,
-	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
+	DepositT Deposit[DepositT, ForkDataT, WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(primitives.Root, math.U64, common.ExecutionHash) Eth1DataT
 		GetDepositCount() math.U64
@@ -22,6 +22,7 @@ package core
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 func (sp *StateProcessor[
@@ -37,14 +38,37 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+	nextEpoch := epoch + 1
+
+	// Validators are only exited through the withdrawal requests introduced
+	// in Electra.
+	exits := sp.cs.ActiveForkVersionForEpoch(epoch) >= version.Electra
+
 	// Create a list of validator updates.
 	//
 	// TODO: This is a trivial implementation that is to improved upon later.
 	updates := make([]*transition.ValidatorUpdate, 0)
 	for _, val := range vals {
+		effectiveBalance := val.GetEffectiveBalance()
+		if exits {
+			// Validators exiting at the next epoch are removed from the set
+			// by zeroing their voting power, after which they are no longer
+			// sent.
+			switch exitEpoch := val.GetExitEpoch(); {
+			case exitEpoch < nextEpoch:
+				continue
+			case exitEpoch == nextEpoch:
+				effectiveBalance = 0
+			}
+		}
 		updates = append(updates, &transition.ValidatorUpdate{
 			Pubkey:           val.GetPubkey(),
-			EffectiveBalance: val.GetEffectiveBalance(),
+			EffectiveBalance: effectiveBalance,
 		})
 	}
 
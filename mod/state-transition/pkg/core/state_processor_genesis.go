@@ -105,11 +105,9 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
-	for _, deposit := range deposits {
-		// TODO: process deposits into eth1 data.
-		if err = sp.processDeposit(st, deposit); err != nil {
-			return nil, err
-		}
+	// TODO: process deposits into eth1 data.
+	if err = sp.processDeposits(st, deposits); err != nil {
+		return nil, err
 	}
 
 	// TODO: process activations.
@@ -108,12 +108,20 @@ func (sp *StateProcessor[
 		)
 	}
 
+	// Execution requests are only sent to the execution client from
+	// Electra onwards, prior to that they are ignored.
+	executionRequests, err := body.GetExecutionRequests().Encode()
+	if err != nil {
+		return err
+	}
+
 	parentBeaconBlockRoot := blk.GetParentBlockRoot()
 	if err = sp.executionEngine.VerifyAndNotifyNewPayload(
 		ctx, engineprimitives.BuildNewPayloadRequest(
 			payload,
 			body.GetBlobKzgCommitments().ToVersionedHashes(),
 			&parentBeaconBlockRoot,
+			executionRequests,
 			optimisticEngine,
 		),
 	); err != nil {
@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// eth1CredentialPrefix is the prefix of withdrawal credentials that hold an
+// execution address.
+const eth1CredentialPrefix = byte(0x01)
+
+// processExecutionRequests processes the EIP-6110 deposit requests and the
+// EIP-7002 withdrawal requests of the block.
+//
+// EIP-7251 consolidation requests are not processed. Consolidating into a
+// validator, or switching a validator to compounding, requires compounding
+// withdrawal credentials, which are not supported. The specification ignores
+// consolidations into validators without compounding credentials, so every
+// consolidation request is ignored. The block is still valid, since the
+// execution client already accepted the request.
+func (sp *StateProcessor[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+]) processExecutionRequests(
+	st BeaconStateT,
+	requests *engineprimitives.ExecutionRequests,
+) error {
+	for _, req := range requests.GetDeposits() {
+		if err := sp.processDepositRequest(st, req); err != nil {
+			return err
+		}
+	}
+
+	for _, req := range requests.GetWithdrawals() {
+		if err := sp.processWithdrawalRequest(st, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processDepositRequest processes an EIP-6110 deposit request.
+//
+// Deposits from the block bodies and from deposit requests share the
+// Eth1DepositIndex, and each deposit is applied once, in order. A request
+// below the deposit index was already included in a block body and is
+// skipped. A request above it would skip the deposits in between, so the
+// block is rejected until a block body includes them.
+func (sp *StateProcessor[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+]) processDepositRequest(
+	st BeaconStateT,
+	req *engineprimitives.DepositRequest,
+) error {
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+
+	switch index := req.Index.Unwrap(); {
+	case index < depositIndex:
+		return nil
+	case index > depositIndex:
+		return errors.Wrapf(
+			ErrDepositRequestIndexGap,
+			"deposit request %d, expected %d", index, depositIndex,
+		)
+	}
+
+	if err = st.SetEth1DepositIndex(depositIndex + 1); err != nil {
+		return err
+	}
+
+	var dep DepositT
+	return sp.applyDeposit(st, dep.New(
+		req.Pubkey,
+		WithdrawalCredentialsT(req.WithdrawalCredentials),
+		req.Amount,
+		req.Signature,
+		req.Index.Unwrap(),
+	), false)
+}
+
+// processWithdrawalRequest processes an EIP-7002 withdrawal request. As in
+// the specification, invalid requests are ignored rather than invalidating
+// the block.
+//
+// Partial withdrawal requests are ignored. The specification only processes
+// them for validators with compounding withdrawal credentials, which are not
+// supported.
+//
+// Validators join the set as soon as they are deposited, without an
+// activation epoch. The activity and age checks of the specification
+// therefore reduce to the validator not exiting already.
+func (sp *StateProcessor[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+]) processWithdrawalRequest(
+	st BeaconStateT,
+	req *engineprimitives.WithdrawalRequest,
+) error {
+	if req.Amount != math.Gwei(constants.FullExitRequestAmount) {
+		return nil
+	}
+
+	idx, err := st.ValidatorIndexByPubkey(req.ValidatorPubkey)
+	if err != nil {
+		//nolint:nilerr // unknown validators are ignored.
+		return nil
+	}
+
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	if !sp.isExitable(val, req.SourceAddress) {
+		return nil
+	}
+
+	return sp.initiateValidatorExit(st, idx, val, epoch)
+}
+
+// isExitable returns true if the validator can be exited by a request sent
+// from the given address.
+func (sp *StateProcessor[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+]) isExitable(
+	val ValidatorT,
+	sourceAddress common.ExecutionAddress,
+) bool {
+	address, ok := executionAddress(val.GetWithdrawalCredentials())
+	return ok && address == sourceAddress &&
+		val.GetExitEpoch() == math.Epoch(constants.FarFutureEpoch)
+}
+
+// initiateValidatorExit exits the validator at the next epoch. There is no
+// exit queue, so the churn limit of the specification does not apply.
+func (sp *StateProcessor[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+]) initiateValidatorExit(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+	val ValidatorT,
+	epoch math.Epoch,
+) error {
+	exitEpoch := epoch + 1
+	val.SetExitEpoch(exitEpoch)
+	val.SetWithdrawableEpoch(
+		exitEpoch + math.Epoch(sp.cs.MinValidatorWithdrawabilityDelay()),
+	)
+	return st.UpdateValidatorAtIndex(idx, val)
+}
+
+// executionAddress returns the execution address of eth1 withdrawal
+// credentials.
+func executionAddress[WithdrawalCredentialsT ~[32]byte](
+	credentials WithdrawalCredentialsT,
+) (common.ExecutionAddress, bool) {
+	if credentials[0] != eth1CredentialPrefix {
+		return common.ExecutionAddress{}, false
+	}
+	return common.ExecutionAddress(credentials[12:]), true
+}
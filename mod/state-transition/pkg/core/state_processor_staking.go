@@ -23,6 +23,7 @@ package core
 import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/davecgh/go-spew/spew"
@@ -59,7 +60,12 @@ func (sp *StateProcessor[
 	// if uint64(len(deposits)) != depositCount {
 	// 	return errors.New("deposit count mismatch")
 	// }
-	return sp.processDeposits(st, deposits)
+	if err = sp.processDeposits(st, deposits); err != nil {
+		return err
+	}
+
+	// Process the execution requests, which are only present from Electra.
+	return sp.processExecutionRequests(st, blk.GetBody().GetExecutionRequests())
 }
 
 // ProcessDeposits processes the deposits and ensures they match the
@@ -73,15 +79,138 @@ func (sp *StateProcessor[
 	st BeaconStateT,
 	deposits []DepositT,
 ) error {
+	// Verify the signatures of all the deposits creating a validator at
+	// once, rather than one pairing check per deposit.
+	if err := sp.verifyDepositSignatures(st, deposits); err != nil {
+		return err
+	}
+
 	// Ensure the deposits match the local state.
 	for _, dep := range deposits {
-		if err := sp.processDeposit(st, dep); err != nil {
+		if err := sp.processDeposit(st, dep, true); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// verifyDepositSignatures verifies the signatures of the deposits that create
+// a new validator. The signatures are batch verified if the signer supports
+// it, falling back to verifying them one by one to identify the invalid
+// deposit.
+func (sp *StateProcessor[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+]) verifyDepositSignatures(
+	st BeaconStateT,
+	deposits []DepositT,
+) error {
+	var (
+		pending    = make([]DepositT, 0, len(deposits))
+		seen       = make(map[crypto.BLSPubkey]struct{}, len(deposits))
+		pubkeys    = make([]crypto.BLSPubkey, 0, len(deposits))
+		msgs       = make([][]byte, 0, len(deposits))
+		signatures = make([]crypto.BLSSignature, 0, len(deposits))
+	)
+
+	// Deposits to an existing validator, or to a validator created by an
+	// earlier deposit in the same block, are top ups and are not verified.
+	for _, dep := range deposits {
+		pubkey := dep.GetPubkey()
+		if _, ok := seen[pubkey]; ok {
+			continue
+		}
+		seen[pubkey] = struct{}{}
+		if _, err := st.ValidatorIndexByPubkey(pubkey); err == nil {
+			continue
+		}
+		pending = append(pending, dep)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	forkData, err := sp.depositForkData(st)
+	if err != nil {
+		return err
+	}
+
+	batchVerifier, ok := sp.signer.(crypto.BLSBatchVerifier)
+	if ok {
+		for _, dep := range pending {
+			if err = dep.VerifySignature(
+				forkData,
+				sp.cs.DomainTypeDeposit(),
+				func(
+					pubkey crypto.BLSPubkey,
+					msg []byte,
+					signature crypto.BLSSignature,
+				) error {
+					pubkeys = append(pubkeys, pubkey)
+					msgs = append(msgs, msg)
+					signatures = append(signatures, signature)
+					return nil
+				},
+			); err != nil {
+				return err
+			}
+		}
+		if batchVerifier.VerifySignatures(
+			pubkeys, msgs, signatures,
+		) == nil {
+			return nil
+		}
+	}
+
+	// Verify the deposits one by one, which identifies the invalid one.
+	for _, dep := range pending {
+		if err = dep.VerifySignature(
+			forkData,
+			sp.cs.DomainTypeDeposit(),
+			sp.signer.VerifySignature,
+		); err != nil {
+			return errors.Wrapf(
+				err, "invalid signature for deposit %d", dep.GetIndex(),
+			)
+		}
+	}
+	return nil
+}
+
+// depositForkData returns the fork data deposit signatures are verified
+// against.
+func (sp *StateProcessor[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+]) depositForkData(
+	st BeaconStateT,
+) (ForkDataT, error) {
+	var d ForkDataT
+
+	// Get the genesis validators root to be used to find fork data later.
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return d, err
+	}
+
+	// Get the current epoch.
+	slot, err := st.GetSlot()
+	if err != nil {
+		return d, err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	return d.New(
+		version.FromUint32[primitives.Version](
+			sp.cs.ActiveForkVersionForEpoch(epoch),
+		), genesisValidatorsRoot,
+	), nil
+}
+
 // processDeposit processes the deposit and ensures it matches the local state.
 func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
@@ -91,6 +220,7 @@ func (sp *StateProcessor[
 ]) processDeposit(
 	st BeaconStateT,
 	dep DepositT,
+	verified bool,
 ) error {
 	// TODO: fill this in properly
 	// if !sp.isValidMerkleBranch(
@@ -114,10 +244,11 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	return sp.applyDeposit(st, dep)
+	return sp.applyDeposit(st, dep, verified)
 }
 
-// processDeposit processes the deposit and ensures it matches the local state.
+// applyDeposit applies the deposit to the local state. The signature of a
+// deposit creating a validator is only verified if it was not already.
 func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, ContextT,
@@ -126,6 +257,7 @@ func (sp *StateProcessor[
 ]) applyDeposit(
 	st BeaconStateT,
 	dep DepositT,
+	verified bool,
 ) error {
 	idx, err := st.ValidatorIndexByPubkey(dep.GetPubkey())
 	// If the validator already exists, we update the balance.
@@ -144,7 +276,7 @@ func (sp *StateProcessor[
 
 	// If the validator does not exist, we add the validator.
 	// Add the validator to the registry.
-	return sp.createValidator(st, dep)
+	return sp.createValidator(st, dep, verified)
 }
 
 // createValidator creates a validator if the deposit is valid.
@@ -156,39 +288,22 @@ func (sp *StateProcessor[
 ]) createValidator(
 	st BeaconStateT,
 	dep DepositT,
+	verified bool,
 ) error {
-	var (
-		genesisValidatorsRoot primitives.Root
-		epoch                 math.Epoch
-		err                   error
-	)
-
-	// Get the genesis validators root to be used to find fork data later.
-	genesisValidatorsRoot, err = st.GetGenesisValidatorsRoot()
-	if err != nil {
-		return err
-	}
-
-	// Get the current epoch.
-	// Get the current slot.
-	slot, err := st.GetSlot()
-	if err != nil {
-		return err
-	}
-	epoch = sp.cs.SlotToEpoch(slot)
+	if !verified {
+		forkData, err := sp.depositForkData(st)
+		if err != nil {
+			return err
+		}
 
-	// Verify that the message was signed correctly.
-	var d ForkDataT
-	if err = dep.VerifySignature(
-		d.New(
-			version.FromUint32[primitives.Version](
-				sp.cs.ActiveForkVersionForEpoch(epoch),
-			), genesisValidatorsRoot,
-		),
-		sp.cs.DomainTypeDeposit(),
-		sp.signer.VerifySignature,
-	); err != nil {
-		return err
+		// Verify that the message was signed correctly.
+		if err = dep.VerifySignature(
+			forkData,
+			sp.cs.DomainTypeDeposit(),
+			sp.signer.VerifySignature,
+		); err != nil {
+			return err
+		}
 	}
 
 	// Add the validator to the registry.
@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// badSignature is the signature the test signer rejects.
+	badSignature = crypto.BLSSignature{1}
+
+	errBadSignature = errors.New("bad signature")
+)
+
+// testChainSpec is a chain spec whose forks are all Deneb.
+type testChainSpec struct {
+	primitives.ChainSpec
+}
+
+func (testChainSpec) DomainTypeDeposit() common.DomainType {
+	return common.DomainType{0x03}
+}
+
+func (testChainSpec) SlotToEpoch(math.Slot) math.Epoch {
+	return 0
+}
+
+func (testChainSpec) ActiveForkVersionForEpoch(math.Epoch) uint32 {
+	return version.Deneb
+}
+
+// testState is a beacon state holding only the pubkeys of its validators.
+type testState struct {
+	BeaconState[
+		*types.BeaconBlockHeader, *types.Eth1Data,
+		*types.ExecutionPayloadHeader, *types.Fork,
+		*types.Validator, *engineprimitives.Withdrawal,
+	]
+	validators []crypto.BLSPubkey
+}
+
+func (s *testState) ValidatorIndexByPubkey(
+	pubkey crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	for i, validator := range s.validators {
+		if validator == pubkey {
+			return math.ValidatorIndex(i), nil
+		}
+	}
+	return 0, errors.New("validator not found")
+}
+
+func (s *testState) GetGenesisValidatorsRoot() (common.Root, error) {
+	return common.Root{}, nil
+}
+
+func (s *testState) GetSlot() (math.Slot, error) {
+	return 0, nil
+}
+
+// testSidecars are empty blob sidecars.
+type testSidecars struct{}
+
+func (testSidecars) Len() int { return 0 }
+
+// testSigner is a signer rejecting the bad signature, counting the
+// signatures it verifies.
+type testSigner struct {
+	verified int
+}
+
+func (s *testSigner) PublicKey() crypto.BLSPubkey {
+	return crypto.BLSPubkey{}
+}
+
+func (s *testSigner) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, nil
+}
+
+func (s *testSigner) VerifySignature(
+	_ crypto.BLSPubkey,
+	_ []byte,
+	signature crypto.BLSSignature,
+) error {
+	s.verified++
+	if signature == badSignature {
+		return errBadSignature
+	}
+	return nil
+}
+
+// testBatchSigner is a test signer which also batch verifies signatures,
+// recording the size of each batch.
+type testBatchSigner struct {
+	*testSigner
+	batches []int
+}
+
+func (s *testBatchSigner) VerifySignatures(
+	_ []crypto.BLSPubkey,
+	_ [][]byte,
+	signatures []crypto.BLSSignature,
+) error {
+	s.batches = append(s.batches, len(signatures))
+	for _, signature := range signatures {
+		if signature == badSignature {
+			return errBadSignature
+		}
+	}
+	return nil
+}
+
+// newTestStateProcessor returns a state processor verifying signatures with
+// the given signer.
+func newTestStateProcessor(
+	signer crypto.BLSSigner,
+) *StateProcessor[
+	*types.BeaconBlock, *types.BeaconBlockBody, *types.BeaconBlockHeader,
+	*testState, testSidecars, *transition.Context,
+	*types.Deposit, *types.Eth1Data, *types.ExecutionPayload,
+	*types.ExecutionPayloadHeader, *types.Fork, *types.ForkData,
+	*types.Validator, *engineprimitives.Withdrawal, types.WithdrawalCredentials,
+] {
+	return NewStateProcessor[
+		*types.BeaconBlock, *types.BeaconBlockBody, *types.BeaconBlockHeader,
+		*testState, testSidecars, *transition.Context,
+		*types.Deposit, *types.Eth1Data, *types.ExecutionPayload,
+		*types.ExecutionPayloadHeader, *types.Fork, *types.ForkData,
+		*types.Validator, *engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	](testChainSpec{}, nil, signer)
+}
+
+// newDeposit returns a deposit of the given index to the validator of the
+// given pubkey.
+func newDeposit(
+	index uint64,
+	pubkey crypto.BLSPubkey,
+	signature crypto.BLSSignature,
+) *types.Deposit {
+	return types.NewDeposit(
+		pubkey, types.WithdrawalCredentials{}, 32e9, signature, index,
+	)
+}
+
+func TestVerifyDepositSignatures_Batch(t *testing.T) {
+	signer := &testBatchSigner{testSigner: &testSigner{}}
+	sp := newTestStateProcessor(signer)
+
+	require.NoError(t, sp.verifyDepositSignatures(&testState{}, []*types.Deposit{
+		newDeposit(0, crypto.BLSPubkey{1}, crypto.BLSSignature{}),
+		newDeposit(1, crypto.BLSPubkey{2}, crypto.BLSSignature{}),
+		newDeposit(2, crypto.BLSPubkey{3}, crypto.BLSSignature{}),
+	}))
+	require.Equal(t, []int{3}, signer.batches)
+	require.Zero(t, signer.verified)
+}
+
+func TestVerifyDepositSignatures_BadDepositInBatch(t *testing.T) {
+	signer := &testBatchSigner{testSigner: &testSigner{}}
+	sp := newTestStateProcessor(signer)
+
+	// The batch fails, the deposits are then verified one by one until the
+	// bad one is found.
+	err := sp.verifyDepositSignatures(&testState{}, []*types.Deposit{
+		newDeposit(0, crypto.BLSPubkey{1}, crypto.BLSSignature{}),
+		newDeposit(1, crypto.BLSPubkey{2}, crypto.BLSSignature{}),
+		newDeposit(2, crypto.BLSPubkey{3}, badSignature),
+		newDeposit(3, crypto.BLSPubkey{4}, crypto.BLSSignature{}),
+	})
+	require.ErrorIs(t, err, errBadSignature)
+	require.ErrorIs(t, err, types.ErrDepositMessage)
+	require.ErrorContains(t, err, "invalid signature for deposit 2")
+	require.Equal(t, []int{4}, signer.batches)
+	require.Equal(t, 3, signer.verified)
+}
+
+func TestVerifyDepositSignatures_NoBatchVerifier(t *testing.T) {
+	signer := &testSigner{}
+	sp := newTestStateProcessor(signer)
+
+	err := sp.verifyDepositSignatures(&testState{}, []*types.Deposit{
+		newDeposit(0, crypto.BLSPubkey{1}, crypto.BLSSignature{}),
+		newDeposit(1, crypto.BLSPubkey{2}, badSignature),
+	})
+	require.ErrorIs(t, err, errBadSignature)
+	require.ErrorContains(t, err, "invalid signature for deposit 1")
+	require.Equal(t, 2, signer.verified)
+}
+
+func TestVerifyDepositSignatures_TopUps(t *testing.T) {
+	signer := &testBatchSigner{testSigner: &testSigner{}}
+	sp := newTestStateProcessor(signer)
+	st := &testState{validators: []crypto.BLSPubkey{{1}}}
+
+	// Deposits to an existing validator, or to a validator created earlier
+	// in the block, are not verified, even if their signature is bad.
+	require.NoError(t, sp.verifyDepositSignatures(st, []*types.Deposit{
+		newDeposit(0, crypto.BLSPubkey{1}, badSignature),
+		newDeposit(1, crypto.BLSPubkey{2}, crypto.BLSSignature{}),
+		newDeposit(2, crypto.BLSPubkey{2}, badSignature),
+	}))
+	require.Equal(t, []int{1}, signer.batches)
+	require.Zero(t, signer.verified)
+
+	// A block of top ups only is not verified at all.
+	require.NoError(t, sp.verifyDepositSignatures(st, []*types.Deposit{
+		newDeposit(3, crypto.BLSPubkey{1}, badSignature),
+	}))
+	require.Equal(t, []int{1}, signer.batches)
+}
@@ -98,6 +98,9 @@ type BeaconBlockBody[
 	HashTreeRoot() ([32]byte, error)
 	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
 	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+	// GetExecutionRequests returns the execution requests, which are nil
+	// prior to Electra.
+	GetExecutionRequests() *engineprimitives.ExecutionRequests
 }
 
 // BlobSidecars is the interface for blobs sidecars.
@@ -129,9 +132,18 @@ type Context interface {
 
 // Deposit is the interface for a deposit.
 type Deposit[
+	DepositT any,
 	ForkDataT any,
 	WithdrawlCredentialsT ~[32]byte,
 ] interface {
+	// New creates a new deposit.
+	New(
+		pubkey crypto.BLSPubkey,
+		credentials WithdrawlCredentialsT,
+		amount math.Gwei,
+		signature crypto.BLSSignature,
+		index uint64,
+	) DepositT
 	// GetAmount returns the amount of the deposit.
 	GetAmount() math.Gwei
 	// GetIndex returns the index of the deposit.
@@ -251,8 +263,17 @@ type Validator[
 	GetEffectiveBalance() math.Gwei
 	// SetEffectiveBalance sets the effective balance of the validator in Gwei.
 	SetEffectiveBalance(math.Gwei)
+	// GetWithdrawalCredentials returns the withdrawal credentials of the
+	// validator.
+	GetWithdrawalCredentials() WithdrawalCredentialsT
+	// GetExitEpoch returns the epoch when the validator exits.
+	GetExitEpoch() math.Epoch
+	// SetExitEpoch sets the epoch when the validator exits.
+	SetExitEpoch(math.Epoch)
 	// GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 	GetWithdrawableEpoch() math.Epoch
+	// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+	SetWithdrawableEpoch(math.Epoch)
 }
 
 // Withdrawal is the interface for a withdrawal.
@@ -21,6 +21,7 @@
 package encoding
 
 import (
+	"encoding/json"
 	"reflect"
 
 	"cosmossdk.io/collections/codec"
@@ -50,14 +51,20 @@ func (SSZValueCodec[T]) Decode(b []byte) (T, error) {
 	return v, nil
 }
 
-// EncodeJSON is not implemented and will panic if called.
-func (SSZValueCodec[T]) EncodeJSON(_ T) ([]byte, error) {
-	panic("not implemented")
+// EncodeJSON marshals the provided value into its JSON encoding.
+func (SSZValueCodec[T]) EncodeJSON(value T) ([]byte, error) {
+	return json.Marshal(value)
 }
 
-// DecodeJSON is not implemented and will panic if called.
-func (SSZValueCodec[T]) DecodeJSON(_ []byte) (T, error) {
-	panic("not implemented")
+// DecodeJSON unmarshals the provided JSON bytes into a value of type T.
+func (SSZValueCodec[T]) DecodeJSON(b []byte) (T, error) {
+	var v T
+	//nolint:errcheck // will error in unmarshal if there is a problem.
+	v = reflect.New(reflect.TypeOf(v).Elem()).Interface().(T)
+	if err := json.Unmarshal(b, v); err != nil {
+		return v, err
+	}
+	return v, nil
 }
 
 // Stringify returns the string representation of the provided value.
@@ -99,9 +106,9 @@ func (cdc SSZInterfaceCodec[T]) Decode(b []byte) (T, error) {
 	return t.NewFromSSZ(b, cdc.latestVersion)
 }
 
-// EncodeJSON is not implemented and will panic if called.
-func (SSZInterfaceCodec[T]) EncodeJSON(_ T) ([]byte, error) {
-	panic("not implemented")
+// EncodeJSON marshals the provided value into its JSON encoding.
+func (SSZInterfaceCodec[T]) EncodeJSON(value T) ([]byte, error) {
+	return json.Marshal(value)
 }
 
 // DecodeJSON is not implemented and will panic if called.
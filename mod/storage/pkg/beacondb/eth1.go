@@ -49,6 +49,7 @@ func (kv *KVStore[
 		return err
 	}
 	kv.latestExecutionPayloadCodec.SetActiveForkVersion(payloadHeader.Version())
+	kv.changed(latestExecutionPayloadHeaderField, 0)
 	return kv.latestExecutionPayloadHeader.Set(kv.ctx, payloadHeader)
 }
 
@@ -65,6 +66,7 @@ func (kv *KVStore[
 ]) SetEth1DepositIndex(
 	index uint64,
 ) error {
+	kv.changed(eth1DepositIndexField, 0)
 	return kv.eth1DepositIndex.Set(kv.ctx, index)
 }
 
@@ -81,5 +83,6 @@ func (kv *KVStore[
 ]) SetEth1Data(
 	data Eth1DataT,
 ) error {
+	kv.changed(eth1DataField, 0)
 	return kv.eth1Data.Set(kv.ctx, data)
 }
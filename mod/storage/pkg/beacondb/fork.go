@@ -26,6 +26,7 @@ func (kv *KVStore[
 ]) SetFork(
 	fork ForkT,
 ) error {
+	kv.changed(forkField, 0)
 	return kv.fork.Set(kv.ctx, fork)
 }
 
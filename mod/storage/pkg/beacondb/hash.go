@@ -0,0 +1,896 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"slices"
+	"sync"
+
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+	sha256 "github.com/minio/sha256-simd"
+)
+
+// ErrListTooBig is returned when a list of the beacon state exceeds its
+// limit.
+var ErrListTooBig = errors.New("list exceeds its limit")
+
+// field is a field of the beacon state. The fields are in the order of the
+// deneb and electra BeaconState containers, whose hash tree root is
+// computed by ComputeHashTreeRoot.
+type field uint8
+
+const (
+	genesisValidatorsRootField field = iota
+	slotField
+	forkField
+	latestBlockHeaderField
+	blockRootsField
+	stateRootsField
+	eth1DataField
+	eth1DepositIndexField
+	latestExecutionPayloadHeaderField
+	validatorsField
+	balancesField
+	randaoMixesField
+	nextWithdrawalIndexField
+	nextWithdrawalValidatorIndexField
+	slashingsField
+	totalSlashingField
+	numFields
+)
+
+const (
+	// allIndices is the index of a change that rewrote a list as a whole.
+	allIndices = math.MaxUint64
+	// maxSnapshots is the number of snapshots of recently hashed states that
+	// are kept.
+	maxSnapshots = 8
+	// historicalRootsLimit is the limit of the block and state roots lists.
+	historicalRootsLimit = 8192
+	// randaoMixesLimit is the limit of the randao mixes list.
+	randaoMixesLimit = 65536
+	// registryLimitDepth is the depth of the validators list, whose limit is
+	// 2^40 validators.
+	registryLimitDepth = 40
+	// packedRegistryLimitDepth is the depth of the balances and slashings
+	// lists, which pack 4 of their 2^40 uint64 elements per chunk.
+	packedRegistryLimitDepth = 38
+	// uint64sPerChunk is the number of uint64 elements packed in a chunk.
+	uint64sPerChunk = 4
+)
+
+// isList returns true if the field is a list, whose elements are tracked
+// individually.
+func (f field) isList() bool {
+	switch f {
+	case blockRootsField, stateRootsField, validatorsField,
+		balancesField, randaoMixesField, slashingsField:
+		return true
+	default:
+		return false
+	}
+}
+
+// change is a write to a field of the beacon state, at an index for lists.
+type change struct {
+	field field
+	index uint64
+}
+
+// stateTrees are the Merkle trees of the fields of a beacon state. They are
+// shared copy-on-write between the stores and snapshots of the same state.
+type stateTrees struct {
+	// roots are the hash tree roots of the fields.
+	roots [numFields][32]byte
+	// lists are the Merkle trees of the list fields.
+	lists [numFields]*listTree
+	// owned are the lists that are not shared and may be updated in place.
+	owned [numFields]bool
+	// sparse are the registry lists whose keys are not contiguous, and whose
+	// trees are thus rebuilt on every change.
+	sparse [numFields]bool
+}
+
+// share returns a copy of the trees that shares the lists with them. Neither
+// the trees nor the copy update the shared lists in place afterwards.
+func (t *stateTrees) share() *stateTrees {
+	t.owned = [numFields]bool{}
+	cpy := *t
+	return &cpy
+}
+
+// list returns the tree of the given list, which is copied first if shared.
+func (t *stateTrees) list(f field) *listTree {
+	if !t.owned[f] {
+		t.lists[f] = t.lists[f].Copy()
+		t.owned[f] = true
+	}
+	return t.lists[f]
+}
+
+// hashCache tracks the changes made through a store, and the Merkle trees of
+// its state as of the last time it was hashed.
+type hashCache struct {
+	// mu protects the fields below.
+	mu sync.Mutex
+	// trees are the Merkle trees of the state, nil until it is first hashed.
+	trees *stateTrees
+	// changes are the changes made through the store, in order. It is only
+	// ever appended to.
+	changes []change
+	// applied is the number of changes reflected in the trees.
+	applied int
+	// inherited is the number of changes inherited from the parent.
+	inherited int
+	// saved is the number of changes written back to the parent.
+	saved int
+	// parent is the cache of the store this one was copied from, nil if the
+	// store is not a copy.
+	parent *hashCache
+	// failed is set once hashing the state failed, after which the trees are
+	// rebuilt from scratch rather than taken from a snapshot.
+	failed bool
+}
+
+// record records a change. It returns true for the first change of a store
+// that is not a copy and did not hash the state yet, whose changes are not
+// in any snapshot journal yet.
+func (c *hashCache) record(f field, index uint64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.changes = append(c.changes, change{field: f, index: index})
+	return len(c.changes) == 1 && c.trees == nil && c.parent == nil
+}
+
+// since returns the changes recorded after the first n.
+func (c *hashCache) since(n int) []change {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.changes[n:len(c.changes):len(c.changes)]
+}
+
+// branch returns the cache of a copy of the store, which starts with the
+// trees of this cache and its changes not applied to them yet.
+func (c *hashCache) branch() *hashCache {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	child := &hashCache{
+		changes: slices.Clone(c.changes[c.applied:]),
+		parent:  c,
+	}
+	child.inherited = len(child.changes)
+	if c.trees != nil {
+		child.trees = c.trees.share()
+	}
+	return child
+}
+
+// detach returns the cache of a store the changes of the store of this
+// cache were written to, which starts with the trees of this cache. Without
+// trees, the changes do not cover the whole state, and the store starts
+// from a snapshot or from scratch instead.
+func (c *hashCache) detach() *hashCache {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.trees == nil {
+		return new(hashCache)
+	}
+	return &hashCache{
+		trees:   c.trees.share(),
+		changes: slices.Clone(c.changes[c.applied:]),
+	}
+}
+
+// save records the changes made through a copy of the store with the
+// parent, once they have been written back to it. It returns the parent, nil
+// if the store is not a copy.
+func (c *hashCache) save() *hashCache {
+	if c.parent == nil {
+		return nil
+	}
+	c.mu.Lock()
+	changes := c.changes[max(c.inherited, c.saved):]
+	c.saved = len(c.changes)
+	c.mu.Unlock()
+
+	c.parent.mu.Lock()
+	defer c.parent.mu.Unlock()
+	c.parent.changes = append(c.parent.changes, changes...)
+	return c.parent
+}
+
+// len returns the number of changes recorded.
+func (c *hashCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.changes)
+}
+
+// snapshotKey identifies a beacon state by its slot and the root of its
+// latest block header. The state transition is deterministic, thus every
+// state with the same key is the same when hashed.
+type snapshotKey struct {
+	slot   uint64
+	header [32]byte
+}
+
+// journal refers to the changes made to a state after it was hashed.
+type journal struct {
+	cache *hashCache
+	start int
+}
+
+// snapshot are the Merkle trees of a state when it was hashed.
+type snapshot struct {
+	// trees are nil until the state is hashed, if the snapshot was created
+	// by watch.
+	trees *stateTrees
+	// journals are the changes made by every store that hashed the state
+	// since. Their union covers the changes of any store derived from it,
+	// e.g. the changes of a block that was committed after the same block
+	// was processed in a proposal.
+	journals []journal
+}
+
+// snapshots are the snapshots of the most recently hashed states, which let
+// the stores of new contexts start from the trees of their committed state.
+type snapshots struct {
+	// mu protects the fields below.
+	mu sync.Mutex
+	// byKey are the snapshots by the key of their state.
+	byKey map[snapshotKey]*snapshot
+	// keys are the keys of the snapshots, oldest first.
+	keys []snapshotKey
+}
+
+// newSnapshots creates an empty set of snapshots.
+func newSnapshots() *snapshots {
+	return &snapshots{byKey: make(map[snapshotKey]*snapshot)}
+}
+
+// add adds the trees of a state hashed by the store of the given cache.
+func (s *snapshots) add(
+	key snapshotKey, trees *stateTrees, cache *hashCache, start int,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snap := s.snapshot(key)
+	if snap.trees == nil {
+		snap.trees = trees
+	}
+	snap.addJournal(cache, start)
+}
+
+// watch adds the changes made by the store of the given cache from now on
+// to the journals of the state with the given key, which may not have been
+// hashed yet.
+func (s *snapshots) watch(key snapshotKey, cache *hashCache, start int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshot(key).addJournal(cache, start)
+}
+
+// snapshot returns the snapshot of the state with the given key, which is
+// created if needed.
+func (s *snapshots) snapshot(key snapshotKey) *snapshot {
+	if snap, ok := s.byKey[key]; ok {
+		return snap
+	}
+
+	snap := new(snapshot)
+	s.byKey[key] = snap
+	s.keys = append(s.keys, key)
+	if len(s.keys) > maxSnapshots {
+		delete(s.byKey, s.keys[0])
+		s.keys = s.keys[1:]
+	}
+	return snap
+}
+
+// addJournal adds the changes made by the store of the given cache after
+// the first start ones to the journals of the snapshot.
+func (snap *snapshot) addJournal(cache *hashCache, start int) {
+	for i, j := range snap.journals {
+		if j.cache == cache {
+			snap.journals[i].start = min(j.start, start)
+			return
+		}
+	}
+	snap.journals = append(snap.journals, journal{cache, start})
+}
+
+// get returns the trees of the state with the given key, and the changes
+// made to it since by the stores other than that of the given cache, nil if
+// the state was not hashed recently.
+func (s *snapshots) get(
+	key snapshotKey, self *hashCache,
+) (*stateTrees, []change) {
+	s.mu.Lock()
+	snap, ok := s.byKey[key]
+	if !ok || snap.trees == nil {
+		s.mu.Unlock()
+		return nil, nil
+	}
+	trees := snap.trees.share()
+	journals := slices.Clone(snap.journals)
+	s.mu.Unlock()
+
+	// No lock is held while reading the journals, so that stores looking
+	// up snapshots concurrently do not wait on each other.
+	var changes []change
+	for _, j := range journals {
+		if j.cache != self {
+			changes = append(changes, j.cache.since(j.start)...)
+		}
+	}
+	return trees, changes
+}
+
+// ComputeHashTreeRoot returns the hash tree root of the beacon state, given
+// the lengths of its block and state roots and randao mixes vectors.
+//
+// The Merkle trees of the state are kept, and only the fields and list
+// elements changed through the store since it was last hashed are rehashed.
+// A store of a new context starts from the trees of a recently hashed state
+// with the same slot and latest block header, if any. Writes to the context
+// made outside of the store, or its copies, are not tracked.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) ComputeHashTreeRoot(
+	slotsPerHistoricalRoot uint64,
+	epochsPerHistoricalVector uint64,
+) ([32]byte, error) {
+	c := kv.hashes
+	key, err := kv.snapshotKey()
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	// The snapshot is looked up before locking the cache, as reading its
+	// journals locks the caches of other stores.
+	c.mu.Lock()
+	lookup := c.trees == nil && !c.failed
+	c.mu.Unlock()
+	var (
+		snapTrees   *stateTrees
+		snapChanges []change
+	)
+	if lookup {
+		snapTrees, snapChanges = kv.snapshots.get(key, c)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The changes since the trees were last hashed may have been made with
+	// the state already at the key, they are thus in its journal too. All
+	// of them are for trees that were not hashed through the store.
+	start := c.applied
+	if c.trees == nil {
+		start = 0
+	}
+	pending := c.changes[c.applied:]
+	switch {
+	case c.trees != nil:
+	case snapTrees != nil:
+		c.trees = snapTrees
+		pending = append(snapChanges, pending...)
+	default:
+		c.trees, err = kv.buildTrees(
+			slotsPerHistoricalRoot, epochsPerHistoricalVector,
+		)
+		pending = nil
+	}
+	if err == nil {
+		err = kv.applyChanges(
+			c.trees, pending,
+			slotsPerHistoricalRoot, epochsPerHistoricalVector,
+		)
+	}
+	if err != nil {
+		// The trees may be partially updated, start over the next time.
+		c.trees, c.failed = nil, true
+		return [32]byte{}, err
+	}
+	c.applied = len(c.changes)
+
+	kv.snapshots.add(key, c.trees.share(), c, start)
+	return merkleizeFields(&c.trees.roots), nil
+}
+
+// changed records a change of the given field at the given index.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) changed(f field, index uint64) {
+	if kv.hashes == nil || !kv.hashes.record(f, index) {
+		return
+	}
+
+	// The changes of a store that did not hash the state are added to the
+	// journals of the state it started from, as the stores of other contexts
+	// with the same state would miss them otherwise.
+	kv.watch(kv.hashes, 0)
+}
+
+// rekeyed adds the changes made through the store from now on to the
+// journals of its state, once its slot or latest block header changed.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) rekeyed() {
+	if kv.hashes != nil {
+		kv.watch(kv.hashes, kv.hashes.len())
+	}
+}
+
+// watch adds the changes of the given cache after the first start ones to
+// the journals of the current state of the store. The state cannot be
+// identified before its slot and latest block header are set, when no other
+// context can have the same state either.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) watch(c *hashCache, start int) {
+	if key, err := kv.snapshotKey(); err == nil {
+		kv.snapshots.watch(key, c, start)
+	}
+}
+
+// snapshotKey returns the key of the state for its snapshots.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) snapshotKey() (snapshotKey, error) {
+	slot, err := kv.slot.Get(kv.ctx)
+	if err != nil {
+		return snapshotKey{}, err
+	}
+	header, err := kv.latestBlockHeader.Get(kv.ctx)
+	if err != nil {
+		return snapshotKey{}, err
+	}
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return snapshotKey{}, err
+	}
+	return snapshotKey{slot: slot, header: headerRoot}, nil
+}
+
+// buildTrees builds the Merkle trees of the state from scratch.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) buildTrees(
+	slotsPerHistoricalRoot uint64,
+	epochsPerHistoricalVector uint64,
+) (*stateTrees, error) {
+	t := new(stateTrees)
+	for f := range numFields {
+		if !f.isList() {
+			var err error
+			if t.roots[f], err = kv.fieldRoot(f); err != nil {
+				return nil, err
+			}
+		} else if err := kv.buildList(
+			t, f, slotsPerHistoricalRoot, epochsPerHistoricalVector,
+		); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// applyChanges updates the trees with the given changes.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) applyChanges(
+	t *stateTrees,
+	changes []change,
+	slotsPerHistoricalRoot uint64,
+	epochsPerHistoricalVector uint64,
+) error {
+	var (
+		changedFields [numFields]bool
+		rebuild       [numFields]bool
+		indices       [numFields][]uint64
+	)
+	for _, ch := range changes {
+		changedFields[ch.field] = true
+		if ch.index == allIndices {
+			rebuild[ch.field] = true
+		} else if ch.field.isList() {
+			indices[ch.field] = append(indices[ch.field], ch.index)
+		}
+	}
+
+	var err error
+	for f := range numFields {
+		switch {
+		case !changedFields[f]:
+			continue
+		case !f.isList():
+			t.roots[f], err = kv.fieldRoot(f)
+		case rebuild[f] || t.sparse[f]:
+			err = kv.buildList(
+				t, f, slotsPerHistoricalRoot, epochsPerHistoricalVector,
+			)
+		default:
+			var ok bool
+			if ok, err = kv.updateList(t, f, indices[f]); err == nil && !ok {
+				err = kv.buildList(
+					t, f, slotsPerHistoricalRoot, epochsPerHistoricalVector,
+				)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fieldRoot returns the hash tree root of a field that is not a list.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) fieldRoot(f field) ([32]byte, error) {
+	switch f {
+	case genesisValidatorsRootField:
+		return kv.GetGenesisValidatorsRoot()
+	case slotField:
+		return uint64Root(kv.slot.Get(kv.ctx))
+	case forkField:
+		return hashTreeRoot(kv.GetFork())
+	case latestBlockHeaderField:
+		return hashTreeRoot(kv.GetLatestBlockHeader())
+	case eth1DataField:
+		return hashTreeRoot(kv.GetEth1Data())
+	case eth1DepositIndexField:
+		return uint64Root(kv.eth1DepositIndex.Get(kv.ctx))
+	case latestExecutionPayloadHeaderField:
+		return hashTreeRoot(kv.GetLatestExecutionPayloadHeader())
+	case nextWithdrawalIndexField:
+		return uint64Root(kv.nextWithdrawalIndex.Get(kv.ctx))
+	case nextWithdrawalValidatorIndexField:
+		return uint64Root(kv.nextWithdrawalValidatorIndex.Get(kv.ctx))
+	case totalSlashingField:
+		total, err := kv.GetTotalSlashing()
+		return uint64Root(uint64(total), err)
+	default:
+		panic("not a field of the beacon state")
+	}
+}
+
+// buildList builds the tree of a list field from scratch.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) buildList(
+	t *stateTrees,
+	f field,
+	slotsPerHistoricalRoot uint64,
+	epochsPerHistoricalVector uint64,
+) error {
+	var (
+		tree   *listTree
+		sparse bool
+		err    error
+	)
+	switch f {
+	case blockRootsField:
+		tree, err = buildVector(
+			kv.ctx, kv.blockRoots,
+			slotsPerHistoricalRoot, historicalRootsLimit,
+		)
+	case stateRootsField:
+		tree, err = buildVector(
+			kv.ctx, kv.stateRoots,
+			slotsPerHistoricalRoot, historicalRootsLimit,
+		)
+	case randaoMixesField:
+		tree, err = buildVector(
+			kv.ctx, kv.randaoMix,
+			epochsPerHistoricalVector, randaoMixesLimit,
+		)
+	case validatorsField:
+		tree, sparse, err = kv.buildValidators()
+	case balancesField:
+		tree, sparse, err = buildPacked(kv.ctx, kv.balances)
+	case slashingsField:
+		tree, sparse, err = buildPacked(kv.ctx, kv.slashings)
+	default:
+		panic("not a list of the beacon state")
+	}
+	if err != nil {
+		return err
+	}
+
+	t.lists[f], t.owned[f], t.sparse[f] = tree, true, sparse
+	t.roots[f] = tree.HashTreeRoot()
+	return nil
+}
+
+// updateList updates the tree of a list field with the elements at the
+// given indices. It returns false if the list must be rebuilt instead, i.e.
+// if an element was added past its end.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) updateList(t *stateTrees, f field, indices []uint64) (bool, error) {
+	slices.Sort(indices)
+	indices = slices.Compact(indices)
+
+	var (
+		ok  = true
+		err error
+	)
+	switch tree := t.list(f); f {
+	case blockRootsField:
+		err = updateVector(kv.ctx, kv.blockRoots, tree, indices)
+	case stateRootsField:
+		err = updateVector(kv.ctx, kv.stateRoots, tree, indices)
+	case randaoMixesField:
+		err = updateVector(kv.ctx, kv.randaoMix, tree, indices)
+	case validatorsField:
+		ok, err = kv.updateValidators(tree, indices)
+	case balancesField:
+		ok, err = updatePacked(kv.ctx, kv.balances, tree, indices)
+	case slashingsField:
+		ok, err = updatePacked(kv.ctx, kv.slashings, tree, indices)
+	default:
+		panic("not a list of the beacon state")
+	}
+	if !ok || err != nil {
+		return ok, err
+	}
+	t.roots[f] = t.lists[f].HashTreeRoot()
+	return true, nil
+}
+
+// buildValidators builds the tree of the validators, which is sparse if the
+// indices of the validators are not contiguous.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) buildValidators() (*listTree, bool, error) {
+	iter, err := kv.validators.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	defer iter.Close()
+
+	var (
+		chunks [][32]byte
+		sparse bool
+	)
+	for ; iter.Valid(); iter.Next() {
+		kv, kvErr := iter.KeyValue()
+		if kvErr != nil {
+			return nil, false, kvErr
+		}
+		root, rErr := kv.Value.HashTreeRoot()
+		if rErr != nil {
+			return nil, false, rErr
+		}
+		sparse = sparse || kv.Key != uint64(len(chunks))
+		chunks = append(chunks, root)
+	}
+	tree, err := newListTree(chunks, uint64(len(chunks)), registryLimitDepth)
+	return tree, sparse, err
+}
+
+// updateValidators updates the tree of the validators with the validators
+// at the given sorted indices.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) updateValidators(tree *listTree, indices []uint64) (bool, error) {
+	for _, i := range indices {
+		if i > tree.length {
+			return false, nil
+		}
+		val, err := kv.validators.Get(kv.ctx, i)
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		root, err := val.HashTreeRoot()
+		if err != nil {
+			return false, err
+		}
+		tree.SetChunk(i, root)
+		if i == tree.length {
+			tree.length++
+		}
+	}
+	return true, nil
+}
+
+// buildVector builds the tree of a vector of roots stored by index, which is
+// hashed as a list of the given length.
+func buildVector(
+	ctx context.Context,
+	m collections.Map[uint64, []byte],
+	length uint64,
+	limit uint64,
+) (*listTree, error) {
+	if length > limit {
+		return nil, ErrListTooBig
+	}
+	chunks := make([][32]byte, length)
+	for i := range length {
+		bz, err := m.Get(ctx, i)
+		if err != nil {
+			return nil, err
+		}
+		chunks[i] = [32]byte(bz)
+	}
+	return newListTree(chunks, length, depthOf(limit))
+}
+
+// updateVector updates the tree of a vector of roots with the roots at the
+// given indices, ignoring those outside of it.
+func updateVector(
+	ctx context.Context,
+	m collections.Map[uint64, []byte],
+	tree *listTree,
+	indices []uint64,
+) error {
+	for _, i := range indices {
+		if i >= tree.length {
+			continue
+		}
+		bz, err := m.Get(ctx, i)
+		if err != nil {
+			return err
+		}
+		tree.SetChunk(i, [32]byte(bz))
+	}
+	return nil
+}
+
+// buildPacked builds the tree of a list of uint64 stored by index, which is
+// sparse if the indices are not contiguous.
+func buildPacked(
+	ctx context.Context,
+	m collections.Map[uint64, uint64],
+) (*listTree, bool, error) {
+	iter, err := m.Iterate(ctx, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	defer iter.Close()
+
+	var (
+		chunks [][32]byte
+		length uint64
+		sparse bool
+	)
+	for ; iter.Valid(); iter.Next() {
+		kv, kvErr := iter.KeyValue()
+		if kvErr != nil {
+			return nil, false, kvErr
+		}
+		if length%uint64sPerChunk == 0 {
+			chunks = append(chunks, [32]byte{})
+		}
+		putPacked(&chunks[len(chunks)-1], length, kv.Value)
+		sparse = sparse || kv.Key != length
+		length++
+	}
+	tree, err := newListTree(chunks, length, packedRegistryLimitDepth)
+	return tree, sparse, err
+}
+
+// updatePacked updates the tree of a list of uint64 with the elements at
+// the given sorted indices.
+func updatePacked(
+	ctx context.Context,
+	m collections.Map[uint64, uint64],
+	tree *listTree,
+	indices []uint64,
+) (bool, error) {
+	chunks := make([]uint64, 0, len(indices))
+	for _, i := range indices {
+		if i > tree.length {
+			return false, nil
+		} else if i == tree.length {
+			tree.length++
+		}
+		if c := i / uint64sPerChunk; len(chunks) == 0 ||
+			chunks[len(chunks)-1] != c {
+			chunks = append(chunks, c)
+		}
+	}
+
+	for _, c := range chunks {
+		var chunk [32]byte
+		start := c * uint64sPerChunk
+		for i := start; i < min(start+uint64sPerChunk, tree.length); i++ {
+			v, err := m.Get(ctx, i)
+			if errors.Is(err, collections.ErrNotFound) {
+				return false, nil
+			} else if err != nil {
+				return false, err
+			}
+			putPacked(&chunk, i, v)
+		}
+		tree.SetChunk(c, chunk)
+	}
+	return true, nil
+}
+
+// putPacked puts the element at index i of a list of uint64 into its chunk.
+func putPacked(chunk *[32]byte, i uint64, v uint64) {
+	offset := (i % uint64sPerChunk) * 8
+	binary.LittleEndian.PutUint64(chunk[offset:offset+8], v)
+}
+
+// depthOf returns the depth of the tree of a list with the given limit of
+// chunks.
+func depthOf(limit uint64) uint8 {
+	var depth uint8
+	for uint64(1)<<depth < limit {
+		depth++
+	}
+	return depth
+}
+
+// merkleizeFields returns the hash tree root of a container with the given
+// field roots.
+func merkleizeFields(roots *[numFields][32]byte) [32]byte {
+	var (
+		layer = roots[:]
+		pair  [64]byte
+	)
+	for len(layer) > 1 {
+		//nolint:mnd // a node has two children.
+		parents := make([][32]byte, (len(layer)+1)/2)
+		for i := range parents {
+			copy(pair[:32], layer[2*i][:])
+			if 2*i+1 < len(layer) {
+				copy(pair[32:], layer[2*i+1][:])
+			} else {
+				copy(pair[32:], zero.Hashes[0][:])
+			}
+			parents[i] = sha256.Sum256(pair[:])
+		}
+		layer = parents
+	}
+	return layer[0]
+}
+
+// hashTreeRoot returns the hash tree root of a value, or the error reading
+// it.
+func hashTreeRoot[T interface{ HashTreeRoot() ([32]byte, error) }](
+	v T, err error,
+) ([32]byte, error) {
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return v.HashTreeRoot()
+}
+
+// uint64Root returns the hash tree root of a uint64, or the error reading
+// it.
+func uint64Root(v uint64, err error) ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:8], v)
+	return root, err
+}
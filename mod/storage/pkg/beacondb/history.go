@@ -31,6 +31,7 @@ func (kv *KVStore[
 	index uint64,
 	root primitives.Root,
 ) error {
+	kv.changed(blockRootsField, index)
 	return kv.blockRoots.Set(kv.ctx, index, root[:])
 }
 
@@ -53,7 +54,12 @@ func (kv *KVStore[
 ]) SetLatestBlockHeader(
 	header BeaconBlockHeaderT,
 ) error {
-	return kv.latestBlockHeader.Set(kv.ctx, header)
+	kv.changed(latestBlockHeaderField, 0)
+	if err := kv.latestBlockHeader.Set(kv.ctx, header); err != nil {
+		return err
+	}
+	kv.rekeyed()
+	return nil
 }
 
 // GetLatestBlockHeader retrieves the latest block header from the BeaconStore.
@@ -72,6 +78,7 @@ func (kv *KVStore[
 	idx uint64,
 	stateRoot primitives.Root,
 ) error {
+	kv.changed(stateRootsField, idx)
 	return kv.stateRoots.Set(kv.ctx, idx, stateRoot[:])
 }
 
@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"slices"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// ErrUnknownCollection is returned when a collection is not part of the
+// store.
+var ErrUnknownCollection = errors.New("unknown collection")
+
+// Collections returns the human-readable names of all the collections of the
+// store, as defined in the keys package.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) Collections() []string {
+	colls := kv.schema.ListCollections()
+	names := make([]string, len(colls))
+	for i, coll := range colls {
+		names[i] = coll.GetName()
+	}
+	return names
+}
+
+// ExportCollection writes the contents of the collection with the given
+// human-readable name to w as JSON.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) ExportCollection(name string, w io.Writer) error {
+	idx := slices.IndexFunc(
+		kv.schema.ListCollections(),
+		func(coll sdkcollections.Collection) bool {
+			return coll.GetName() == name
+		},
+	)
+	if idx < 0 {
+		return fmt.Errorf("%w: %s", ErrUnknownCollection, name)
+	}
+	prefix := kv.schema.ListCollections()[idx].GetPrefix()
+
+	// The payload header codec needs the fork version of the stored header.
+	if version, err := kv.latestExecutionPayloadVersion.Get(
+		kv.ctx,
+	); err == nil {
+		kv.latestExecutionPayloadCodec.SetActiveForkVersion(version)
+	}
+
+	// The schema exports every collection, so the export is restricted to
+	// the requested collection, the others read as empty and are discarded.
+	return kv.schema.ExportGenesis(
+		context.WithValue(kv.ctx, collectionKey{}, prefix),
+		func(field string) (io.WriteCloser, error) {
+			if field != name {
+				return nopWriteCloser{io.Discard}, nil
+			}
+			return nopWriteCloser{w}, nil
+		},
+	)
+}
+
+// nopWriteCloser is an io.WriteCloser with a no-op Close.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+// Close implements io.Closer.
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+// collectionKey is the context key of the prefix of the collection the
+// store of the context is restricted to.
+type collectionKey struct{}
+
+// collectionStore is a store restricted to the keys of a collection. The
+// keys of the other collections read as missing, and their ranges as empty,
+// so they are never read from the underlying store.
+type collectionStore struct {
+	store.KVStore
+	// prefix is the prefix of the collection.
+	prefix []byte
+}
+
+// Get returns the value of the key if it belongs to the collection.
+func (s collectionStore) Get(key []byte) ([]byte, error) {
+	if !bytes.HasPrefix(key, s.prefix) {
+		return nil, nil
+	}
+	return s.KVStore.Get(key)
+}
+
+// Has returns whether the key is set and belongs to the collection.
+func (s collectionStore) Has(key []byte) (bool, error) {
+	if !bytes.HasPrefix(key, s.prefix) {
+		return false, nil
+	}
+	return s.KVStore.Has(key)
+}
+
+// Iterator iterates over [start, end) if it is a range of the collection.
+// Collections only iterate over their own prefix, and no prefix is the
+// prefix of another, so other ranges are empty.
+func (s collectionStore) Iterator(start, end []byte) (store.Iterator, error) {
+	if !bytes.HasPrefix(start, s.prefix) {
+		return emptyIterator{start: start, end: end}, nil
+	}
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator iterates over [start, end) in reverse if it is a range of
+// the collection.
+func (s collectionStore) ReverseIterator(
+	start, end []byte,
+) (store.Iterator, error) {
+	if !bytes.HasPrefix(start, s.prefix) {
+		return emptyIterator{start: start, end: end}, nil
+	}
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// emptyIterator is an iterator over an empty range.
+type emptyIterator struct {
+	start, end []byte
+}
+
+// Domain returns the range of the iterator.
+func (it emptyIterator) Domain() ([]byte, []byte) { return it.start, it.end }
+
+// Valid returns false, as the iterator is empty.
+func (emptyIterator) Valid() bool { return false }
+
+// Next is a no-op.
+func (emptyIterator) Next() {}
+
+// Key returns nil.
+func (emptyIterator) Key() []byte { return nil }
+
+// Value returns nil.
+func (emptyIterator) Value() []byte { return nil }
+
+// Error returns nil.
+func (emptyIterator) Error() error { return nil }
+
+// Close is a no-op.
+func (emptyIterator) Close() error { return nil }
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package beacondb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectionStore(t *testing.T) {
+	parent := newOverlay(emptyStore{})
+	for _, key := range []string{"a1", "a2", "b1"} {
+		require.NoError(t, parent.Set([]byte(key), []byte(key)))
+	}
+	s := collectionStore{KVStore: parent, prefix: []byte("a")}
+
+	value, err := s.Get([]byte("a1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("a1"), value)
+	value, err = s.Get([]byte("b1"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+	has, err := s.Has([]byte("b1"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	it, err := s.Iterator([]byte("a"), []byte("b"))
+	require.Equal(t, []string{"a1=a1", "a2=a2"}, collect(t, it, err))
+	it, err = s.ReverseIterator([]byte("a"), []byte("b"))
+	require.Equal(t, []string{"a2=a2", "a1=a1"}, collect(t, it, err))
+	it, err = s.Iterator([]byte("b"), []byte("c"))
+	require.Empty(t, collect(t, it, err))
+}
@@ -25,6 +25,7 @@ import (
 
 	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/index"
@@ -47,6 +48,9 @@ type KVStore[
 ] struct {
 	ctx   context.Context
 	write func()
+	// kss opens the store of a context, which is the overlay of the context
+	// for copies of the store.
+	kss store.KVStoreService
 	// Versioning
 	// genesisValidatorsRoot is the root of the genesis validators.
 	genesisValidatorsRoot sdkcollections.Item[[]byte]
@@ -97,6 +101,14 @@ type KVStore[
 	slashings sdkcollections.Map[uint64, uint64]
 	// totalSlashing stores the total slashing in the vector range.
 	totalSlashing sdkcollections.Item[uint64]
+	// schema is the schema of all the collections above.
+	schema sdkcollections.Schema
+	// hashes tracks the changes made through the store to hash the state
+	// incrementally.
+	hashes *hashCache
+	// snapshots are the snapshots of the recently hashed states, shared by
+	// all the stores.
+	snapshots *snapshots
 }
 
 // Store creates a new instance of Store.
@@ -115,15 +127,20 @@ func New[
 ](
 	kss store.KVStoreService,
 	payloadCodec *encoding.SSZInterfaceCodec[ExecutionPayloadHeaderT],
-) *KVStore[
+) (*KVStore[
 	ForkT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, Eth1DataT, ValidatorT,
-] {
+], error) {
+	// The collections open the overlay of the context when they are read or
+	// written through a copy of the store.
+	kss = kvStoreService{kss}
 	schemaBuilder := sdkcollections.NewSchemaBuilder(kss)
-	return &KVStore[
+	kv := &KVStore[
 		ForkT, BeaconBlockHeaderT,
 		ExecutionPayloadHeaderT, Eth1DataT, ValidatorT,
 	]{
-		ctx: nil,
+		ctx:       nil,
+		kss:       kss,
+		snapshots: newSnapshots(),
 		genesisValidatorsRoot: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{keys.GenesisValidatorsRootPrefix}),
@@ -248,21 +265,61 @@ func New[
 			encoding.SSZValueCodec[BeaconBlockHeaderT]{},
 		),
 	}
+
+	// Building the schema fails on duplicate prefixes or names.
+	schema, err := schemaBuilder.Build()
+	if err != nil {
+		return nil, err
+	}
+	kv.schema = schema
+	return kv, nil
 }
 
-// Copy returns a copy of the Store.
+// Copy returns a copy of the Store, which is forked in memory from the
+// Store and only written back to it when saved. Copies are cheap, and a
+// discarded copy is simply dropped.
 func (kv *KVStore[
 	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
 ]) Copy() *KVStore[
 	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
 ] {
-	// TODO: Decouple the KVStore type from the Cosmos-SDK.
-	cctx, write := sdk.UnwrapSDKContext(kv.ctx).CacheContext()
-	ss := kv.WithContext(cctx)
-	ss.write = write
+	parent := kv.ctx
+	if _, ok := parent.Value(overlayKey{}).(*overlay); !ok {
+		// Copies read the store of the context concurrently, each with its
+		// own gas meter, since gas meters are not safe for concurrent use.
+		//
+		// TODO: Decouple the KVStore type from the Cosmos-SDK.
+		parent = sdk.UnwrapSDKContext(parent).
+			WithGasMeter(storetypes.NewInfiniteGasMeter())
+	}
+	o := newOverlay(kv.kss.OpenKVStore(parent))
+	ss := kv.WithContext(context.WithValue(kv.ctx, overlayKey{}, o))
+	ss.write = func() {
+		// Writes to the parent only fail if the parent is unusable, as for
+		// the cache context this replaces.
+		if err := o.flush(); err != nil {
+			panic(err)
+		}
+	}
+	if kv.hashes != nil {
+		ss.hashes = kv.hashes.branch()
+	}
 	return ss
 }
 
+// Fork returns a copy of an in-memory fork of the Store, to transition a
+// state that may or may not be finalized. Saving the copy writes its
+// changes to the fork, which is never written back to the Store, rather
+// than to the Store. The changes held by the copy and its fork are written
+// to a store of the same state with Apply.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) Fork() *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+] {
+	return kv.Copy().Copy()
+}
+
 // Context returns the context of the Store.
 func (kv *KVStore[
 	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
@@ -280,6 +337,7 @@ func (kv *KVStore[
 ] {
 	cpy := *kv
 	cpy.ctx = ctx
+	cpy.hashes = new(hashCache)
 	return &cpy
 }
 
@@ -289,5 +347,36 @@ func (kv *KVStore[
 ]) Save() {
 	if kv.write != nil {
 		kv.write()
+		// The parent is now at the state of the store, its changes from now
+		// on belong to the journals of that state.
+		if parent := kv.hashes.save(); parent != nil {
+			kv.watch(parent, parent.len())
+		}
+	}
+}
+
+// Apply writes the changes made through the given copy of the Store, or of
+// a store of the same state, to the Store, which then starts from the trees
+// of the copy when hashed. The Store must not have been changed since the
+// copy was made.
+func (kv *KVStore[
+	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+]) Apply(
+	cpy *KVStore[
+		ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
+	],
+) error {
+	o, ok := cpy.ctx.Value(overlayKey{}).(*overlay)
+	if !ok {
+		return ErrNotACopy
 	}
+	if err := o.writeTo(kv.kss.OpenKVStore(kv.ctx)); err != nil {
+		return err
+	}
+
+	// The changes made through the Store from now on are made to the state
+	// of the copy.
+	kv.hashes = cpy.hashes.detach()
+	kv.watch(kv.hashes, 0)
+	return nil
 }
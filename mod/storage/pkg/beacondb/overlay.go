@@ -0,0 +1,327 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"sync"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// ErrNotACopy is returned when the changes of a store that is not a copy
+// are applied to another store.
+var ErrNotACopy = errors.New("store is not a copy")
+
+// overlayKey is the context key of the overlay a copy of the store reads
+// from and writes to.
+type overlayKey struct{}
+
+// kvStoreService opens the overlay of the context, if any, instead of the
+// store of the wrapped service, restricted to the collection of the context
+// if any.
+type kvStoreService struct {
+	store.KVStoreService
+}
+
+// OpenKVStore returns the overlay of the context or, if there is none, the
+// store of the wrapped service. If the context is restricted to a
+// collection, the keys of the other collections read as missing.
+func (s kvStoreService) OpenKVStore(ctx context.Context) store.KVStore {
+	var kvs store.KVStore
+	if o, ok := ctx.Value(overlayKey{}).(*overlay); ok {
+		kvs = o
+	} else {
+		kvs = s.KVStoreService.OpenKVStore(ctx)
+	}
+	if prefix, ok := ctx.Value(collectionKey{}).([]byte); ok {
+		return collectionStore{KVStore: kvs, prefix: prefix}
+	}
+	return kvs
+}
+
+// overlay is an in-memory copy-on-write branch of a store. Reads fall
+// through to the parent for the keys it has not written, and its writes are
+// only applied to the parent when it is flushed. Dropping an overlay
+// discards its writes.
+type overlay struct {
+	parent store.KVStore
+	mu     sync.RWMutex
+	// writes are the values written to the overlay, nil for deleted keys.
+	writes map[string][]byte
+}
+
+// newOverlay returns an empty overlay of the given store.
+func newOverlay(parent store.KVStore) *overlay {
+	return &overlay{
+		parent: parent,
+		writes: make(map[string][]byte),
+	}
+}
+
+// Get returns the value of the key, nil if it is not set. The value
+// returned is a copy, as the caller may modify it.
+func (o *overlay) Get(key []byte) ([]byte, error) {
+	o.mu.RLock()
+	value, ok := o.writes[string(key)]
+	o.mu.RUnlock()
+	if ok {
+		return slices.Clone(value), nil
+	}
+	return o.parent.Get(key)
+}
+
+// Has returns true if the key is set.
+func (o *overlay) Has(key []byte) (bool, error) {
+	o.mu.RLock()
+	value, ok := o.writes[string(key)]
+	o.mu.RUnlock()
+	if ok {
+		return value != nil, nil
+	}
+	return o.parent.Has(key)
+}
+
+// Set sets the value of the key in the overlay.
+func (o *overlay) Set(key, value []byte) error {
+	if value == nil {
+		value = []byte{}
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.writes[string(key)] = slices.Clone(value)
+	return nil
+}
+
+// Delete deletes the key in the overlay.
+func (o *overlay) Delete(key []byte) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.writes[string(key)] = nil
+	return nil
+}
+
+// Iterator returns an iterator over the keys in [start, end) in ascending
+// order.
+func (o *overlay) Iterator(start, end []byte) (store.Iterator, error) {
+	return o.iterator(start, end, false)
+}
+
+// ReverseIterator returns an iterator over the keys in [start, end) in
+// descending order.
+func (o *overlay) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	return o.iterator(start, end, true)
+}
+
+// iterator returns an iterator that merges the keys written to the overlay
+// in [start, end), as of now, with the keys of the parent.
+func (o *overlay) iterator(
+	start, end []byte, reverse bool,
+) (store.Iterator, error) {
+	var (
+		parent store.Iterator
+		err    error
+	)
+	if reverse {
+		parent, err = o.parent.ReverseIterator(start, end)
+	} else {
+		parent, err = o.parent.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	it := &overlayIterator{
+		parent:  parent,
+		start:   start,
+		end:     end,
+		reverse: reverse,
+	}
+	o.mu.RLock()
+	for key := range o.writes {
+		if (start == nil || key >= string(start)) &&
+			(end == nil || key < string(end)) {
+			it.keys = append(it.keys, key)
+		}
+	}
+	slices.Sort(it.keys)
+	if reverse {
+		slices.Reverse(it.keys)
+	}
+	it.values = make([][]byte, len(it.keys))
+	for i, key := range it.keys {
+		it.values[i] = o.writes[key]
+	}
+	o.mu.RUnlock()
+
+	it.next()
+	return it, nil
+}
+
+// flush applies the writes of the overlay to its parent, and empties it.
+func (o *overlay) flush() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if err := o.write(o.parent); err != nil {
+		return err
+	}
+	clear(o.writes)
+	return nil
+}
+
+// writeTo writes the writes of the overlay, and of the overlays it is a
+// branch of, to the given store, oldest first.
+func (o *overlay) writeTo(dst store.KVStore) error {
+	if parent, ok := o.parent.(*overlay); ok {
+		if err := parent.writeTo(dst); err != nil {
+			return err
+		}
+	}
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.write(dst)
+}
+
+// write writes the writes of the overlay to the given store. The caller
+// must hold the lock.
+func (o *overlay) write(dst store.KVStore) error {
+	keys := make([]string, 0, len(o.writes))
+	for key := range o.writes {
+		keys = append(keys, key)
+	}
+	// Write in order, so that writing is deterministic.
+	slices.Sort(keys)
+	for _, key := range keys {
+		var err error
+		if value := o.writes[key]; value == nil {
+			err = dst.Delete([]byte(key))
+		} else {
+			err = dst.Set([]byte(key), value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// overlayIterator merges the keys written to an overlay with the keys of its
+// parent, the former taking precedence.
+type overlayIterator struct {
+	parent     store.Iterator
+	start, end []byte
+	reverse    bool
+	// keys and values are the writes of the overlay in iteration order.
+	keys   []string
+	values [][]byte
+	// key and value are the current entry of the iterator.
+	key, value []byte
+	valid      bool
+}
+
+// Domain returns the range of the iterator.
+func (it *overlayIterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+// Valid returns true if the iterator is at an entry.
+func (it *overlayIterator) Valid() bool {
+	return it.valid
+}
+
+// Next moves the iterator to the next entry.
+func (it *overlayIterator) Next() {
+	if !it.valid {
+		panic("overlay iterator is invalid")
+	}
+	it.next()
+}
+
+// Key returns the key of the current entry.
+func (it *overlayIterator) Key() []byte {
+	return it.key
+}
+
+// Value returns the value of the current entry.
+func (it *overlayIterator) Value() []byte {
+	return it.value
+}
+
+// Error returns the error of the parent iterator.
+func (it *overlayIterator) Error() error {
+	return it.parent.Error()
+}
+
+// Close closes the parent iterator.
+func (it *overlayIterator) Close() error {
+	return it.parent.Close()
+}
+
+// next moves to the next entry that is not deleted in the overlay.
+func (it *overlayIterator) next() {
+	for {
+		parentValid := it.parent.Valid()
+		if len(it.keys) == 0 && !parentValid {
+			it.valid = false
+			it.key, it.value = nil, nil
+			return
+		}
+
+		// Take the entry of the parent if it comes first, and the one of the
+		// overlay otherwise, skipping the shadowed entry of the parent.
+		if len(it.keys) > 0 && parentValid {
+			cmp := bytes.Compare([]byte(it.keys[0]), it.parent.Key())
+			if it.reverse {
+				cmp = -cmp
+			}
+			if cmp > 0 {
+				it.takeParent()
+				return
+			} else if cmp == 0 {
+				it.parent.Next()
+			}
+		} else if parentValid {
+			it.takeParent()
+			return
+		}
+
+		key, value := it.keys[0], it.values[0]
+		it.keys, it.values = it.keys[1:], it.values[1:]
+		if value != nil {
+			it.valid = true
+			it.key, it.value = []byte(key), slices.Clone(value)
+			return
+		}
+	}
+}
+
+// takeParent makes the current entry of the parent the current entry of the
+// iterator, and moves the parent to its next entry. The entry is copied, as
+// the parent may reuse its buffers once it moves.
+func (it *overlayIterator) takeParent() {
+	it.valid = true
+	it.key = slices.Clone(it.parent.Key())
+	it.value = slices.Clone(it.parent.Value())
+	it.parent.Next()
+}
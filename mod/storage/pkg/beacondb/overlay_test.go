@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"testing"
+
+	"cosmossdk.io/core/store"
+	"github.com/stretchr/testify/require"
+)
+
+// emptyStore is a store without keys.
+type emptyStore struct{ store.KVStore }
+
+func (emptyStore) Get([]byte) ([]byte, error) { return nil, nil }
+
+func (emptyStore) Iterator(start, end []byte) (store.Iterator, error) {
+	return &overlayIterator{parent: emptyIterator{}, start: start, end: end}, nil
+}
+
+func (s emptyStore) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	return s.Iterator(start, end)
+}
+
+// collect returns the entries of the iterator.
+func collect(t *testing.T, it store.Iterator, err error) []string {
+	t.Helper()
+	require.NoError(t, err)
+	defer func() { require.NoError(t, it.Close()) }()
+	var entries []string
+	for ; it.Valid(); it.Next() {
+		entries = append(entries, string(it.Key())+"="+string(it.Value()))
+	}
+	return entries
+}
+
+func TestOverlay(t *testing.T) {
+	parent := newOverlay(emptyStore{})
+	for _, key := range []string{"a", "c", "e", "g"} {
+		require.NoError(t, parent.Set([]byte(key), []byte(key)))
+	}
+
+	o := newOverlay(parent)
+	require.NoError(t, o.Set([]byte("b"), []byte("B")))
+	require.NoError(t, o.Set([]byte("c"), []byte("C")))
+	require.NoError(t, o.Delete([]byte("e")))
+	require.NoError(t, o.Set([]byte("h"), []byte{}))
+	require.NoError(t, o.Delete([]byte("z")))
+
+	value, err := o.Get([]byte("c"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("C"), value)
+	has, err := o.Has([]byte("e"))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = o.Has([]byte("g"))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	it, err := o.Iterator(nil, nil)
+	require.Equal(t,
+		[]string{"a=a", "b=B", "c=C", "g=g", "h="}, collect(t, it, err),
+	)
+	it, err = o.ReverseIterator(nil, nil)
+	require.Equal(t,
+		[]string{"h=", "g=g", "c=C", "b=B", "a=a"}, collect(t, it, err),
+	)
+	it, err = o.Iterator([]byte("b"), []byte("g"))
+	require.Equal(t, []string{"b=B", "c=C"}, collect(t, it, err))
+	it, err = o.ReverseIterator([]byte("c"), []byte("h"))
+	require.Equal(t, []string{"g=g", "c=C"}, collect(t, it, err))
+
+	// The parent is unchanged until the overlay is flushed.
+	it, err = parent.Iterator(nil, nil)
+	require.Equal(t,
+		[]string{"a=a", "c=c", "e=e", "g=g"}, collect(t, it, err),
+	)
+	require.NoError(t, o.flush())
+	it, err = parent.Iterator(nil, nil)
+	require.Equal(t,
+		[]string{"a=a", "b=B", "c=C", "g=g", "h="}, collect(t, it, err),
+	)
+	require.Empty(t, o.writes)
+}
+
+// reusingStore is a store whose iterators reuse their key and value buffers
+// across entries, as those of some databases do.
+type reusingStore struct{ *overlay }
+
+func (s reusingStore) Iterator(start, end []byte) (store.Iterator, error) {
+	it, err := s.overlay.Iterator(start, end)
+	return &reusingIterator{Iterator: it}, err
+}
+
+// reusingIterator copies the entries of the wrapped iterator into buffers it
+// reuses.
+type reusingIterator struct {
+	store.Iterator
+	key, value []byte
+}
+
+func (it *reusingIterator) Key() []byte {
+	it.key = append(it.key[:0], it.Iterator.Key()...)
+	return it.key
+}
+
+func (it *reusingIterator) Value() []byte {
+	it.value = append(it.value[:0], it.Iterator.Value()...)
+	return it.value
+}
+
+func TestOverlay_Copies(t *testing.T) {
+	parent := newOverlay(emptyStore{})
+	for _, key := range []string{"a", "b"} {
+		require.NoError(t, parent.Set([]byte(key), []byte(key)))
+	}
+	o := newOverlay(reusingStore{parent})
+	require.NoError(t, o.Set([]byte("c"), []byte("c")))
+
+	// The entries of the parent are not overwritten as it moves on.
+	it, err := o.Iterator(nil, nil)
+	require.NoError(t, err)
+	key, value := it.Key(), it.Value()
+	it.Next()
+	require.Equal(t, []byte("a"), key)
+	require.Equal(t, []byte("a"), value)
+	require.NoError(t, it.Close())
+
+	// Modifying a value read does not modify the overlay.
+	value, err = o.Get([]byte("c"))
+	require.NoError(t, err)
+	value[0] = 'x'
+	value, err = o.Get([]byte("c"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("c"), value)
+}
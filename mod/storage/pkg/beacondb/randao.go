@@ -31,6 +31,7 @@ func (kv *KVStore[
 	index uint64,
 	mix primitives.Bytes32,
 ) error {
+	kv.changed(randaoMixesField, index)
 	return kv.randaoMix.Set(kv.ctx, index, mix[:])
 }
 
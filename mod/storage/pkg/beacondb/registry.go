@@ -39,11 +39,13 @@ func (kv *KVStore[
 	}
 
 	// Push onto the validators list.
+	kv.changed(validatorsField, idx)
 	if err = kv.validators.Set(kv.ctx, idx, val); err != nil {
 		return err
 	}
 
 	// Push onto the balances list.
+	kv.changed(balancesField, idx)
 	return kv.balances.Set(kv.ctx, idx, uint64(val.GetEffectiveBalance()))
 }
 
@@ -54,6 +56,7 @@ func (kv *KVStore[
 	index math.ValidatorIndex,
 	val ValidatorT,
 ) error {
+	kv.changed(validatorsField, uint64(index))
 	return kv.validators.Set(kv.ctx, uint64(index), val)
 }
 
@@ -63,6 +66,7 @@ func (kv *KVStore[
 ]) RemoveValidatorAtIndex(
 	idx math.ValidatorIndex,
 ) error {
+	kv.changed(validatorsField, allIndices)
 	return kv.validators.Remove(kv.ctx, uint64(idx))
 }
 
@@ -203,6 +207,7 @@ func (kv *KVStore[
 	idx math.ValidatorIndex,
 	balance math.Gwei,
 ) error {
+	kv.changed(balancesField, uint64(idx))
 	return kv.balances.Set(kv.ctx, uint64(idx), uint64(balance))
 }
 
@@ -68,6 +68,7 @@ func (kv *KVStore[
 	index uint64,
 	amount math.Gwei,
 ) error {
+	kv.changed(slashingsField, index)
 	return kv.slashings.Set(kv.ctx, index, uint64(amount))
 }
 
@@ -90,5 +91,6 @@ func (kv *KVStore[
 ]) SetTotalSlashing(
 	amount math.Gwei,
 ) error {
+	kv.changed(totalSlashingField, 0)
 	return kv.totalSlashing.Set(kv.ctx, uint64(amount))
 }
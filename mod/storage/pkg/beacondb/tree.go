@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"encoding/binary"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+	sha256 "github.com/minio/sha256-simd"
+)
+
+// listTree is the Merkle tree of the chunks of an SSZ list. Its nodes are
+// kept, so that updating a chunk only rehashes the branch of that chunk.
+type listTree struct {
+	// layers are the nodes of the tree, from the chunks up to the layer of
+	// a single node. The nodes above it are the zero hashes that pad the
+	// tree to its depth.
+	layers [][][32]byte
+	// depth is the depth of the tree given by the limit of the list.
+	depth uint8
+	// length is the number of elements of the list.
+	length uint64
+	// dirty are the indices of the chunks updated since the root was last
+	// computed.
+	dirty map[uint64]struct{}
+}
+
+// newListTree builds the Merkle tree of the given chunks of a list with the
+// given number of elements.
+func newListTree(
+	chunks [][32]byte, length uint64, depth uint8,
+) (*listTree, error) {
+	t := &listTree{
+		layers: [][][32]byte{chunks},
+		depth:  depth,
+		length: length,
+		dirty:  make(map[uint64]struct{}),
+	}
+	for d := 0; len(t.layers[d]) > 1; d++ {
+		layer := t.layers[d]
+		//nolint:mnd // a node has two children.
+		if len(layer)%2 == 1 {
+			layer = append(slices.Clip(layer), zero.Hashes[d])
+		}
+		parents, err := merkle.BuildParentTreeRoots[[32]byte, [32]byte](layer)
+		if err != nil {
+			return nil, err
+		}
+		t.layers = append(t.layers, parents)
+	}
+	return t, nil
+}
+
+// Copy returns a deep copy of the tree.
+func (t *listTree) Copy() *listTree {
+	cpy := &listTree{
+		layers: make([][][32]byte, len(t.layers)),
+		depth:  t.depth,
+		length: t.length,
+		dirty:  make(map[uint64]struct{}, len(t.dirty)),
+	}
+	for d, layer := range t.layers {
+		cpy.layers[d] = slices.Clone(layer)
+	}
+	for i := range t.dirty {
+		cpy.dirty[i] = struct{}{}
+	}
+	return cpy
+}
+
+// NumChunks returns the number of chunks of the tree.
+func (t *listTree) NumChunks() uint64 {
+	return uint64(len(t.layers[0]))
+}
+
+// SetChunk sets the chunk at the given index, which appends a chunk if it
+// is the number of chunks.
+func (t *listTree) SetChunk(i uint64, chunk [32]byte) {
+	if i == t.NumChunks() {
+		t.layers[0] = append(t.layers[0], chunk)
+	} else {
+		t.layers[0][i] = chunk
+	}
+	t.dirty[i] = struct{}{}
+}
+
+// HashTreeRoot rehashes the branches of the updated chunks and returns the
+// root of the list, with its length mixed in.
+func (t *listTree) HashTreeRoot() [32]byte {
+	var (
+		dirty = make([]uint64, 0, len(t.dirty))
+		pair  [64]byte
+	)
+	for i := range t.dirty {
+		dirty = append(dirty, i)
+	}
+	slices.Sort(dirty)
+	clear(t.dirty)
+
+	for d := 0; len(t.layers[d]) > 1; d++ {
+		layer := t.layers[d]
+		if d+1 == len(t.layers) {
+			t.layers = append(t.layers, nil)
+		}
+		//nolint:mnd // a node has two children.
+		numParents := (len(layer) + 1) / 2
+		for len(t.layers[d+1]) < numParents {
+			t.layers[d+1] = append(t.layers[d+1], [32]byte{})
+		}
+
+		parents := dirty[:0]
+		for _, i := range dirty {
+			//nolint:mnd // a node has two children.
+			p := i / 2
+			if len(parents) > 0 && parents[len(parents)-1] == p {
+				continue
+			}
+			parents = append(parents, p)
+
+			copy(pair[:32], layer[2*p][:])
+			if 2*p+1 < uint64(len(layer)) {
+				copy(pair[32:], layer[2*p+1][:])
+			} else {
+				copy(pair[32:], zero.Hashes[d][:])
+			}
+			t.layers[d+1][p] = sha256.Sum256(pair[:])
+		}
+		dirty = parents
+	}
+
+	// Pad the root of the chunks with zero hashes up to the depth of the
+	// tree and mix in the length of the list.
+	top := len(t.layers) - 1
+	root := zero.Hashes[top]
+	if len(t.layers[top]) > 0 {
+		root = t.layers[top][0]
+	}
+	for d := top; d < int(t.depth); d++ {
+		copy(pair[:32], root[:])
+		copy(pair[32:], zero.Hashes[d][:])
+		root = sha256.Sum256(pair[:])
+	}
+	copy(pair[:32], root[:])
+	clear(pair[32:])
+	binary.LittleEndian.PutUint64(pair[32:], t.length)
+	return sha256.Sum256(pair[:])
+}
@@ -32,6 +32,7 @@ func (kv *KVStore[
 ]) SetGenesisValidatorsRoot(
 	root primitives.Root,
 ) error {
+	kv.changed(genesisValidatorsRootField, 0)
 	return kv.genesisValidatorsRoot.Set(kv.ctx, root[:])
 }
 
@@ -61,5 +62,10 @@ func (kv *KVStore[
 ]) SetSlot(
 	slot math.Slot,
 ) error {
-	return kv.slot.Set(kv.ctx, uint64(slot))
+	kv.changed(slotField, 0)
+	if err := kv.slot.Set(kv.ctx, uint64(slot)); err != nil {
+		return err
+	}
+	kv.rekeyed()
+	return nil
 }
@@ -35,6 +35,7 @@ func (kv *KVStore[
 ]) SetNextWithdrawalIndex(
 	index uint64,
 ) error {
+	kv.changed(nextWithdrawalIndexField, 0)
 	return kv.nextWithdrawalIndex.Set(kv.ctx, index)
 }
 
@@ -54,5 +55,6 @@ func (kv *KVStore[
 ]) SetNextWithdrawalValidatorIndex(
 	index math.ValidatorIndex,
 ) error {
+	kv.changed(nextWithdrawalValidatorIndexField, 0)
 	return kv.nextWithdrawalValidatorIndex.Set(kv.ctx, uint64(index))
 }
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// defaultRetentionSlots is the number of slots of blocks retained when no
+// retention is configured.
+const defaultRetentionSlots = 8192
+
+// BuildPruneRangeFn builds the function returning the range of slots to
+// prune on each finalized block.
+func BuildPruneRangeFn[
+	BeaconBlockT interface{ GetSlot() math.Slot },
+	BlockEventT BlockEvent[BeaconBlockT],
+](
+	retentionSlots uint64,
+) func(BlockEventT) (uint64, uint64) {
+	return func(event BlockEventT) (uint64, uint64) {
+		return PruneRange(retentionSlots, event.Data().GetSlot())
+	}
+}
+
+// AvailabilityWindow returns the number of slots before the head whose
+// blocks are retained given the configured retentionSlots.
+func AvailabilityWindow(retentionSlots uint64) uint64 {
+	if retentionSlots == 0 {
+		return defaultRetentionSlots
+	}
+	return retentionSlots
+}
+
+// PruneRange returns the [start, end) range of slots to prune given the
+// current head slot. The blocks of the last retentionSlots slots are
+// retained, or of the last defaultRetentionSlots slots if it is zero.
+func PruneRange(retentionSlots uint64, head math.Slot) (uint64, uint64) {
+	retentionSlots = AvailabilityWindow(retentionSlots)
+	if head.Unwrap() < retentionSlots {
+		return 0, 0
+	}
+	return 0, head.Unwrap() - retentionSlots
+}
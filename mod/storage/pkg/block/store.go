@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const KeyBlockPrefix = "block"
+
+const blockPrefix uint8 = iota
+
+// versionLength is the length of the fork version that prefixes the SSZ
+// encoding of every stored block.
+const versionLength = 4
+
+// KVStore stores the finalized beacon blocks by slot, so that their
+// execution payloads can be replayed to an execution client that fell behind.
+type KVStore[BeaconBlockT BeaconBlock[BeaconBlockT]] struct {
+	blocks sdkcollections.Map[uint64, []byte]
+	mu     sync.RWMutex
+}
+
+// NewStore creates a new block store.
+func NewStore[BeaconBlockT BeaconBlock[BeaconBlockT]](
+	kvsp store.KVStoreService,
+) *KVStore[BeaconBlockT] {
+	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+	return &KVStore[BeaconBlockT]{
+		blocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{blockPrefix}),
+			KeyBlockPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+	}
+}
+
+// Get returns the block stored for the given slot.
+func (kv *KVStore[BeaconBlockT]) Get(slot math.Slot) (BeaconBlockT, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	var blk BeaconBlockT
+	bz, err := kv.blocks.Get(context.TODO(), slot.Unwrap())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return blk, errors.Wrapf(ErrBlockNotFound, "slot %d", slot)
+	} else if err != nil {
+		return blk, err
+	}
+	if len(bz) < versionLength {
+		return blk, ErrInvalidBlock
+	}
+	return blk.NewFromSSZ(
+		bz[versionLength:], binary.BigEndian.Uint32(bz[:versionLength]),
+	)
+}
+
+// Set stores the given block under its slot.
+func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
+	bz, err := blk.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.blocks.Set(
+		context.TODO(),
+		blk.GetSlot().Unwrap(),
+		append(binary.BigEndian.AppendUint32(nil, blk.Version()), bz...),
+	)
+}
+
+// OldestSlot returns the slot of the oldest block stored.
+func (kv *KVStore[BeaconBlockT]) OldestSlot() (math.Slot, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	iter, err := kv.blocks.Iterate(context.TODO(), nil)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, ErrBlockNotFound
+	}
+	slot, err := iter.Key()
+	if err != nil {
+		return 0, err
+	}
+	return math.Slot(slot), nil
+}
+
+// Prune removes the blocks of the slots in the range [start, end).
+func (kv *KVStore[BeaconBlockT]) Prune(start, end uint64) error {
+	if start >= end {
+		return nil
+	}
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	iter, err := kv.blocks.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).
+			StartInclusive(start).
+			EndExclusive(end),
+	)
+	if err != nil {
+		return err
+	}
+	slots, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, slot := range slots {
+		if err = kv.blocks.Remove(context.TODO(), slot); err != nil {
+			return err
+		}
+	}
+	return nil
+}
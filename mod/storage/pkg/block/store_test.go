@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sort"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/stretchr/testify/require"
+)
+
+// testBlock is a minimal block used to exercise the store.
+type testBlock struct {
+	Slot    math.Slot
+	version uint32
+}
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(nil, b.Slot.Unwrap()), nil
+}
+
+func (b *testBlock) NewFromSSZ(bz []byte, version uint32) (*testBlock, error) {
+	return &testBlock{
+		Slot:    math.Slot(binary.LittleEndian.Uint64(bz)),
+		version: version,
+	}, nil
+}
+
+func (b *testBlock) Version() uint32 {
+	return b.version
+}
+
+func (b *testBlock) GetSlot() math.Slot {
+	return b.Slot
+}
+
+// memKVStore is an in-memory store.KVStore.
+type memKVStore map[string][]byte
+
+func (m memKVStore) Get(key []byte) ([]byte, error) {
+	return m[string(key)], nil
+}
+
+func (m memKVStore) Has(key []byte) (bool, error) {
+	_, ok := m[string(key)]
+	return ok, nil
+}
+
+func (m memKVStore) Set(key, value []byte) error {
+	m[string(key)] = value
+	return nil
+}
+
+func (m memKVStore) Delete(key []byte) error {
+	delete(m, string(key))
+	return nil
+}
+
+func (m memKVStore) Iterator(start, end []byte) (store.Iterator, error) {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		if (start == nil || bytes.Compare([]byte(key), start) >= 0) &&
+			(end == nil || bytes.Compare([]byte(key), end) < 0) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return &memIterator{store: m, keys: keys, start: start, end: end}, nil
+}
+
+func (m memKVStore) ReverseIterator(_, _ []byte) (store.Iterator, error) {
+	panic("not implemented")
+}
+
+// memIterator iterates over a snapshot of the keys of a memKVStore.
+type memIterator struct {
+	store      memKVStore
+	keys       []string
+	start, end []byte
+}
+
+func (it *memIterator) Domain() ([]byte, []byte) { return it.start, it.end }
+func (it *memIterator) Valid() bool              { return len(it.keys) > 0 }
+func (it *memIterator) Next()                    { it.keys = it.keys[1:] }
+func (it *memIterator) Key() []byte              { return []byte(it.keys[0]) }
+func (it *memIterator) Value() []byte            { return it.store[it.keys[0]] }
+func (it *memIterator) Error() error             { return nil }
+func (it *memIterator) Close() error             { return nil }
+
+type memKVStoreService struct {
+	store memKVStore
+}
+
+func (s memKVStoreService) OpenKVStore(context.Context) store.KVStore {
+	return s.store
+}
+
+func newTestStore(
+	t *testing.T,
+	slots ...math.Slot,
+) *block.KVStore[*testBlock] {
+	t.Helper()
+	bs := block.NewStore[*testBlock](memKVStoreService{store: memKVStore{}})
+	for _, slot := range slots {
+		require.NoError(t, bs.Set(&testBlock{Slot: slot, version: 4}))
+	}
+	return bs
+}
+
+func TestSetAndGet(t *testing.T) {
+	bs := newTestStore(t, 1, 2)
+
+	blk, err := bs.Get(2)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(2), blk.GetSlot())
+	require.Equal(t, uint32(4), blk.Version())
+
+	_, err = bs.Get(3)
+	require.ErrorIs(t, err, block.ErrBlockNotFound)
+}
+
+func TestPruneRemovesRange(t *testing.T) {
+	bs := newTestStore(t, 1, 2, 3, 4, 5)
+	require.NoError(t, bs.Prune(0, 4))
+
+	for _, slot := range []math.Slot{1, 2, 3} {
+		_, err := bs.Get(slot)
+		require.ErrorIs(t, err, block.ErrBlockNotFound)
+	}
+	for _, slot := range []math.Slot{4, 5} {
+		_, err := bs.Get(slot)
+		require.NoError(t, err)
+	}
+}
+
+func TestOldestSlot(t *testing.T) {
+	bs := newTestStore(t)
+	_, err := bs.OldestSlot()
+	require.ErrorIs(t, err, block.ErrBlockNotFound)
+
+	bs = newTestStore(t, 3, 4, 5)
+	slot, err := bs.OldestSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(3), slot)
+
+	require.NoError(t, bs.Prune(0, 5))
+	slot, err = bs.OldestSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(5), slot)
+}
+
+func TestPruneRange(t *testing.T) {
+	start, end := block.PruneRange(10, 5)
+	require.Zero(t, start)
+	require.Zero(t, end)
+
+	start, end = block.PruneRange(10, 25)
+	require.Zero(t, start)
+	require.Equal(t, uint64(15), end)
+
+	// A retention of zero falls back to the default retention.
+	_, end = block.PruneRange(0, 10_000)
+	require.Equal(t, uint64(10_000-8192), end)
+}
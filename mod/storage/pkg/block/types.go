@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// BeaconBlock is the interface of the blocks held by the store.
+type BeaconBlock[T any] interface {
+	// MarshalSSZ returns the SSZ encoding of the block.
+	MarshalSSZ() ([]byte, error)
+	// NewFromSSZ decodes a block of the given fork version.
+	NewFromSSZ([]byte, uint32) (T, error)
+	// Version returns the fork version of the block.
+	Version() uint32
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+}
+
+// BlockEvent is the interface of the events of the block feed.
+type BlockEvent[BeaconBlockT any] interface {
+	// Data returns the block of the event.
+	Data() BeaconBlockT
+}
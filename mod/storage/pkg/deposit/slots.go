@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+)
+
+var (
+	_ pruner.Prunable = (*SlotPrunable[Deposit])(nil)
+	_ pruner.Sizer    = (*SlotPrunable[Deposit])(nil)
+)
+
+// SlotPrunable prunes the deposits of a KVStore by the slots of the beacon
+// blocks that processed them, rather than by their index, using the deposit
+// indexes recorded through SetBlockDepositIndex.
+type SlotPrunable[DepositT Deposit] struct {
+	kv *KVStore[DepositT]
+}
+
+// NewSlotPrunable returns a SlotPrunable of the given store.
+func NewSlotPrunable[DepositT Deposit](
+	kv *KVStore[DepositT],
+) *SlotPrunable[DepositT] {
+	return &SlotPrunable[DepositT]{kv: kv}
+}
+
+// Prune removes the deposits processed by the beacon blocks of the slots
+// below end, along with the deposit indexes recorded for [start, end). The
+// latest deposit index recorded below end is kept, so that later prunes
+// still find it if no block is recorded in their range.
+func (p *SlotPrunable[DepositT]) Prune(start, end uint64) error {
+	p.kv.mu.Lock()
+	defer p.kv.mu.Unlock()
+	if start >= end {
+		return nil
+	}
+
+	slot, index, found, err := p.kv.lastBlockDepositIndex(end)
+	if err != nil || !found {
+		return err
+	}
+
+	first, found, err := p.kv.firstDepositIndex()
+	if err != nil {
+		return err
+	} else if found {
+		if err = p.kv.prune(first, index); err != nil {
+			return err
+		}
+	}
+
+	if start >= slot {
+		return nil
+	}
+	return p.kv.blockDepositIndexes.Clear(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).
+			StartInclusive(start).
+			EndExclusive(slot),
+	)
+}
+
+// Size returns the number of bytes held by the deposits processed by the
+// beacon blocks of the slots below end.
+func (p *SlotPrunable[DepositT]) Size(start, end uint64) (uint64, error) {
+	p.kv.mu.RLock()
+	defer p.kv.mu.RUnlock()
+	if start >= end {
+		return 0, nil
+	}
+
+	_, index, found, err := p.kv.lastBlockDepositIndex(end)
+	if err != nil || !found {
+		return 0, err
+	}
+
+	first, found, err := p.kv.firstDepositIndex()
+	if err != nil || !found {
+		return 0, err
+	}
+	return p.kv.size(first, index)
+}
+
+// lastBlockDepositIndex returns the latest slot below end a deposit index is
+// recorded for, and that index. The caller must hold the lock.
+func (kv *KVStore[DepositT]) lastBlockDepositIndex(
+	end uint64,
+) (uint64, uint64, bool, error) {
+	iter, err := kv.blockDepositIndexes.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).EndExclusive(end).Descending(),
+	)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, 0, false, nil
+	}
+	entry, err := iter.KeyValue()
+	if err != nil {
+		return 0, 0, false, err
+	}
+	return entry.Key, entry.Value, true, nil
+}
+
+// firstDepositIndex returns the index of the first deposit held by the
+// store. The caller must hold the lock.
+func (kv *KVStore[DepositT]) firstDepositIndex() (uint64, bool, error) {
+	iter, err := kv.store.Iterate(context.TODO(), nil)
+	if err != nil {
+		return 0, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, false, nil
+	}
+	index, err := iter.Key()
+	return index, err == nil, err
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+	sha256 "github.com/minio/sha256-simd"
+)
+
+// DepositContractDepth is the depth of the deposit contract merkle tree.
+const DepositContractDepth = 32
+
+// Snapshot is a deposit tree snapshot as defined in EIP-4881. It contains
+// the minimal set of finalized subtree roots required to rebuild the deposit
+// tree for all deposits with an index below DepositCount.
+type Snapshot struct {
+	// Finalized is the list of finalized subtree roots, ordered from the
+	// largest subtree to the smallest.
+	Finalized []common.Root
+	// DepositRoot is the root of the deposit tree, mixed in with the
+	// number of deposits.
+	DepositRoot common.Root
+	// DepositCount is the number of deposits covered by the snapshot.
+	DepositCount uint64
+	// ExecutionBlockHash is the hash of the execution block associated
+	// with the snapshot.
+	ExecutionBlockHash common.ExecutionHash
+	// ExecutionBlockHeight is the height of the execution block associated
+	// with the snapshot.
+	ExecutionBlockHeight math.U64
+}
+
+// snapshotTree is an incremental merkle tree of the deposit contract, as
+// maintained by the deposit contract itself. It only ever holds a single
+// branch, which is all that is needed to keep appending leaves and to
+// produce an EIP-4881 snapshot.
+type snapshotTree struct {
+	branch [DepositContractDepth]common.Root
+	count  uint64
+}
+
+// push appends a leaf to the tree.
+func (t *snapshotTree) push(leaf common.Root) {
+	t.count++
+	node := leaf
+	for height := range DepositContractDepth {
+		if (t.count>>height)&1 == 1 {
+			t.branch[height] = node
+			return
+		}
+		node = hashPair(t.branch[height], node)
+	}
+}
+
+// root returns the deposit root, mixed in with the number of deposits.
+func (t *snapshotTree) root() common.Root {
+	var node common.Root
+	for height := range DepositContractDepth {
+		if (t.count>>height)&1 == 1 {
+			node = hashPair(t.branch[height], node)
+		} else {
+			node = hashPair(node, zero.Hashes[height])
+		}
+	}
+	return merkle.MixinLength(node, t.count)
+}
+
+// finalized returns the roots of the full subtrees covering every leaf in
+// the tree, ordered from the largest subtree to the smallest.
+func (t *snapshotTree) finalized() []common.Root {
+	finalized := make([]common.Root, 0, DepositContractDepth)
+	for height := DepositContractDepth - 1; height >= 0; height-- {
+		if (t.count>>height)&1 == 1 {
+			finalized = append(finalized, t.branch[height])
+		}
+	}
+	return finalized
+}
+
+// marshalBranch encodes the branch of the tree into a flat byte slice.
+func (t *snapshotTree) marshalBranch() []byte {
+	bz := make([]byte, 0, DepositContractDepth*len(common.Root{}))
+	for _, node := range t.branch {
+		bz = append(bz, node[:]...)
+	}
+	return bz
+}
+
+// unmarshalBranch decodes a flat byte slice into the branch of the tree.
+func (t *snapshotTree) unmarshalBranch(bz []byte) error {
+	if len(bz) != DepositContractDepth*len(common.Root{}) {
+		return ErrInvalidSnapshotBranch
+	}
+	for height := range t.branch {
+		copy(t.branch[height][:], bz[height*len(common.Root{}):])
+	}
+	return nil
+}
+
+// hashPair returns the sha256 hash of the concatenation of two nodes.
+func hashPair(left, right common.Root) common.Root {
+	var input [64]byte
+	copy(input[:32], left[:])
+	copy(input[32:], right[:])
+	return sha256.Sum256(input[:])
+}
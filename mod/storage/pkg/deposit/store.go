@@ -22,19 +22,43 @@ package deposit
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"sync"
 
 	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
 
 // Deposit is a struct that holds the deposit information.
-var _ pruner.Prunable = (*KVStore[Deposit])(nil)
+var (
+	_ pruner.Prunable = (*KVStore[Deposit])(nil)
+	_ pruner.Sizer    = (*KVStore[Deposit])(nil)
+)
+
+const (
+	KeyDepositPrefix                      = "deposit"
+	KeySnapshotBranchPrefix               = "snapshot_branch"
+	KeySnapshotCountPrefix                = "snapshot_count"
+	KeySnapshotExecutionBlockHashPrefix   = "snapshot_execution_block_hash"
+	KeySnapshotExecutionBlockHeightPrefix = "snapshot_execution_block_height"
+	KeyExecutionBlockPrefix               = "execution_block"
+	KeyBlockDepositIndexPrefix            = "block_deposit_index"
+)
 
-const KeyDepositPrefix = "deposit"
+const (
+	depositPrefix uint8 = iota
+	snapshotBranchPrefix
+	snapshotCountPrefix
+	snapshotExecutionBlockHashPrefix
+	snapshotExecutionBlockHeightPrefix
+	executionBlockPrefix
+	blockDepositIndexPrefix
+)
 
 type KVStoreProvider struct {
 	store.KVStoreWithBatch
@@ -49,7 +73,25 @@ func (p *KVStoreProvider) OpenKVStore(context.Context) store.KVStore {
 // the deposit indexes are tracked outside of the kv store.
 type KVStore[DepositT Deposit] struct {
 	store sdkcollections.Map[uint64, DepositT]
-	mu    sync.RWMutex
+	// snapshotBranch stores the branch of the deposit tree covering all
+	// pruned deposits.
+	snapshotBranch sdkcollections.Item[[]byte]
+	// snapshotCount stores the number of deposits folded into the
+	// deposit tree snapshot.
+	snapshotCount sdkcollections.Item[uint64]
+	// snapshotExecutionBlockHash stores the execution block hash associated
+	// with the deposit tree snapshot.
+	snapshotExecutionBlockHash sdkcollections.Item[[]byte]
+	// snapshotExecutionBlockHeight stores the execution block height
+	// associated with the deposit tree snapshot.
+	snapshotExecutionBlockHeight sdkcollections.Item[uint64]
+	// executionBlocks stores, by the index of the last deposit of each
+	// execution block, the hash and height of that block.
+	executionBlocks sdkcollections.Map[uint64, []byte]
+	// blockDepositIndexes stores, by slot, the index of the next deposit to
+	// be processed after the beacon block of that slot.
+	blockDepositIndexes sdkcollections.Map[uint64, uint64]
+	mu                  sync.RWMutex
 }
 
 // NewStore creates a new deposit store.
@@ -58,11 +100,53 @@ func NewStore[DepositT Deposit](kvsp store.KVStoreService) *KVStore[DepositT] {
 	return &KVStore[DepositT]{
 		store: sdkcollections.NewMap(
 			schemaBuilder,
-			sdkcollections.NewPrefix([]byte{uint8(0)}),
+			sdkcollections.NewPrefix([]byte{depositPrefix}),
 			KeyDepositPrefix,
 			sdkcollections.Uint64Key,
 			encoding.SSZValueCodec[DepositT]{},
 		),
+		snapshotBranch: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{snapshotBranchPrefix}),
+			KeySnapshotBranchPrefix,
+			sdkcollections.BytesValue,
+		),
+		snapshotCount: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{snapshotCountPrefix}),
+			KeySnapshotCountPrefix,
+			sdkcollections.Uint64Value,
+		),
+		snapshotExecutionBlockHash: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{snapshotExecutionBlockHashPrefix},
+			),
+			KeySnapshotExecutionBlockHashPrefix,
+			sdkcollections.BytesValue,
+		),
+		snapshotExecutionBlockHeight: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{snapshotExecutionBlockHeightPrefix},
+			),
+			KeySnapshotExecutionBlockHeightPrefix,
+			sdkcollections.Uint64Value,
+		),
+		executionBlocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{executionBlockPrefix}),
+			KeyExecutionBlockPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		blockDepositIndexes: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{blockDepositIndexPrefix}),
+			KeyBlockDepositIndexPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
 	}
 }
 
@@ -113,15 +197,229 @@ func (kv *KVStore[DepositT]) setDeposit(deposit DepositT) error {
 	return kv.store.Set(context.TODO(), deposit.GetIndex(), deposit)
 }
 
-// Prune removes the [start, end) deposits from the store.
+// SetExecutionBlock records the execution block whose deposits end with the
+// deposit of the given index. The deposit tree snapshot only ever advances to
+// the end of such a block, so that the deposit contract held exactly the
+// deposits of the snapshot as of its execution block.
+func (kv *KVStore[DepositT]) SetExecutionBlock(
+	index uint64,
+	hash common.ExecutionHash,
+	height math.U64,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.executionBlocks.Set(
+		context.TODO(),
+		index,
+		binary.BigEndian.AppendUint64(hash.Bytes(), height.Unwrap()),
+	)
+}
+
+// SetBlockDepositIndex records the index of the next deposit to be processed
+// after the beacon block of the given slot, so that deposits can be pruned
+// by the slot of the block that processed them.
+func (kv *KVStore[DepositT]) SetBlockDepositIndex(
+	slot math.Slot,
+	index uint64,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.blockDepositIndexes.Set(context.TODO(), slot.Unwrap(), index)
+}
+
+// GetSnapshot returns the EIP-4881 deposit tree snapshot covering all
+// deposits that have been pruned from the store, or nil if no deposit has
+// been folded into the snapshot yet.
+func (kv *KVStore[DepositT]) GetSnapshot() (*Snapshot, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	tree, err := kv.getSnapshotTree()
+	if err != nil {
+		return nil, err
+	} else if tree.count == 0 {
+		return nil, nil
+	}
+
+	hash, err := kv.snapshotExecutionBlockHash.Get(context.TODO())
+	if err != nil && !errors.Is(err, sdkcollections.ErrNotFound) {
+		return nil, err
+	}
+
+	height, err := kv.snapshotExecutionBlockHeight.Get(context.TODO())
+	if err != nil && !errors.Is(err, sdkcollections.ErrNotFound) {
+		return nil, err
+	}
+
+	snapshot := &Snapshot{
+		Finalized:            tree.finalized(),
+		DepositRoot:          tree.root(),
+		DepositCount:         tree.count,
+		ExecutionBlockHeight: math.U64(height),
+	}
+	copy(snapshot.ExecutionBlockHash[:], hash)
+	return snapshot, nil
+}
+
+// Prune removes the [start, end) deposits from the store. Before any deposit
+// is removed, the deposits below it are folded into the deposit tree
+// snapshot, so that the deposit tree can still be rebuilt afterwards. As the
+// snapshot only advances to the end of an execution block, the deposits of
+// the execution block end falls into are retained.
 func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
-	for i := range end {
+	return kv.prune(start, end)
+}
+
+// prune removes the [start, end) deposits from the store. The caller must
+// hold the lock.
+func (kv *KVStore[DepositT]) prune(start, end uint64) error {
+	if start >= end {
+		return nil
+	}
+
+	end, err := kv.advanceSnapshot(end)
+	if err != nil {
+		return err
+	}
+
+	for i := start; i < end; i++ {
 		// This only errors if the key passed in cannot be encoded.
-		if err := kv.store.Remove(context.TODO(), start+i); err != nil {
+		if err = kv.store.Remove(context.TODO(), i); err != nil {
+			return err
+		}
+		if err = kv.executionBlocks.Remove(context.TODO(), i); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// Size returns the number of bytes held by the [start, end) deposits.
+func (kv *KVStore[DepositT]) Size(start, end uint64) (uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return kv.size(start, end)
+}
+
+// size returns the number of bytes held by the [start, end) deposits. The
+// caller must hold the lock.
+func (kv *KVStore[DepositT]) size(start, end uint64) (uint64, error) {
+	iter, err := kv.store.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).
+			StartInclusive(start).
+			EndExclusive(end),
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	var size uint64
+	for ; iter.Valid(); iter.Next() {
+		deposit, vErr := iter.Value()
+		if vErr != nil {
+			return 0, vErr
+		}
+		size += uint64(deposit.SizeSSZ())
+	}
+	return size, nil
+}
+
+// advanceSnapshot folds the deposits from the snapshot count up to the end of
+// the last execution block ending below end into the deposit tree snapshot
+// and persists it. It returns the end of the deposits that may be pruned.
+func (kv *KVStore[DepositT]) advanceSnapshot(end uint64) (uint64, error) {
+	tree, err := kv.getSnapshotTree()
+	if err != nil {
+		return 0, err
+	}
+
+	if tree.count >= end {
+		return end, nil
+	}
+
+	// The snapshot cannot be built from this store if the next deposit to
+	// fold is missing, e.g. on a node that was state synced.
+	if _, err = kv.store.Get(
+		context.TODO(), tree.count,
+	); errors.Is(err, sdkcollections.ErrNotFound) {
+		return end, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	// Find the end of the last execution block ending below end.
+	var (
+		block    []byte
+		boundary uint64
+	)
+	for i := end; i > tree.count; i-- {
+		block, err = kv.executionBlocks.Get(context.TODO(), i-1)
+		if err == nil {
+			boundary = i
+			break
+		} else if !errors.Is(err, sdkcollections.ErrNotFound) {
+			return 0, err
+		}
+	}
+	if block == nil {
+		return tree.count, nil
+	}
+
+	for i := tree.count; i < boundary; i++ {
+		var (
+			deposit DepositT
+			leaf    common.Root
+		)
+		if deposit, err = kv.store.Get(context.TODO(), i); err != nil {
+			return 0, err
+		}
+
+		if leaf, err = deposit.DepositDataRoot(); err != nil {
+			return 0, err
+		}
+		tree.push(leaf)
+	}
+
+	if err = kv.snapshotBranch.Set(
+		context.TODO(), tree.marshalBranch(),
+	); err != nil {
+		return 0, err
+	}
+	if err = kv.snapshotCount.Set(context.TODO(), tree.count); err != nil {
+		return 0, err
+	}
+	if err = kv.snapshotExecutionBlockHash.Set(
+		context.TODO(), block[:len(common.ExecutionHash{})],
+	); err != nil {
+		return 0, err
+	}
+	return boundary, kv.snapshotExecutionBlockHeight.Set(
+		context.TODO(),
+		binary.BigEndian.Uint64(block[len(common.ExecutionHash{}):]),
+	)
+}
+
+// getSnapshotTree loads the deposit tree snapshot from the store.
+func (kv *KVStore[DepositT]) getSnapshotTree() (*snapshotTree, error) {
+	tree := &snapshotTree{}
+	count, err := kv.snapshotCount.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return tree, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	branch, err := kv.snapshotBranch.Get(context.TODO())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tree.unmarshalBranch(branch); err != nil {
+		return nil, err
+	}
+	tree.count = count
+	return tree, nil
+}
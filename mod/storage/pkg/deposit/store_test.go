@@ -0,0 +1,358 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/stretchr/testify/require"
+)
+
+// testDeposit is a minimal deposit used to exercise the store.
+type testDeposit struct {
+	Index uint64
+}
+
+func (d *testDeposit) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(buf, d.Index), nil
+}
+
+func (d *testDeposit) MarshalSSZ() ([]byte, error) {
+	return d.MarshalSSZTo(nil)
+}
+
+func (d *testDeposit) UnmarshalSSZ(buf []byte) error {
+	d.Index = binary.LittleEndian.Uint64(buf)
+	return nil
+}
+
+func (d *testDeposit) SizeSSZ() int {
+	return 8
+}
+
+func (d *testDeposit) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	// Offset by one so that no leaf is the zero hash.
+	binary.LittleEndian.PutUint64(root[:], d.Index+1)
+	return root, nil
+}
+
+func (d *testDeposit) GetIndex() uint64 {
+	return d.Index
+}
+
+func (d *testDeposit) DepositDataRoot() (common.Root, error) {
+	var root common.Root
+	// Offset by one so that no leaf is the zero hash.
+	binary.LittleEndian.PutUint64(root[:], d.Index+1)
+	return root, nil
+}
+
+// memKVStore is an in-memory store.KVStore.
+type memKVStore map[string][]byte
+
+func (m memKVStore) Get(key []byte) ([]byte, error) {
+	return m[string(key)], nil
+}
+
+func (m memKVStore) Has(key []byte) (bool, error) {
+	_, ok := m[string(key)]
+	return ok, nil
+}
+
+func (m memKVStore) Set(key, value []byte) error {
+	m[string(key)] = value
+	return nil
+}
+
+func (m memKVStore) Delete(key []byte) error {
+	delete(m, string(key))
+	return nil
+}
+
+func (m memKVStore) Iterator(start, end []byte) (store.Iterator, error) {
+	return m.iterator(start, end, false), nil
+}
+
+func (m memKVStore) ReverseIterator(
+	start, end []byte,
+) (store.Iterator, error) {
+	return m.iterator(start, end, true), nil
+}
+
+func (m memKVStore) iterator(start, end []byte, reverse bool) *memIterator {
+	it := &memIterator{store: m, start: start, end: end}
+	for key := range m {
+		if (start == nil || key >= string(start)) &&
+			(end == nil || key < string(end)) {
+			it.keys = append(it.keys, key)
+		}
+	}
+	slices.Sort(it.keys)
+	if reverse {
+		slices.Reverse(it.keys)
+	}
+	return it
+}
+
+// memIterator iterates over the keys of a memKVStore as of its creation.
+type memIterator struct {
+	store      memKVStore
+	start, end []byte
+	keys       []string
+}
+
+func (it *memIterator) Domain() ([]byte, []byte) { return it.start, it.end }
+func (it *memIterator) Valid() bool              { return len(it.keys) > 0 }
+func (it *memIterator) Next()                    { it.keys = it.keys[1:] }
+func (it *memIterator) Key() []byte              { return []byte(it.keys[0]) }
+func (it *memIterator) Value() []byte            { return it.store[it.keys[0]] }
+func (it *memIterator) Error() error             { return nil }
+func (it *memIterator) Close() error             { return nil }
+
+type memKVStoreService struct {
+	store memKVStore
+}
+
+func (s memKVStoreService) OpenKVStore(context.Context) store.KVStore {
+	return s.store
+}
+
+func newTestStore(
+	t *testing.T,
+	numDeposits uint64,
+) *deposit.KVStore[*testDeposit] {
+	t.Helper()
+	ds := deposit.NewStore[*testDeposit](
+		memKVStoreService{store: memKVStore{}},
+	)
+	deposits := make([]*testDeposit, numDeposits)
+	for i := range numDeposits {
+		deposits[i] = &testDeposit{Index: i}
+	}
+	require.NoError(t, ds.EnqueueDeposits(deposits))
+	return ds
+}
+
+// contractDepositRoot returns the deposit root of the given leaves as
+// computed by the deposit contract's get_deposit_root.
+func contractDepositRoot(leaves []common.Root) common.Root {
+	var branch, zeroHashes [deposit.DepositContractDepth]common.Root
+	for height := 1; height < deposit.DepositContractDepth; height++ {
+		zeroHashes[height] = sha256.Sum256(
+			append(zeroHashes[height-1][:], zeroHashes[height-1][:]...),
+		)
+	}
+
+	// deposit
+	for i, leaf := range leaves {
+		size := uint64(i) + 1
+		node := leaf
+		for height := range deposit.DepositContractDepth {
+			if size&1 == 1 {
+				branch[height] = node
+				break
+			}
+			node = sha256.Sum256(append(branch[height][:], node[:]...))
+			size /= 2
+		}
+	}
+
+	// get_deposit_root
+	var node common.Root
+	size := uint64(len(leaves))
+	for height := range deposit.DepositContractDepth {
+		if size&1 == 1 {
+			node = sha256.Sum256(append(branch[height][:], node[:]...))
+		} else {
+			node = sha256.Sum256(append(node[:], zeroHashes[height][:]...))
+		}
+		size /= 2
+	}
+	count := make([]byte, 32)
+	binary.LittleEndian.PutUint64(count, uint64(len(leaves)))
+	return sha256.Sum256(append(node[:], count...))
+}
+
+func expectedDepositRoot(t *testing.T, count uint64) common.Root {
+	t.Helper()
+	leaves := make([]common.Root, count)
+	for i := range count {
+		var err error
+		leaves[i], err = (&testDeposit{Index: i}).DepositDataRoot()
+		require.NoError(t, err)
+	}
+	return contractDepositRoot(leaves)
+}
+
+func TestContractDepositRoot(t *testing.T) {
+	// The deposit root of the empty deposit contract.
+	require.Equal(t,
+		common.Root(common.Hex2BytesFixed(
+			"d70a234731285c6804c2a4f56711ddb8c82c99740f207854891028af34e27e5e",
+			32,
+		)),
+		contractDepositRoot(nil),
+	)
+}
+
+func TestPruneRemovesRange(t *testing.T) {
+	ds := newTestStore(t, 10)
+	require.NoError(t, ds.SetExecutionBlock(5, common.ExecutionHash{}, 1))
+	require.NoError(t, ds.Prune(3, 6))
+
+	deposits, err := ds.GetDepositsByIndex(0, 3)
+	require.NoError(t, err)
+	require.Len(t, deposits, 3)
+
+	deposits, err = ds.GetDepositsByIndex(3, 3)
+	require.NoError(t, err)
+	require.Empty(t, deposits)
+
+	deposits, err = ds.GetDepositsByIndex(6, 4)
+	require.NoError(t, err)
+	require.Len(t, deposits, 4)
+}
+
+func TestPruneEmptyRange(t *testing.T) {
+	ds := newTestStore(t, 4)
+	require.NoError(t, ds.SetExecutionBlock(3, common.ExecutionHash{}, 1))
+	require.NoError(t, ds.Prune(2, 2))
+
+	deposits, err := ds.GetDepositsByIndex(0, 4)
+	require.NoError(t, err)
+	require.Len(t, deposits, 4)
+
+	snapshot, err := ds.GetSnapshot()
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+}
+
+func TestPruneAdvancesSnapshot(t *testing.T) {
+	ds := newTestStore(t, 12)
+	// Execution blocks 7, 8 and 9 hold the deposits [0, 5), [5, 8) and
+	// [8, 12).
+	require.NoError(t, ds.SetExecutionBlock(4, common.ExecutionHash{0x01}, 7))
+	require.NoError(t, ds.SetExecutionBlock(7, common.ExecutionHash{0x02}, 8))
+	require.NoError(t, ds.SetExecutionBlock(11, common.ExecutionHash{0x03}, 9))
+
+	require.NoError(t, ds.Prune(0, 5))
+	snapshot, err := ds.GetSnapshot()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), snapshot.DepositCount)
+	require.Equal(t, expectedDepositRoot(t, 5), snapshot.DepositRoot)
+	// 5 = 0b101, so there is one subtree of 4 leaves and one of 1 leaf.
+	require.Len(t, snapshot.Finalized, 2)
+	require.Equal(t, common.ExecutionHash{0x01}, snapshot.ExecutionBlockHash)
+	require.Equal(t, uint64(7), snapshot.ExecutionBlockHeight.Unwrap())
+
+	// Deposit 10 is in the middle of execution block 9, so the snapshot only
+	// advances to the end of execution block 8 and the deposits of block 9
+	// are retained.
+	require.NoError(t, ds.Prune(5, 11))
+	snapshot, err = ds.GetSnapshot()
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), snapshot.DepositCount)
+	require.Equal(t, expectedDepositRoot(t, 8), snapshot.DepositRoot)
+	require.Len(t, snapshot.Finalized, 1)
+	require.Equal(t, common.ExecutionHash{0x02}, snapshot.ExecutionBlockHash)
+	require.Equal(t, uint64(8), snapshot.ExecutionBlockHeight.Unwrap())
+
+	deposits, err := ds.GetDepositsByIndex(8, 4)
+	require.NoError(t, err)
+	require.Len(t, deposits, 4)
+}
+
+func TestPruneMissingDeposit(t *testing.T) {
+	ds := deposit.NewStore[*testDeposit](
+		memKVStoreService{store: memKVStore{}},
+	)
+	// A state synced node only holds the deposits from index 4 onwards.
+	deposits := make([]*testDeposit, 0, 4)
+	for i := range uint64(4) {
+		deposits = append(deposits, &testDeposit{Index: i + 4})
+	}
+	require.NoError(t, ds.EnqueueDeposits(deposits))
+	require.NoError(t, ds.SetExecutionBlock(7, common.ExecutionHash{}, 1))
+
+	require.NoError(t, ds.Prune(0, 6))
+	snapshot, err := ds.GetSnapshot()
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+
+	remaining, err := ds.GetDepositsByIndex(4, 2)
+	require.NoError(t, err)
+	require.Empty(t, remaining)
+
+	remaining, err = ds.GetDepositsByIndex(6, 2)
+	require.NoError(t, err)
+	require.Len(t, remaining, 2)
+}
+
+func TestSlotPrunable(t *testing.T) {
+	ds := newTestStore(t, 10)
+	require.NoError(t, ds.SetExecutionBlock(3, common.ExecutionHash{}, 1))
+	require.NoError(t, ds.SetExecutionBlock(9, common.ExecutionHash{}, 2))
+	// The blocks of slots 1, 2 and 4 processed the deposits [0, 4), none
+	// and [4, 10).
+	require.NoError(t, ds.SetBlockDepositIndex(1, 4))
+	require.NoError(t, ds.SetBlockDepositIndex(2, 4))
+	require.NoError(t, ds.SetBlockDepositIndex(4, 10))
+	sp := deposit.NewSlotPrunable(ds)
+
+	// Nothing was processed below slot 1.
+	require.NoError(t, sp.Prune(0, 1))
+	deposits, err := ds.GetDepositsByIndex(0, 10)
+	require.NoError(t, err)
+	require.Len(t, deposits, 10)
+
+	size, err := sp.Size(0, 4)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4*8), size)
+
+	// Slot 3 has no block, the deposits as of slot 2 are pruned.
+	require.NoError(t, sp.Prune(0, 4))
+	deposits, err = ds.GetDepositsByIndex(0, 4)
+	require.NoError(t, err)
+	require.Empty(t, deposits)
+	deposits, err = ds.GetDepositsByIndex(4, 6)
+	require.NoError(t, err)
+	require.Len(t, deposits, 6)
+
+	// The deposit index of slot 2 is kept, a prune up to slot 4 still
+	// finds it and prunes nothing more.
+	require.NoError(t, sp.Prune(3, 4))
+	deposits, err = ds.GetDepositsByIndex(4, 6)
+	require.NoError(t, err)
+	require.Len(t, deposits, 6)
+
+	require.NoError(t, sp.Prune(4, 5))
+	deposits, err = ds.GetDepositsByIndex(4, 6)
+	require.NoError(t, err)
+	require.Empty(t, deposits)
+}
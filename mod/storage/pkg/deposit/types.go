@@ -21,6 +21,7 @@
 package deposit
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 )
 
@@ -28,6 +29,9 @@ import (
 type Deposit interface {
 	ssz.Marshallable
 	GetIndex() uint64
+	// DepositDataRoot returns the hash tree root of the deposit data, which
+	// is the leaf of the deposit in the deposit contract tree.
+	DepositDataRoot() (common.Root, error)
 }
 
 // RawBatch represents a group of writes. They may or may not be written
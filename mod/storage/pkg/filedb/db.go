@@ -21,14 +21,24 @@
 package filedb
 
 import (
+	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/gofrs/flock"
 	"github.com/spf13/afero"
 )
 
+// lockFileName is the name of the file locked by the process holding the
+// lock of the DB.
+const lockFileName = "LOCK"
+
+// ErrLocked is returned when the lock of the DB is held by another process.
+var ErrLocked = errors.New("database is locked by another process")
+
 // DB represents a filesystem backed key-value store.
 // It is useful for storing amounts of data that exceed what is
 // performant to store in a traditional key-value database.
@@ -38,6 +48,8 @@ type DB struct {
 	rootDir   string
 	extension string
 	dirPerms  os.FileMode
+	// lock is the lock of the DB, if held.
+	lock *flock.Flock
 }
 
 // NewDB creates a new instance of the DB.
@@ -53,6 +65,33 @@ func NewDB(opts ...Option) *DB {
 	return db
 }
 
+// Lock takes an exclusive lock on the DB, held until Unlock is called or the
+// process exits, so that other processes do not write to the DB while it is
+// in use. It returns ErrLocked if another process holds the lock.
+func (db *DB) Lock() error {
+	if err := os.MkdirAll(db.rootDir, db.dirPerms); err != nil {
+		return err
+	}
+
+	lock := flock.New(filepath.Join(db.rootDir, lockFileName))
+	locked, err := lock.TryLock()
+	if err != nil {
+		return err
+	} else if !locked {
+		return ErrLocked
+	}
+	db.lock = lock
+	return nil
+}
+
+// Unlock releases the lock on the DB, if held.
+func (db *DB) Unlock() error {
+	if db.lock == nil {
+		return nil
+	}
+	return db.lock.Unlock()
+}
+
 // Get retrieves the value for a key.
 func (db *DB) Get(key []byte) ([]byte, error) {
 	return afero.ReadFile(db.fs, db.pathForKey(key))
@@ -101,6 +140,70 @@ func (db *DB) Delete(key []byte) error {
 	return db.fs.RemoveAll(db.pathForKey(key))
 }
 
+// Keys returns the keys of all values stored under the given directory.
+// Nested directories are not traversed.
+func (db *DB) Keys(dir []byte) ([][]byte, error) {
+	entries, err := afero.ReadDir(db.fs, string(dir))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	suffix := "." + db.extension
+	keys := make([][]byte, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, suffix) {
+			continue
+		}
+		keys = append(keys, []byte(
+			filepath.Join(string(dir), strings.TrimSuffix(name, suffix)),
+		))
+	}
+	return keys, nil
+}
+
+// Dirs returns the names of all directories stored at the root of the
+// database.
+func (db *DB) Dirs() ([]string, error) {
+	entries, err := afero.ReadDir(db.fs, ".")
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	dirs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			dirs = append(dirs, entry.Name())
+		}
+	}
+	return dirs, nil
+}
+
+// DirSize returns the number of bytes held by the values stored under the
+// given directory, nested directories included.
+func (db *DB) DirSize(dir string) (uint64, error) {
+	var size uint64
+	err := afero.Walk(
+		db.fs, dir, func(_ string, info fs.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() {
+				size += uint64(info.Size())
+			}
+			return nil
+		},
+	)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	return size, err
+}
+
 // pathForKey returns the path for a key.
 // TODO: for efficient storage we should expand this path
 func (db *DB) pathForKey(key []byte) string {
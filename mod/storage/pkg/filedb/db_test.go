@@ -293,3 +293,24 @@ func TestDB_Set_MkDirError(t *testing.T) {
 		}
 	})
 }
+
+func TestDB_Lock(t *testing.T) {
+	dir := t.TempDir()
+	newDB := func() *file.DB {
+		return file.NewDB(
+			file.WithRootDirectory(dir),
+			file.WithDirectoryPermissions(0o755),
+			file.WithLogger(log.NewNopLogger()),
+		)
+	}
+
+	db := newDB()
+	require.NoError(t, db.Lock())
+
+	// The lock is held until it is released.
+	other := newDB()
+	require.ErrorIs(t, other.Lock(), file.ErrLocked)
+	require.NoError(t, db.Unlock())
+	require.NoError(t, other.Lock())
+	require.NoError(t, other.Unlock())
+}
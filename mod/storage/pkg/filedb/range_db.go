@@ -23,6 +23,7 @@ package filedb
 import (
 	"bytes"
 	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -35,7 +36,10 @@ import (
 const two = 2
 
 // Compile-time assertion of prunable interface.
-var _ pruner.Prunable = (*RangeDB)(nil)
+var (
+	_ pruner.Prunable = (*RangeDB)(nil)
+	_ pruner.Sizer    = (*RangeDB)(nil)
+)
 
 // RangeDB is a database that stores versioned data.
 // It prefixes keys with an index.
@@ -101,6 +105,72 @@ func (db *RangeDB) DeleteRange(from, to uint64) error {
 	return nil
 }
 
+// Keys returns all keys stored at the given index, with the index prefix
+// stripped.
+func (db *RangeDB) Keys(index uint64) ([][]byte, error) {
+	prefixedKeys, err := db.DB.Keys([]byte(strconv.FormatUint(index, 10)))
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([][]byte, 0, len(prefixedKeys))
+	for _, prefixedKey := range prefixedKeys {
+		parts := bytes.SplitN(prefixedKey, []byte("/"), two)
+		if len(parts) < two {
+			return nil, errors.New("invalid key format")
+		}
+		key, err := hex.NewString(parts[1]).ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Indexes returns all indexes that hold at least one value, in ascending
+// order.
+func (db *RangeDB) Indexes() ([]uint64, error) {
+	dirs, err := db.DB.Dirs()
+	if err != nil {
+		return nil, err
+	}
+
+	indexes := make([]uint64, 0, len(dirs))
+	for _, dir := range dirs {
+		index, err := strconv.ParseUint(dir, 10, 64)
+		if err != nil {
+			// Skip directories that are not managed by the RangeDB.
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+	slices.Sort(indexes)
+	return indexes, nil
+}
+
+// Size returns the number of bytes held by the values of all indexes in the
+// given range [start, end).
+func (db *RangeDB) Size(start, end uint64) (uint64, error) {
+	indexes, err := db.Indexes()
+	if err != nil {
+		return 0, err
+	}
+
+	var size uint64
+	for _, index := range indexes {
+		if index < start || index >= end {
+			continue
+		}
+		dirSize, err := db.DB.DirSize(strconv.FormatUint(index, 10))
+		if err != nil {
+			return 0, err
+		}
+		size += dirSize
+	}
+	return size, nil
+}
+
 // Prune removes all values in the given range [start, end) from the db.
 func (db *RangeDB) Prune(start, end uint64) error {
 	start = max(start, db.firstNonNilIndex)
@@ -151,6 +151,32 @@ func TestRangeDB(t *testing.T) {
 	}
 }
 
+func TestRangeDB_KeysAndIndexes(t *testing.T) {
+	rdb := file.NewRangeDB(newTestFDB(t.TempDir()))
+
+	indexes, err := rdb.Indexes()
+	require.NoError(t, err)
+	require.Empty(t, indexes)
+
+	for _, index := range []uint64{10, 2, 7} {
+		for _, key := range [][]byte{{0x01}, {0x02, 0x03}} {
+			require.NoError(t, rdb.Set(index, key, []byte("testValue")))
+		}
+	}
+
+	indexes, err = rdb.Indexes()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 7, 10}, indexes)
+
+	keys, err := rdb.Keys(7)
+	require.NoError(t, err)
+	require.ElementsMatch(t, [][]byte{{0x01}, {0x02, 0x03}}, keys)
+
+	keys, err = rdb.Keys(3)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+}
+
 func TestExtractIndex(t *testing.T) {
 	tests := []struct {
 		name        string
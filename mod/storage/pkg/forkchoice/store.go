@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forkchoice
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// KeyForkchoiceStatePrefix is the name of the item holding the forkchoice
+// state in the store.
+const KeyForkchoiceStatePrefix = "forkchoice_state"
+
+const forkchoiceStatePrefix uint8 = iota
+
+const (
+	// slotLength is the length of the encoded slot of the beacon block the
+	// forkchoice state was recorded for.
+	slotLength = 8
+	// hashLength is the length of an execution block hash.
+	hashLength = len(common.ExecutionHash{})
+	// stateLength is the length of an encoded forkchoice state, made of the
+	// slot followed by the head, safe and finalized execution block hashes.
+	stateLength = slotLength + 3*hashLength
+)
+
+// ErrInvalidState is returned when the persisted forkchoice state does not
+// have the expected length.
+var ErrInvalidState = errors.New("invalid forkchoice state")
+
+// KVStore persists the forkchoice state of the execution chain, so that the
+// head, safe and finalized execution block hashes survive restarts.
+type KVStore struct {
+	state sdkcollections.Item[[]byte]
+	// slot is the slot of the beacon block the cached forkchoice state was
+	// recorded for.
+	slot math.Slot
+	// cache is the latest forkchoice state, nil until it is first read or
+	// written.
+	cache *engineprimitives.ForkchoiceStateV1
+	mu    sync.Mutex
+}
+
+// NewStore creates a new forkchoice store.
+func NewStore(kvsp store.KVStoreService) *KVStore {
+	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+	return &KVStore{
+		state: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{forkchoiceStatePrefix}),
+			KeyForkchoiceStatePrefix,
+			sdkcollections.BytesValue,
+		),
+	}
+}
+
+// Get returns the latest forkchoice state along with the slot of the beacon
+// block it was recorded for, or a nil state if none has been persisted yet.
+func (kv *KVStore) Get() (
+	math.Slot, *engineprimitives.ForkchoiceStateV1, error,
+) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if kv.cache != nil {
+		return kv.slot, kv.copyCache(), nil
+	}
+
+	bz, err := kv.state.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, nil, nil
+	} else if err != nil {
+		return 0, nil, err
+	}
+	if len(bz) != stateLength {
+		return 0, nil, ErrInvalidState
+	}
+
+	kv.slot = math.Slot(binary.BigEndian.Uint64(bz[:slotLength]))
+	bz = bz[slotLength:]
+	kv.cache = &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash: common.ExecutionHash(bz[:hashLength]),
+		SafeBlockHash: common.ExecutionHash(
+			bz[hashLength : 2*hashLength],
+		),
+		FinalizedBlockHash: common.ExecutionHash(bz[2*hashLength:]),
+	}
+	return kv.slot, kv.copyCache(), nil
+}
+
+// Set persists the given forkchoice state, recorded for the beacon block at
+// the given slot.
+func (kv *KVStore) Set(
+	slot math.Slot,
+	fcs *engineprimitives.ForkchoiceStateV1,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	bz := make([]byte, 0, stateLength)
+	bz = binary.BigEndian.AppendUint64(bz, slot.Unwrap())
+	bz = append(bz, fcs.HeadBlockHash[:]...)
+	bz = append(bz, fcs.SafeBlockHash[:]...)
+	bz = append(bz, fcs.FinalizedBlockHash[:]...)
+	if err := kv.state.Set(context.TODO(), bz); err != nil {
+		return err
+	}
+	cache := *fcs
+	kv.slot = slot
+	kv.cache = &cache
+	return nil
+}
+
+// copyCache returns a copy of the cached forkchoice state, so that callers
+// cannot modify it.
+func (kv *KVStore) copyCache() *engineprimitives.ForkchoiceStateV1 {
+	fcs := *kv.cache
+	return &fcs
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forkchoice_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/forkchoice"
+	"github.com/stretchr/testify/require"
+)
+
+// memKVStore is an in-memory store.KVStore that does not support iteration.
+type memKVStore map[string][]byte
+
+func (m memKVStore) Get(key []byte) ([]byte, error) {
+	return m[string(key)], nil
+}
+
+func (m memKVStore) Has(key []byte) (bool, error) {
+	_, ok := m[string(key)]
+	return ok, nil
+}
+
+func (m memKVStore) Set(key, value []byte) error {
+	m[string(key)] = value
+	return nil
+}
+
+func (m memKVStore) Delete(key []byte) error {
+	delete(m, string(key))
+	return nil
+}
+
+func (m memKVStore) Iterator(_, _ []byte) (store.Iterator, error) {
+	panic("not implemented")
+}
+
+func (m memKVStore) ReverseIterator(_, _ []byte) (store.Iterator, error) {
+	panic("not implemented")
+}
+
+type memKVStoreService struct {
+	store memKVStore
+}
+
+func (s memKVStoreService) OpenKVStore(context.Context) store.KVStore {
+	return s.store
+}
+
+func TestGetEmpty(t *testing.T) {
+	slot, fcs, err := forkchoice.NewStore(
+		memKVStoreService{store: memKVStore{}},
+	).Get()
+	require.NoError(t, err)
+	require.Nil(t, fcs)
+	require.Zero(t, slot)
+}
+
+func TestSetPersists(t *testing.T) {
+	kvs := memKVStoreService{store: memKVStore{}}
+	want := &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash:      common.ExecutionHash{0x03},
+		SafeBlockHash:      common.ExecutionHash{0x02},
+		FinalizedBlockHash: common.ExecutionHash{0x01},
+	}
+	require.NoError(t, forkchoice.NewStore(kvs).Set(42, want))
+
+	// A new store over the same database reads the state back, as it
+	// would after a restart.
+	slot, got, err := forkchoice.NewStore(kvs).Get()
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+	require.Equal(t, math.Slot(42), slot)
+}
+
+func TestGetReturnsCopy(t *testing.T) {
+	fcStore := forkchoice.NewStore(memKVStoreService{store: memKVStore{}})
+	require.NoError(t, fcStore.Set(1, &engineprimitives.ForkchoiceStateV1{
+		HeadBlockHash: common.ExecutionHash{0x01},
+	}))
+
+	_, fcs, err := fcStore.Get()
+	require.NoError(t, err)
+	fcs.HeadBlockHash = common.ExecutionHash{0xff}
+
+	_, fcs, err = fcStore.Get()
+	require.NoError(t, err)
+	require.Equal(t, common.ExecutionHash{0x01}, fcs.HeadBlockHash)
+}
+
+func TestGetInvalidState(t *testing.T) {
+	kvs := memKVStoreService{store: memKVStore{}}
+	require.NoError(t, forkchoice.NewStore(kvs).Set(
+		1, &engineprimitives.ForkchoiceStateV1{},
+	))
+	for key := range kvs.store {
+		kvs.store[key] = []byte{0x01}
+	}
+
+	_, _, err := forkchoice.NewStore(kvs).Get()
+	require.ErrorIs(t, err, forkchoice.ErrInvalidState)
+}
@@ -26,6 +26,14 @@ type DB interface {
 	Has(key []byte) (bool, error)
 	Set(key []byte, value []byte) error
 	Delete(key []byte) error
+	// Keys returns the keys of all values stored under the given directory.
+	Keys(dir []byte) ([][]byte, error)
+	// Dirs returns the names of all directories stored at the root of the
+	// store.
+	Dirs() ([]string, error)
+	// DirSize returns the number of bytes held by the values stored under
+	// the given directory.
+	DirSize(dir string) (uint64, error)
 
 	// TODO: add Batch and full DB stuff.
 }
@@ -63,6 +63,119 @@ func (_c *DB_Delete_Call) RunAndReturn(run func([]byte) error) *DB_Delete_Call {
 	return _c
 }
 
+// DirSize provides a mock function with given fields: dir
+func (_m *DB) DirSize(dir string) (uint64, error) {
+	ret := _m.Called(dir)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DirSize")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (uint64, error)); ok {
+		return rf(dir)
+	}
+	if rf, ok := ret.Get(0).(func(string) uint64); ok {
+		r0 = rf(dir)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(dir)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_DirSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DirSize'
+type DB_DirSize_Call struct {
+	*mock.Call
+}
+
+// DirSize is a helper method to define mock.On call
+//   - dir string
+func (_e *DB_Expecter) DirSize(dir interface{}) *DB_DirSize_Call {
+	return &DB_DirSize_Call{Call: _e.mock.On("DirSize", dir)}
+}
+
+func (_c *DB_DirSize_Call) Run(run func(dir string)) *DB_DirSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DB_DirSize_Call) Return(_a0 uint64, _a1 error) *DB_DirSize_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_DirSize_Call) RunAndReturn(run func(string) (uint64, error)) *DB_DirSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Dirs provides a mock function with given fields:
+func (_m *DB) Dirs() ([]string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dirs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_Dirs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dirs'
+type DB_Dirs_Call struct {
+	*mock.Call
+}
+
+// Dirs is a helper method to define mock.On call
+func (_e *DB_Expecter) Dirs() *DB_Dirs_Call {
+	return &DB_Dirs_Call{Call: _e.mock.On("Dirs")}
+}
+
+func (_c *DB_Dirs_Call) Run(run func()) *DB_Dirs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DB_Dirs_Call) Return(_a0 []string, _a1 error) *DB_Dirs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_Dirs_Call) RunAndReturn(run func() ([]string, error)) *DB_Dirs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: key
 func (_m *DB) Get(key []byte) ([]byte, error) {
 	ret := _m.Called(key)
@@ -177,6 +290,64 @@ func (_c *DB_Has_Call) RunAndReturn(run func([]byte) (bool, error)) *DB_Has_Call
 	return _c
 }
 
+// Keys provides a mock function with given fields: dir
+func (_m *DB) Keys(dir []byte) ([][]byte, error) {
+	ret := _m.Called(dir)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Keys")
+	}
+
+	var r0 [][]byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) ([][]byte, error)); ok {
+		return rf(dir)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) [][]byte); ok {
+		r0 = rf(dir)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(dir)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_Keys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Keys'
+type DB_Keys_Call struct {
+	*mock.Call
+}
+
+// Keys is a helper method to define mock.On call
+//   - dir []byte
+func (_e *DB_Expecter) Keys(dir interface{}) *DB_Keys_Call {
+	return &DB_Keys_Call{Call: _e.mock.On("Keys", dir)}
+}
+
+func (_c *DB_Keys_Call) Run(run func(dir []byte)) *DB_Keys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *DB_Keys_Call) Return(_a0 [][]byte, _a1 error) *DB_Keys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_Keys_Call) RunAndReturn(run func([]byte) ([][]byte, error)) *DB_Keys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Set provides a mock function with given fields: key, value
 func (_m *DB) Set(key []byte, value []byte) error {
 	ret := _m.Called(key, value)
@@ -31,4 +31,8 @@ var (
 	// ErrDuplicatePruner is returned when a pruner with the same name is added
 	// to the manager.
 	ErrDuplicatePruner = errors.New("pruner with the same name already exists")
+
+	// ErrPrunerNotFound is returned when no pruner with the requested name is
+	// registered with the manager.
+	ErrPrunerNotFound = errors.New("pruner not found")
 )
@@ -27,6 +27,7 @@ package manager
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
@@ -87,3 +88,20 @@ func (m *DBManager[
 	}
 	return nil
 }
+
+// Prune manually prunes the data of the slots [from, to) from the store of
+// the pruner with the given name.
+func (m *DBManager[
+	BeaconBlockT, BlockEventT, SubscriptionT,
+]) Prune(name string, from, to uint64) error {
+	for _, pruner := range m.pruners {
+		if pruner.Name() != name {
+			continue
+		}
+		m.logger.Info(
+			"manually pruning store", "pruner", name, "from", from, "to", to,
+		)
+		return pruner.Prune(from, to)
+	}
+	return fmt.Errorf("%w: %s", ErrPrunerNotFound, name)
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager/mocks"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	prunerMocks "github.com/berachain/beacon-kit/mod/storage/pkg/pruner/mocks"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -56,18 +57,20 @@ func TestDBManager_Start(t *testing.T) {
 		}
 
 	logger := log.NewNopLogger()
+	cfg := &pruner.Config{Enabled: true}
+	sink := new(prunerMocks.TelemetrySink)
 	p1 := pruner.NewPruner[
 		manager.BeaconBlock,
 		manager.BlockEvent[manager.BeaconBlock],
 		*interfaceMocks.Prunable,
 		manager.Subscription,
-	](logger, mockPrunable, "pruner1", &feed, pruneParamsFn)
+	](cfg, logger, mockPrunable, "pruner1", &feed, pruneParamsFn, sink)
 	p2 := pruner.NewPruner[
 		manager.BeaconBlock,
 		manager.BlockEvent[manager.BeaconBlock],
 		*interfaceMocks.Prunable,
 		manager.Subscription,
-	](logger, mockPrunable, "pruner2", &feed, pruneParamsFn)
+	](cfg, logger, mockPrunable, "pruner2", &feed, pruneParamsFn, sink)
 
 	m, err := manager.NewDBManager[
 		manager.BeaconBlock,
@@ -86,3 +89,57 @@ func TestDBManager_Start(t *testing.T) {
 	feed.AssertNumberOfCalls(t, "Subscribe", 2)
 	mockPrunable.AssertNotCalled(t, "PruneFromInclusive")
 }
+
+func TestDBManager_Prune(t *testing.T) {
+	mockPrunable := new(interfaceMocks.Prunable)
+	mockPrunable.On("Prune", uint64(2), uint64(5)).Return(nil)
+	feed := mocks.BlockFeed[
+		manager.BeaconBlock,
+		manager.BlockEvent[manager.BeaconBlock],
+		manager.Subscription,
+	]{}
+	pruneParamsFn :=
+		func(_ manager.BlockEvent[manager.BeaconBlock]) (uint64, uint64) {
+			return 0, 0
+		}
+
+	sink := new(prunerMocks.TelemetrySink)
+	sink.On(
+		"SetGauge",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return()
+
+	logger := log.NewNopLogger()
+	p1 := pruner.NewPruner[
+		manager.BeaconBlock,
+		manager.BlockEvent[manager.BeaconBlock],
+		*interfaceMocks.Prunable,
+		manager.Subscription,
+	](
+		&pruner.Config{Enabled: true},
+		logger,
+		mockPrunable,
+		"pruner1",
+		&feed,
+		pruneParamsFn,
+		sink,
+	)
+
+	m, err := manager.NewDBManager[
+		manager.BeaconBlock,
+		manager.BlockEvent[manager.BeaconBlock],
+		manager.Subscription,
+	](logger, p1)
+	require.NoError(t, err)
+
+	require.NoError(t, m.Prune("pruner1", 2, 5))
+	mockPrunable.AssertCalled(t, "Prune", uint64(2), uint64(5))
+	sink.AssertCalled(
+		t, "SetGauge",
+		"beacon_kit.storage.pruner.last_pruned_slot", int64(4),
+		"pruner", "pruner1",
+	)
+
+	err = m.Prune("unknown", 2, 5)
+	require.ErrorIs(t, err, manager.ErrPrunerNotFound)
+}
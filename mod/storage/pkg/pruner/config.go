@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package pruner
+
+const (
+	// defaultEnabled is the default setting for enabling a pruner.
+	defaultEnabled = true
+	// defaultRetentionSlots is the default number of slots to retain. Zero
+	// means that the pruner falls back to its own default retention.
+	defaultRetentionSlots = 0
+)
+
+// Config is the configuration for a pruner.
+type Config struct {
+	// Enabled determines whether the pruner prunes its store at all.
+	Enabled bool `mapstructure:"enabled"`
+	// RetentionSlots is the number of most recent slots whose data is kept
+	// by the pruner. Zero means that the pruner uses its default retention.
+	RetentionSlots uint64 `mapstructure:"retention-slots"`
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:        defaultEnabled,
+		RetentionSlots: defaultRetentionSlots,
+	}
+}
@@ -32,8 +32,18 @@ type Prunable interface {
 	Prune(start, end uint64) error
 }
 
+// Sizer is an optional interface for a Prunable that is able to report how
+// many bytes are held in the range [start, end). It is used to report the
+// number of bytes freed by a prune.
+type Sizer interface {
+	// Size returns the number of bytes held in the range [start, end).
+	Size(start, end uint64) (uint64, error)
+}
+
 // Pruner is an interface for pruning the store.
 type Pruner[PrunableT Prunable] interface {
 	Name() string
 	Start(ctx context.Context)
+	// Prune prunes the range [start, end) of the store.
+	Prune(start, end uint64) error
 }
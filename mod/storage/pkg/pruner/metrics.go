@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package pruner
+
+// prunerMetrics is a struct that contains metrics for the pruner.
+type prunerMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+	// name is the name of the pruner the metrics are reported for.
+	name string
+}
+
+// newPrunerMetrics creates a new prunerMetrics.
+func newPrunerMetrics(
+	sink TelemetrySink,
+	name string,
+) *prunerMetrics {
+	return &prunerMetrics{
+		sink: sink,
+		name: name,
+	}
+}
+
+// reportPrunedRange reports the range of slots [start, end) that was pruned
+// and the number of bytes that were freed by pruning it.
+//
+//nolint:gosec // slots and sizes never exceed max int64.
+func (pm *prunerMetrics) reportPrunedRange(start, end, bytesFreed uint64) {
+	pm.sink.SetGauge(
+		"beacon_kit.storage.pruner.pruned_range_start",
+		int64(start), "pruner", pm.name,
+	)
+	pm.sink.SetGauge(
+		"beacon_kit.storage.pruner.pruned_range_end",
+		int64(end), "pruner", pm.name,
+	)
+	pm.sink.SetGauge(
+		"beacon_kit.storage.pruner.last_pruned_slot",
+		int64(end-1), "pruner", pm.name,
+	)
+	pm.sink.SetGauge(
+		"beacon_kit.storage.pruner.bytes_freed",
+		int64(bytesFreed), "pruner", pm.name,
+	)
+}
+
+// markPruneFailure increments the counter for failed prunes.
+func (pm *prunerMetrics) markPruneFailure() {
+	pm.sink.IncrementCounter(
+		"beacon_kit.storage.pruner.prune_failure", "pruner", pm.name,
+	)
+}
@@ -67,6 +67,53 @@ func (_c *Pruner_Name_Call[PrunableT]) RunAndReturn(run func() string) *Pruner_N
 	return _c
 }
 
+// Prune provides a mock function with given fields: start, end
+func (_m *Pruner[PrunableT]) Prune(start uint64, end uint64) error {
+	ret := _m.Called(start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Prune")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64) error); ok {
+		r0 = rf(start, end)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Pruner_Prune_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Prune'
+type Pruner_Prune_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// Prune is a helper method to define mock.On call
+//   - start uint64
+//   - end uint64
+func (_e *Pruner_Expecter[PrunableT]) Prune(start interface{}, end interface{}) *Pruner_Prune_Call[PrunableT] {
+	return &Pruner_Prune_Call[PrunableT]{Call: _e.mock.On("Prune", start, end)}
+}
+
+func (_c *Pruner_Prune_Call[PrunableT]) Run(run func(start uint64, end uint64)) *Pruner_Prune_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint64), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *Pruner_Prune_Call[PrunableT]) Return(_a0 error) *Pruner_Prune_Call[PrunableT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Pruner_Prune_Call[PrunableT]) RunAndReturn(run func(uint64, uint64) error) *Pruner_Prune_Call[PrunableT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Pruner[PrunableT]) Start(ctx context.Context) {
 	_m.Called(ctx)
@@ -28,6 +28,7 @@ package pruner
 
 import (
 	"context"
+	"sync"
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
@@ -46,6 +47,12 @@ type DBPruner[
 	name         string
 	feed         BlockFeed[BeaconBlockT, BlockEventT, SubscriptionT]
 	pruneRangeFn func(BlockEventT) (uint64, uint64)
+	// enabled determines whether the pruner reacts to finalized blocks.
+	enabled bool
+	// mu serializes prunes, which may be triggered by finalized blocks as
+	// well as manually.
+	mu      sync.Mutex
+	metrics *prunerMetrics
 }
 
 func NewPruner[
@@ -54,11 +61,13 @@ func NewPruner[
 	PrunableT Prunable,
 	SubscriptionT Subscription,
 ](
+	cfg *Config,
 	logger log.Logger[any],
 	prunable Prunable,
 	name string,
 	feed BlockFeed[BeaconBlockT, BlockEventT, SubscriptionT],
 	pruneRangeFn func(BlockEventT) (uint64, uint64),
+	telemetrySink TelemetrySink,
 ) *DBPruner[BeaconBlockT, BlockEventT, PrunableT, SubscriptionT] {
 	return &DBPruner[BeaconBlockT, BlockEventT, PrunableT, SubscriptionT]{
 		logger:       logger,
@@ -66,6 +75,8 @@ func NewPruner[
 		name:         name,
 		feed:         feed,
 		pruneRangeFn: pruneRangeFn,
+		enabled:      cfg.Enabled,
+		metrics:      newPrunerMetrics(telemetrySink, name),
 	}
 }
 
@@ -73,6 +84,11 @@ func NewPruner[
 func (p *DBPruner[
 	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
 ]) Start(ctx context.Context) {
+	if !p.enabled {
+		p.logger.Info("pruner is disabled, skipping")
+		return
+	}
+
 	ch := make(chan BlockEventT)
 	sub := p.feed.Subscribe(ch)
 	go func() {
@@ -84,7 +100,7 @@ func (p *DBPruner[
 			case event := <-ch:
 				if event.Is(events.BeaconBlockFinalized) {
 					start, end := p.pruneRangeFn(event)
-					if err := p.prunable.Prune(start, end); err != nil {
+					if err := p.Prune(start, end); err != nil {
 						p.logger.Error(
 							"‼️ error pruning index ‼️",
 							"error", err,
@@ -102,3 +118,33 @@ func (p *DBPruner[
 ]) Name() string {
 	return p.name
 }
+
+// Prune prunes the range [start, end) of the store and reports the pruned
+// range through the telemetry sink.
+func (p *DBPruner[
+	BeaconBlockT, BlockEventT, PrunableT, SubscriptionT,
+]) Prune(start, end uint64) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Measure the size of the range before it is gone, if supported.
+	var (
+		bytesFreed uint64
+		err        error
+	)
+	if sizer, ok := p.prunable.(Sizer); ok && start < end {
+		if bytesFreed, err = sizer.Size(start, end); err != nil {
+			p.logger.Warn("failed to size prune range", "error", err)
+		}
+	}
+
+	if err = p.prunable.Prune(start, end); err != nil {
+		p.metrics.markPruneFailure()
+		return err
+	}
+
+	if start < end {
+		p.metrics.reportPrunedRange(start, end, bytesFreed)
+	}
+	return nil
+}
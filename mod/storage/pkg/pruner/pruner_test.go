@@ -96,13 +96,27 @@ func TestPruner(t *testing.T) {
 			mockPrunable.On("Prune", mock.Anything, mock.Anything).
 				Return(nil)
 
+			sink := new(mocks.TelemetrySink)
+			sink.On(
+				"SetGauge",
+				mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			).Return().Maybe()
+
 			// create Pruner with a Noop logger
 			testPruner := pruner.NewPruner[
 				pruner.BeaconBlock,
 				pruner.BlockEvent[pruner.BeaconBlock],
 				pruner.Prunable,
 				pruner.Subscription,
-			](logger, mockPrunable, "TestPruner", &feed, pruneRangeFn)
+			](
+				&pruner.Config{Enabled: true},
+				logger,
+				mockPrunable,
+				"TestPruner",
+				&feed,
+				pruneRangeFn,
+				sink,
+			)
 
 			ctx, cancel := context.WithCancel(context.Background())
 			// need to ensure goroutine is stopped
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrDoubleProposal is returned when a block conflicting with one
+	// already signed at the same slot is to be signed.
+	ErrDoubleProposal = errors.New("conflicting block already signed at slot")
+
+	// ErrSlotTooLow is returned when a block is to be signed at a slot lower
+	// than the lowest slot a block was signed at.
+	ErrSlotTooLow = errors.New("slot lower than the lowest signed slot")
+
+	// ErrDoubleRandaoReveal is returned when a randao reveal conflicting with
+	// one already signed for the same epoch is to be signed.
+	ErrDoubleRandaoReveal = errors.New(
+		"conflicting randao reveal already signed for epoch",
+	)
+
+	// ErrGenesisValidatorsRootMismatch is returned when the genesis
+	// validators root does not match that of the database.
+	ErrGenesisValidatorsRootMismatch = errors.New(
+		"genesis validators root mismatch",
+	)
+
+	// ErrUnknownGenesisValidatorsRoot is returned when exporting a database
+	// that does not know the genesis validators root yet, i.e. that holds no
+	// signatures.
+	ErrUnknownGenesisValidatorsRoot = errors.New(
+		"genesis validators root unknown",
+	)
+
+	// ErrUnsupportedInterchangeVersion is returned when importing an
+	// interchange file of an unsupported format version.
+	ErrUnsupportedInterchangeVersion = errors.New(
+		"unsupported interchange format version",
+	)
+
+	// ErrInvalidAttestation is returned when importing an attestation whose
+	// source epoch is greater than its target epoch.
+	ErrInvalidAttestation = errors.New(
+		"attestation source epoch greater than target epoch",
+	)
+)
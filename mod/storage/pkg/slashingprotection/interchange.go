@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// InterchangeFormatVersion is the version of the EIP-3076 interchange format
+// supported.
+const InterchangeFormatVersion = "5"
+
+// Interchange is a slashing protection interchange file, as per EIP-3076.
+type Interchange struct {
+	Metadata InterchangeMetadata `json:"metadata"`
+	Data     []InterchangeData   `json:"data"`
+}
+
+// InterchangeMetadata is the metadata of an interchange file.
+type InterchangeMetadata struct {
+	InterchangeFormatVersion string      `json:"interchange_format_version"`
+	GenesisValidatorsRoot    common.Root `json:"genesis_validators_root"`
+}
+
+// InterchangeData are the signed messages of a public key.
+type InterchangeData struct {
+	Pubkey             crypto.BLSPubkey    `json:"pubkey"`
+	SignedBlocks       []SignedBlock       `json:"signed_blocks"`
+	SignedAttestations []SignedAttestation `json:"signed_attestations"`
+}
+
+// SignedBlock is a signed block of an interchange file.
+type SignedBlock struct {
+	Slot        uint64       `json:"slot,string"`
+	SigningRoot *common.Root `json:"signing_root,omitempty"`
+}
+
+// SignedAttestation is a signed attestation of an interchange file.
+type SignedAttestation struct {
+	SourceEpoch uint64       `json:"source_epoch,string"`
+	TargetEpoch uint64       `json:"target_epoch,string"`
+	SigningRoot *common.Root `json:"signing_root,omitempty"`
+}
+
+// Import imports the signed messages of an interchange file. Messages
+// conflicting with those of the database, or with each other, are recorded
+// without a signing root, so that no message may be signed at their slot or
+// epochs anymore. The import is atomic.
+func (kv *KVStore) Import(interchange *Interchange) error {
+	if v := interchange.Metadata.InterchangeFormatVersion; v !=
+		InterchangeFormatVersion {
+		return errors.Wrapf(ErrUnsupportedInterchangeVersion, "version %s", v)
+	}
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+	if err := kv.checkGenesisValidatorsRoot(
+		interchange.Metadata.GenesisValidatorsRoot, batch,
+	); err != nil {
+		return err
+	}
+
+	// pending are the records of the batch, which may repeat each other.
+	pending := make(map[string][]byte)
+	record := func(key []byte, signingRoot *common.Root) error {
+		var root common.Root
+		if signingRoot != nil {
+			root = *signingRoot
+		}
+		prev, ok := pending[string(key)]
+		if !ok {
+			var err error
+			if prev, err = kv.db.Get(key); err != nil {
+				return err
+			}
+		}
+		if prev != nil && !isRepeat(prev, root) {
+			root = common.Root{}
+		}
+		pending[string(key)] = root[:]
+		return batch.Set(key, root[:])
+	}
+
+	for _, data := range interchange.Data {
+		for _, blk := range data.SignedBlocks {
+			if err := record(
+				blockKey(data.Pubkey, blk.Slot), blk.SigningRoot,
+			); err != nil {
+				return err
+			}
+		}
+		for _, att := range data.SignedAttestations {
+			if att.SourceEpoch > att.TargetEpoch {
+				return errors.Wrapf(ErrInvalidAttestation,
+					"pubkey %s, source %d, target %d",
+					data.Pubkey, att.SourceEpoch, att.TargetEpoch,
+				)
+			}
+			if err := record(attestationKey(
+				data.Pubkey, att.SourceEpoch, att.TargetEpoch,
+			), att.SigningRoot); err != nil {
+				return err
+			}
+		}
+	}
+	return batch.WriteSync()
+}
+
+// Export exports the signed blocks and attestations of the database to an
+// interchange file. Randao reveals are not part of the interchange format,
+// thus are not exported.
+func (kv *KVStore) Export() (*Interchange, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	root, found, err := kv.genesisValidatorsRoot()
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, ErrUnknownGenesisValidatorsRoot
+	}
+
+	interchange := &Interchange{
+		Metadata: InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+			GenesisValidatorsRoot:    root,
+		},
+		Data: make([]InterchangeData, 0),
+	}
+	// data returns the data of the given public key, which the records
+	// are iterated by.
+	data := func(pubkey crypto.BLSPubkey) *InterchangeData {
+		for i := range interchange.Data {
+			if interchange.Data[i].Pubkey == pubkey {
+				return &interchange.Data[i]
+			}
+		}
+		interchange.Data = append(interchange.Data, InterchangeData{
+			Pubkey:             pubkey,
+			SignedBlocks:       make([]SignedBlock, 0),
+			SignedAttestations: make([]SignedAttestation, 0),
+		})
+		return &interchange.Data[len(interchange.Data)-1]
+	}
+
+	if err = kv.iterate(blockPrefix, func(
+		pubkey crypto.BLSPubkey, suffix []byte, signingRoot *common.Root,
+	) {
+		d := data(pubkey)
+		d.SignedBlocks = append(d.SignedBlocks, SignedBlock{
+			Slot:        binary.BigEndian.Uint64(suffix),
+			SigningRoot: signingRoot,
+		})
+	}); err != nil {
+		return nil, err
+	}
+	if err = kv.iterate(attestationPrefix, func(
+		pubkey crypto.BLSPubkey, suffix []byte, signingRoot *common.Root,
+	) {
+		d := data(pubkey)
+		d.SignedAttestations = append(d.SignedAttestations,
+			SignedAttestation{
+				TargetEpoch: binary.BigEndian.Uint64(suffix[:8]),
+				SourceEpoch: binary.BigEndian.Uint64(suffix[8:]),
+				SigningRoot: signingRoot,
+			},
+		)
+	}); err != nil {
+		return nil, err
+	}
+	return interchange, nil
+}
+
+// iterate calls the given function with the public key, the key suffix and
+// the signing root, if known, of every record of the given kind.
+func (kv *KVStore) iterate(
+	prefix byte,
+	fn func(crypto.BLSPubkey, []byte, *common.Root),
+) error {
+	iter, err := kv.db.Iterator([]byte{prefix}, []byte{prefix + 1})
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[1:]
+		var signingRoot *common.Root
+		if root := common.Root(iter.Value()); root != (common.Root{}) {
+			signingRoot = &root
+		}
+		fn(
+			crypto.BLSPubkey(key[:len(crypto.BLSPubkey{})]),
+			key[len(crypto.BLSPubkey{}):],
+			signingRoot,
+		)
+	}
+	return iter.Error()
+}
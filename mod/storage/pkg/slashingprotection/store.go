@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	genesisValidatorsRootPrefix byte = iota
+	blockPrefix
+	attestationPrefix
+	randaoRevealPrefix
+)
+
+// KVStore is a slashing protection database. It records, per public key, the
+// slots of the signed blocks, the epochs of the signed randao reveals and the
+// source and target epochs of the signed attestations, along with their
+// signing roots, and refuses to sign messages conflicting with them.
+//
+// Every record is written synchronously, before the signature it guards is
+// released.
+type KVStore struct {
+	// mu serializes the checks and records.
+	mu sync.Mutex
+	// db is the underlying database.
+	db store.KVStoreWithBatch
+}
+
+// NewStore creates a new slashing protection database on top of the given
+// database.
+func NewStore(db store.KVStoreWithBatch) *KVStore {
+	return &KVStore{db: db}
+}
+
+// Close closes the underlying database.
+func (kv *KVStore) Close() error {
+	return kv.db.Close()
+}
+
+// CheckGenesisValidatorsRoot checks that the database belongs to the chain
+// with the given genesis validators root, recording it on first use.
+func (kv *KVStore) CheckGenesisValidatorsRoot(root common.Root) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+	if err := kv.checkGenesisValidatorsRoot(root, batch); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// CheckAndRecordBlock records the signing of a block at the given slot, or
+// returns an error if it conflicts with the blocks signed before. A block is
+// refused if another block was signed at its slot, or if its slot is lower
+// than that of every block signed before. Signing the same block again is
+// allowed.
+func (kv *KVStore) CheckAndRecordBlock(
+	pubkey crypto.BLSPubkey,
+	slot math.Slot,
+	signingRoot common.Root,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	key := blockKey(pubkey, uint64(slot))
+	if prev, err := kv.db.Get(key); err != nil {
+		return err
+	} else if prev != nil {
+		if isRepeat(prev, signingRoot) {
+			return nil
+		}
+		return errors.Wrapf(ErrDoubleProposal, "pubkey %s, slot %d",
+			pubkey, slot,
+		)
+	}
+
+	lowest, found, err := kv.first(blockPrefix, pubkey)
+	if err != nil {
+		return err
+	}
+	if found && uint64(slot) < binary.BigEndian.Uint64(lowest) {
+		return errors.Wrapf(ErrSlotTooLow, "pubkey %s, slot %d",
+			pubkey, slot,
+		)
+	}
+	return kv.write(key, signingRoot[:])
+}
+
+// CheckAndRecordRandaoReveal records the signing of a randao reveal for the
+// given epoch, or returns an error if a different one was signed for it.
+func (kv *KVStore) CheckAndRecordRandaoReveal(
+	pubkey crypto.BLSPubkey,
+	epoch math.Epoch,
+	signingRoot common.Root,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	key := randaoRevealKey(pubkey, uint64(epoch))
+	if prev, err := kv.db.Get(key); err != nil {
+		return err
+	} else if prev != nil {
+		if isRepeat(prev, signingRoot) {
+			return nil
+		}
+		return errors.Wrapf(ErrDoubleRandaoReveal, "pubkey %s, epoch %d",
+			pubkey, epoch,
+		)
+	}
+	return kv.write(key, signingRoot[:])
+}
+
+// checkGenesisValidatorsRoot checks the genesis validators root against that
+// of the database, adding it to the given batch if there is none yet.
+func (kv *KVStore) checkGenesisValidatorsRoot(
+	root common.Root,
+	batch store.Batch,
+) error {
+	key := []byte{genesisValidatorsRootPrefix}
+	prev, err := kv.db.Get(key)
+	switch {
+	case err != nil:
+		return err
+	case prev == nil:
+		return batch.Set(key, root[:])
+	case common.Root(prev) != root:
+		return errors.Wrapf(ErrGenesisValidatorsRootMismatch,
+			"expected %s, got %s", common.Root(prev), root,
+		)
+	default:
+		return nil
+	}
+}
+
+// genesisValidatorsRoot returns the genesis validators root of the database,
+// if known.
+func (kv *KVStore) genesisValidatorsRoot() (common.Root, bool, error) {
+	bz, err := kv.db.Get([]byte{genesisValidatorsRootPrefix})
+	if err != nil || bz == nil {
+		return common.Root{}, false, err
+	}
+	return common.Root(bz), true, nil
+}
+
+// first returns the key suffix after the public key of the first record of
+// the given kind for the public key, if any.
+func (kv *KVStore) first(
+	prefix byte,
+	pubkey crypto.BLSPubkey,
+) ([]byte, bool, error) {
+	start, end := pubkeyRange(prefix, pubkey)
+	iter, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return nil, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, false, iter.Error()
+	}
+	return iter.Key()[len(start):], true, nil
+}
+
+// write writes a record synchronously.
+func (kv *KVStore) write(key, value []byte) error {
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(key, value); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// isRepeat returns true if a recorded signing root is that of the message
+// to sign. Records without a signing root, e.g. imported ones, never are.
+func isRepeat(recorded []byte, signingRoot common.Root) bool {
+	return common.Root(recorded) == signingRoot &&
+		signingRoot != common.Root{}
+}
+
+// pubkeyRange returns the range of the keys of the records of the given
+// kind for the public key.
+func pubkeyRange(prefix byte, pubkey crypto.BLSPubkey) ([]byte, []byte) {
+	start := append([]byte{prefix}, pubkey[:]...)
+	end := append([]byte{prefix}, pubkey[:]...)
+	// The public key is followed by the big endian slot or epochs of the
+	// records, thus no key of the range starts with an all 0xff suffix.
+	return start, append(end, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff)
+}
+
+// blockKey returns the key of the block signed at the given slot.
+func blockKey(pubkey crypto.BLSPubkey, slot uint64) []byte {
+	key := append([]byte{blockPrefix}, pubkey[:]...)
+	return binary.BigEndian.AppendUint64(key, slot)
+}
+
+// randaoRevealKey returns the key of the randao reveal signed for the given
+// epoch.
+func randaoRevealKey(pubkey crypto.BLSPubkey, epoch uint64) []byte {
+	key := append([]byte{randaoRevealPrefix}, pubkey[:]...)
+	return binary.BigEndian.AppendUint64(key, epoch)
+}
+
+// attestationKey returns the key of the attestation signed with the given
+// source and target epochs.
+func attestationKey(pubkey crypto.BLSPubkey, source, target uint64) []byte {
+	key := append([]byte{attestationPrefix}, pubkey[:]...)
+	key = binary.BigEndian.AppendUint64(key, target)
+	return binary.BigEndian.AppendUint64(key, source)
+}
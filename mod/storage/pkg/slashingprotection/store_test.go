@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashingprotection_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/slashingprotection"
+	"github.com/stretchr/testify/require"
+)
+
+// memDB is an in-memory store.KVStoreWithBatch.
+type memDB map[string][]byte
+
+func (m memDB) Get(key []byte) ([]byte, error) {
+	return m[string(key)], nil
+}
+
+func (m memDB) Has(key []byte) (bool, error) {
+	_, ok := m[string(key)]
+	return ok, nil
+}
+
+func (m memDB) Set(key, value []byte) error {
+	m[string(key)] = slices.Clone(value)
+	return nil
+}
+
+func (m memDB) Delete(key []byte) error {
+	delete(m, string(key))
+	return nil
+}
+
+func (m memDB) Iterator(start, end []byte) (store.Iterator, error) {
+	iter := &memIterator{db: m}
+	for k := range m {
+		if bytes.Compare([]byte(k), start) >= 0 &&
+			(end == nil || bytes.Compare([]byte(k), end) < 0) {
+			iter.keys = append(iter.keys, k)
+		}
+	}
+	slices.Sort(iter.keys)
+	return iter, nil
+}
+
+func (m memDB) ReverseIterator(_, _ []byte) (store.Iterator, error) {
+	panic("not implemented")
+}
+
+func (m memDB) NewBatch() store.Batch {
+	return &memBatch{db: m}
+}
+
+func (m memDB) NewBatchWithSize(int) store.Batch {
+	return m.NewBatch()
+}
+
+func (m memDB) Close() error {
+	return nil
+}
+
+type memIterator struct {
+	db   memDB
+	keys []string
+}
+
+func (it *memIterator) Domain() ([]byte, []byte) { return nil, nil }
+func (it *memIterator) Valid() bool              { return len(it.keys) > 0 }
+func (it *memIterator) Next()                    { it.keys = it.keys[1:] }
+func (it *memIterator) Key() []byte              { return []byte(it.keys[0]) }
+func (it *memIterator) Value() []byte            { return it.db[it.keys[0]] }
+func (it *memIterator) Error() error             { return nil }
+func (it *memIterator) Close() error             { return nil }
+
+type memBatch struct {
+	db  memDB
+	ops [][2][]byte
+}
+
+func (b *memBatch) Set(key, value []byte) error {
+	b.ops = append(b.ops, [2][]byte{key, slices.Clone(value)})
+	return nil
+}
+
+func (b *memBatch) Delete(key []byte) error {
+	b.ops = append(b.ops, [2][]byte{key, nil})
+	return nil
+}
+
+func (b *memBatch) Write() error {
+	for _, op := range b.ops {
+		if op[1] == nil {
+			delete(b.db, string(op[0]))
+		} else {
+			b.db[string(op[0])] = op[1]
+		}
+	}
+	b.ops = nil
+	return nil
+}
+
+func (b *memBatch) WriteSync() error          { return b.Write() }
+func (b *memBatch) Close() error              { return nil }
+func (b *memBatch) GetByteSize() (int, error) { return 0, nil }
+
+var (
+	pubkey  = crypto.BLSPubkey{0x01}
+	genesis = common.Root{0x0a}
+)
+
+func TestCheckAndRecordBlock(t *testing.T) {
+	db := slashingprotection.NewStore(memDB{})
+
+	require.NoError(t, db.CheckAndRecordBlock(pubkey, 10, common.Root{1}))
+	// The same block may be signed again.
+	require.NoError(t, db.CheckAndRecordBlock(pubkey, 10, common.Root{1}))
+	require.ErrorIs(t,
+		db.CheckAndRecordBlock(pubkey, 10, common.Root{2}),
+		slashingprotection.ErrDoubleProposal,
+	)
+	require.ErrorIs(t,
+		db.CheckAndRecordBlock(pubkey, 9, common.Root{2}),
+		slashingprotection.ErrSlotTooLow,
+	)
+	require.NoError(t, db.CheckAndRecordBlock(pubkey, 12, common.Root{3}))
+	// A slot between signed slots is allowed.
+	require.NoError(t, db.CheckAndRecordBlock(pubkey, 11, common.Root{4}))
+	// Other public keys are independent.
+	require.NoError(t, db.CheckAndRecordBlock(
+		crypto.BLSPubkey{0x02}, 1, common.Root{5},
+	))
+}
+
+func TestCheckAndRecordRandaoReveal(t *testing.T) {
+	db := slashingprotection.NewStore(memDB{})
+
+	require.NoError(t, db.CheckAndRecordRandaoReveal(pubkey, 3, common.Root{1}))
+	require.NoError(t, db.CheckAndRecordRandaoReveal(pubkey, 3, common.Root{1}))
+	require.ErrorIs(t,
+		db.CheckAndRecordRandaoReveal(pubkey, 3, common.Root{2}),
+		slashingprotection.ErrDoubleRandaoReveal,
+	)
+	require.NoError(t, db.CheckAndRecordRandaoReveal(pubkey, 2, common.Root{2}))
+}
+
+func TestCheckGenesisValidatorsRoot(t *testing.T) {
+	db := slashingprotection.NewStore(memDB{})
+
+	require.NoError(t, db.CheckGenesisValidatorsRoot(genesis))
+	require.NoError(t, db.CheckGenesisValidatorsRoot(genesis))
+	require.ErrorIs(t,
+		db.CheckGenesisValidatorsRoot(common.Root{0x0b}),
+		slashingprotection.ErrGenesisValidatorsRootMismatch,
+	)
+}
+
+const interchangeJSON = `{
+  "metadata": {
+    "interchange_format_version": "5",
+    "genesis_validators_root": "0x0a00000000000000000000000000000000000000000000000000000000000000"
+  },
+  "data": [
+    {
+      "pubkey": "0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+      "signed_blocks": [
+        {
+          "slot": "81951"
+        },
+        {
+          "slot": "81952",
+          "signing_root": "0x0100000000000000000000000000000000000000000000000000000000000000"
+        }
+      ],
+      "signed_attestations": [
+        {
+          "source_epoch": "2290",
+          "target_epoch": "3007",
+          "signing_root": "0x0200000000000000000000000000000000000000000000000000000000000000"
+        }
+      ]
+    }
+  ]
+}`
+
+func TestImportExport(t *testing.T) {
+	var interchange slashingprotection.Interchange
+	require.NoError(t, json.Unmarshal([]byte(interchangeJSON), &interchange))
+
+	db := slashingprotection.NewStore(memDB{})
+	_, err := db.Export()
+	require.ErrorIs(t, err, slashingprotection.ErrUnknownGenesisValidatorsRoot)
+	require.NoError(t, db.Import(&interchange))
+
+	// Imported blocks are protected, and so are slots below them.
+	require.NoError(t, db.CheckAndRecordBlock(pubkey, 81952, common.Root{1}))
+	require.ErrorIs(t,
+		db.CheckAndRecordBlock(pubkey, 81951, common.Root{1}),
+		slashingprotection.ErrDoubleProposal,
+	)
+	require.ErrorIs(t,
+		db.CheckAndRecordBlock(pubkey, 81950, common.Root{1}),
+		slashingprotection.ErrSlotTooLow,
+	)
+	require.ErrorIs(t,
+		db.CheckGenesisValidatorsRoot(common.Root{0x0b}),
+		slashingprotection.ErrGenesisValidatorsRootMismatch,
+	)
+
+	exported, err := db.Export()
+	require.NoError(t, err)
+	bz, err := json.Marshal(exported)
+	require.NoError(t, err)
+	require.JSONEq(t, interchangeJSON, string(bz))
+}
+
+func TestImportConflicts(t *testing.T) {
+	var interchange slashingprotection.Interchange
+	require.NoError(t, json.Unmarshal([]byte(interchangeJSON), &interchange))
+	db := slashingprotection.NewStore(memDB{})
+	require.NoError(t, db.CheckAndRecordBlock(pubkey, 81952, common.Root{9}))
+
+	// A conflicting block is recorded without a signing root, so that the
+	// slot may not be signed anymore.
+	require.NoError(t, db.Import(&interchange))
+	require.ErrorIs(t,
+		db.CheckAndRecordBlock(pubkey, 81952, common.Root{9}),
+		slashingprotection.ErrDoubleProposal,
+	)
+	exported, err := db.Export()
+	require.NoError(t, err)
+	require.Nil(t, exported.Data[0].SignedBlocks[1].SigningRoot)
+
+	// A file of another chain is refused as a whole.
+	interchange.Metadata.GenesisValidatorsRoot = common.Root{0x0b}
+	interchange.Data[0].SignedBlocks[1].Slot = 90000
+	require.ErrorIs(t,
+		db.Import(&interchange),
+		slashingprotection.ErrGenesisValidatorsRootMismatch,
+	)
+	require.NoError(t, db.CheckAndRecordBlock(pubkey, 90000, common.Root{1}))
+
+	interchange.Metadata.InterchangeFormatVersion = "4"
+	require.ErrorIs(t,
+		db.Import(&interchange),
+		slashingprotection.ErrUnsupportedInterchangeVersion,
+	)
+}